@@ -2,13 +2,18 @@ package backend
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"git.sr.ht/~barveyhirdman/chainkills/backend/memory"
 	"git.sr.ht/~barveyhirdman/chainkills/backend/redict"
 	"git.sr.ht/~barveyhirdman/chainkills/config"
 )
 
-var backend Engine
+var (
+	mx      = &sync.Mutex{}
+	backend Engine
+)
 
 const engine = "redict"
 
@@ -21,9 +26,25 @@ type Engine interface {
 	IgnoreSystemID(ctx context.Context, id int64) error
 	IgnoreSystemName(ctx context.Context, name string) error
 	IgnoreRegionID(ctx context.Context, id int64) error
+	SetLastSeen(ctx context.Context, t time.Time) error
+	GetLastSeen(ctx context.Context) (time.Time, error)
+	GetNames(ctx context.Context, ids []string) (map[string]string, error)
+	SetNames(ctx context.Context, names map[string]string, ttl time.Duration) error
+	GetFilter(ctx context.Context, name string) ([]string, error)
+	AddFilter(ctx context.Context, name, value string) error
+	RemoveFilter(ctx context.Context, name, value string) error
+	AddOutbox(ctx context.Context, id, entry string) error
+	GetOutbox(ctx context.Context) (map[string]string, error)
+	RemoveOutbox(ctx context.Context, id string) error
+	AddDeadLetter(ctx context.Context, id, entry string) error
+	GetDeadLetters(ctx context.Context) (map[string]string, error)
+	RemoveDeadLetter(ctx context.Context, id string) error
 }
 
 func Backend() (Engine, error) {
+	mx.Lock()
+	defer mx.Unlock()
+
 	var err error
 	if backend == nil {
 		switch engine {
@@ -38,3 +59,12 @@ func Backend() (Engine, error) {
 
 	return backend, err
 }
+
+// SetBackend replaces the engine Backend returns, so tests can run without a
+// redict server. nil goes back to connecting on the next call.
+func SetBackend(e Engine) {
+	mx.Lock()
+	defer mx.Unlock()
+
+	backend = e
+}
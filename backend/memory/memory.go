@@ -2,25 +2,40 @@ package memory
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 )
 
 var ttl = 24 * time.Hour
 
+// ErrNotPersistent is returned for the outbox and the dead letters, they have
+// to survive restarts, which the memory backend can't do.
+var ErrNotPersistent = errors.New("the outbox and dead letters need a persistent backend")
+
 type Backend struct {
 	mx *sync.Mutex
 
-	count uint64
-	items map[string]time.Time
+	count    uint64
+	items    map[string]time.Time
+	lastSeen time.Time
+	names    map[string]cachedName
+	filters  map[string]map[string]struct{}
+}
+
+type cachedName struct {
+	name    string
+	expires time.Time
 }
 
 func New() (*Backend, error) {
 	return &Backend{
 		mx: &sync.Mutex{},
 
-		count: 0,
-		items: make(map[string]time.Time),
+		count:   0,
+		items:   make(map[string]time.Time),
+		names:   make(map[string]cachedName),
+		filters: make(map[string]map[string]struct{}),
 	}, nil
 }
 
@@ -73,3 +88,103 @@ func (c *Backend) IgnoreSystemName(ctx context.Context, name string) error {
 func (c *Backend) IgnoreRegionID(ctx context.Context, id int64) error {
 	return nil
 }
+func (c *Backend) SetLastSeen(ctx context.Context, t time.Time) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.lastSeen = t
+	return nil
+}
+func (c *Backend) GetLastSeen(ctx context.Context) (time.Time, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.lastSeen, nil
+}
+func (c *Backend) GetNames(ctx context.Context, ids []string) (map[string]string, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	names := make(map[string]string, len(ids))
+	for _, id := range ids {
+		n, ok := c.names[id]
+		if !ok {
+			continue
+		}
+		if time.Now().After(n.expires) {
+			delete(c.names, id)
+			continue
+		}
+		names[id] = n.name
+	}
+
+	return names, nil
+}
+func (c *Backend) SetNames(ctx context.Context, names map[string]string, ttl time.Duration) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	for id, name := range names {
+		c.names[id] = cachedName{name: name, expires: time.Now().Add(ttl)}
+	}
+
+	c.evictNames()
+	return nil
+}
+
+// evictNames drops expired names, those that aren't looked up again would
+// stay in the map otherwise.
+func (c *Backend) evictNames() {
+	for id, n := range c.names {
+		if time.Now().After(n.expires) {
+			delete(c.names, id)
+		}
+	}
+}
+func (c *Backend) GetFilter(ctx context.Context, name string) ([]string, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	values := make([]string, 0, len(c.filters[name]))
+	for value := range c.filters[name] {
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+func (c *Backend) AddFilter(ctx context.Context, name, value string) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if _, ok := c.filters[name]; !ok {
+		c.filters[name] = make(map[string]struct{})
+	}
+	c.filters[name][value] = struct{}{}
+
+	return nil
+}
+func (c *Backend) RemoveFilter(ctx context.Context, name, value string) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	delete(c.filters[name], value)
+	return nil
+}
+func (c *Backend) AddOutbox(_ context.Context, _, _ string) error {
+	return ErrNotPersistent
+}
+func (c *Backend) GetOutbox(_ context.Context) (map[string]string, error) {
+	return nil, ErrNotPersistent
+}
+func (c *Backend) RemoveOutbox(_ context.Context, _ string) error {
+	return ErrNotPersistent
+}
+func (c *Backend) AddDeadLetter(_ context.Context, _, _ string) error {
+	return ErrNotPersistent
+}
+func (c *Backend) GetDeadLetters(_ context.Context) (map[string]string, error) {
+	return nil, ErrNotPersistent
+}
+func (c *Backend) RemoveDeadLetter(_ context.Context, _ string) error {
+	return ErrNotPersistent
+}
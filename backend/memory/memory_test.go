@@ -49,3 +49,83 @@ func TestEvict(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, exists)
 }
+
+func TestEvictNames(t *testing.T) {
+	cache, err := New()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	require.NoError(t, cache.SetNames(ctx, map[string]string{"1": "Jita", "2": "Amarr"}, time.Hour))
+	cache.names["1"] = cachedName{name: "Jita", expires: time.Now().Add(-time.Minute)}
+
+	names, err := cache.GetNames(ctx, []string{"1", "2"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"2": "Amarr"}, names)
+	require.NotContains(t, cache.names, "1")
+
+	cache.names["2"] = cachedName{name: "Amarr", expires: time.Now().Add(-time.Minute)}
+	require.NoError(t, cache.SetNames(ctx, map[string]string{"3": "Dodixie"}, time.Hour))
+	require.Len(t, cache.names, 1)
+}
+
+func TestLastSeen(t *testing.T) {
+	cache, err := New()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	{
+		last, err := cache.GetLastSeen(ctx)
+		require.NoError(t, err)
+		require.True(t, last.IsZero())
+	}
+
+	now := time.Now()
+	require.NoError(t, cache.SetLastSeen(ctx, now))
+
+	last, err := cache.GetLastSeen(ctx)
+	require.NoError(t, err)
+	require.Equal(t, now, last)
+}
+
+func TestFilter(t *testing.T) {
+	cache, err := New()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	require.NoError(t, cache.AddFilter(ctx, "ship_exclude_group", "29"))
+	require.NoError(t, cache.AddFilter(ctx, "ship_exclude_group", "29"))
+	require.NoError(t, cache.AddFilter(ctx, "ship_exclude_group", "31"))
+
+	{
+		values, err := cache.GetFilter(ctx, "ship_exclude_group")
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"29", "31"}, values)
+	}
+
+	require.NoError(t, cache.RemoveFilter(ctx, "ship_exclude_group", "29"))
+	require.NoError(t, cache.RemoveFilter(ctx, "unknown", "29"))
+
+	values, err := cache.GetFilter(ctx, "ship_exclude_group")
+	require.NoError(t, err)
+	require.Equal(t, []string{"31"}, values)
+}
+
+func TestNotPersistent(t *testing.T) {
+	cache, err := New()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	require.ErrorIs(t, cache.AddOutbox(ctx, "1-a", "entry"), ErrNotPersistent)
+	_, err = cache.GetOutbox(ctx)
+	require.ErrorIs(t, err, ErrNotPersistent)
+	require.ErrorIs(t, cache.RemoveOutbox(ctx, "1-a"), ErrNotPersistent)
+
+	require.ErrorIs(t, cache.AddDeadLetter(ctx, "1-a", "failed"), ErrNotPersistent)
+	_, err = cache.GetDeadLetters(ctx)
+	require.ErrorIs(t, err, ErrNotPersistent)
+	require.ErrorIs(t, cache.RemoveDeadLetter(ctx, "1-a"), ErrNotPersistent)
+}
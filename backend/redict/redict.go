@@ -24,10 +24,28 @@ const (
 	spanIgnoreSystemID        = "IgnoreSystemID"
 	spanIgnoreSystemName      = "IgnoreSystemName"
 	spanIgnoreRegionID        = "IgnoreRegionID"
+	spanSetLastSeen           = "SetLastSeen"
+	spanGetLastSeen           = "GetLastSeen"
+	spanGetNames              = "GetNames"
+	spanSetNames              = "SetNames"
+	spanGetFilter             = "GetFilter"
+	spanAddFilter             = "AddFilter"
+	spanRemoveFilter          = "RemoveFilter"
+	spanAddOutbox             = "AddOutbox"
+	spanGetOutbox             = "GetOutbox"
+	spanRemoveOutbox          = "RemoveOutbox"
+	spanAddDeadLetter         = "AddDeadLetter"
+	spanGetDeadLetters        = "GetDeadLetters"
+	spanRemoveDeadLetter      = "RemoveDeadLetter"
 
 	keyIgnoredSystemIDs   = "ignored_system_ids"
 	keyIgnoredSystemNames = "ignored_system_names"
 	keyIgnoredRegionIDs   = "ignored_region_ids"
+	keyLastSeen           = "last_seen"
+	keyName               = "name"
+	keyFilter             = "filter"
+	keyOutbox             = "outbox"
+	keyDeadLetters        = "dead_letters"
 )
 
 type Backend struct {
@@ -185,3 +203,258 @@ func (r *Backend) IgnoreRegionID(ctx context.Context, id int64) error {
 	span.SetStatus(codes.Ok, "ok")
 	return nil
 }
+
+func (r *Backend) SetLastSeen(ctx context.Context, t time.Time) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanSetLastSeen)
+	defer span.End()
+
+	span.SetAttributes(attribute.String("last_seen", t.Format(time.RFC3339)))
+
+	key := fmt.Sprintf("%s:%s", config.Get().Redict.Prefix, keyLastSeen)
+	if err := r.redict.Set(sctx, key, t.Format(time.RFC3339), 0).Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+	return nil
+}
+
+func (r *Backend) GetLastSeen(ctx context.Context) (time.Time, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanGetLastSeen)
+	defer span.End()
+
+	key := fmt.Sprintf("%s:%s", config.Get().Redict.Prefix, keyLastSeen)
+	value, err := r.redict.Get(sctx, key).Result()
+	switch err {
+	case nil:
+	case redis.Nil:
+		span.SetStatus(codes.Ok, "ok")
+		return time.Time{}, nil
+	default:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return time.Time{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return time.Time{}, err
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+	return t, nil
+}
+
+func (r *Backend) GetNames(ctx context.Context, ids []string) (map[string]string, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanGetNames)
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("count", len(ids)))
+
+	names := make(map[string]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = fmt.Sprintf("%s:%s:%s", config.Get().Redict.Prefix, keyName, id)
+	}
+
+	values, err := r.redict.MGet(sctx, keys...).Result()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	for i, value := range values {
+		if name, ok := value.(string); ok {
+			names[ids[i]] = name
+		}
+	}
+
+	span.SetAttributes(attribute.Int("hits", len(names)))
+	span.SetStatus(codes.Ok, "ok")
+	return names, nil
+}
+
+func (r *Backend) SetNames(ctx context.Context, names map[string]string, ttl time.Duration) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanSetNames)
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("count", len(names)))
+
+	pipe := r.redict.Pipeline()
+	for id, name := range names {
+		key := fmt.Sprintf("%s:%s:%s", config.Get().Redict.Prefix, keyName, id)
+		pipe.Set(sctx, key, name, ttl)
+	}
+
+	if _, err := pipe.Exec(sctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+	return nil
+}
+
+func (r *Backend) GetFilter(ctx context.Context, name string) ([]string, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanGetFilter)
+	defer span.End()
+
+	span.SetAttributes(attribute.String("filter", name))
+
+	key := fmt.Sprintf("%s:%s:%s", config.Get().Redict.Prefix, keyFilter, name)
+	values, err := r.redict.SMembers(sctx, key).Result()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+	return values, nil
+}
+
+func (r *Backend) AddFilter(ctx context.Context, name, value string) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanAddFilter)
+	defer span.End()
+
+	span.SetAttributes(attribute.String("filter", name), attribute.String("value", value))
+
+	key := fmt.Sprintf("%s:%s:%s", config.Get().Redict.Prefix, keyFilter, name)
+	if _, err := r.redict.SAdd(sctx, key, value).Result(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+	return nil
+}
+
+func (r *Backend) RemoveFilter(ctx context.Context, name, value string) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanRemoveFilter)
+	defer span.End()
+
+	span.SetAttributes(attribute.String("filter", name), attribute.String("value", value))
+
+	key := fmt.Sprintf("%s:%s:%s", config.Get().Redict.Prefix, keyFilter, name)
+	if _, err := r.redict.SRem(sctx, key, value).Result(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+	return nil
+}
+
+func (r *Backend) AddOutbox(ctx context.Context, id, entry string) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanAddOutbox)
+	defer span.End()
+
+	span.SetAttributes(attribute.String("id", id))
+
+	key := fmt.Sprintf("%s:%s", config.Get().Redict.Prefix, keyOutbox)
+	if err := r.redict.HSet(sctx, key, id, entry).Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+	return nil
+}
+
+func (r *Backend) GetOutbox(ctx context.Context) (map[string]string, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanGetOutbox)
+	defer span.End()
+
+	key := fmt.Sprintf("%s:%s", config.Get().Redict.Prefix, keyOutbox)
+	entries, err := r.redict.HGetAll(sctx, key).Result()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("count", len(entries)))
+	span.SetStatus(codes.Ok, "ok")
+	return entries, nil
+}
+
+func (r *Backend) RemoveOutbox(ctx context.Context, id string) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanRemoveOutbox)
+	defer span.End()
+
+	span.SetAttributes(attribute.String("id", id))
+
+	key := fmt.Sprintf("%s:%s", config.Get().Redict.Prefix, keyOutbox)
+	if err := r.redict.HDel(sctx, key, id).Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+	return nil
+}
+
+func (r *Backend) AddDeadLetter(ctx context.Context, id, entry string) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanAddDeadLetter)
+	defer span.End()
+
+	span.SetAttributes(attribute.String("id", id))
+
+	key := fmt.Sprintf("%s:%s", config.Get().Redict.Prefix, keyDeadLetters)
+	if err := r.redict.HSet(sctx, key, id, entry).Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+	return nil
+}
+
+func (r *Backend) GetDeadLetters(ctx context.Context) (map[string]string, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanGetDeadLetters)
+	defer span.End()
+
+	key := fmt.Sprintf("%s:%s", config.Get().Redict.Prefix, keyDeadLetters)
+	entries, err := r.redict.HGetAll(sctx, key).Result()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("count", len(entries)))
+	span.SetStatus(codes.Ok, "ok")
+	return entries, nil
+}
+
+func (r *Backend) RemoveDeadLetter(ctx context.Context, id string) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, spanRemoveDeadLetter)
+	defer span.End()
+
+	span.SetAttributes(attribute.String("id", id))
+
+	key := fmt.Sprintf("%s:%s", config.Get().Redict.Prefix, keyDeadLetters)
+	if err := r.redict.HDel(sctx, key, id).Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+	return nil
+}
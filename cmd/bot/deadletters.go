@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"git.sr.ht/~barveyhirdman/chainkills/backend"
+	"git.sr.ht/~barveyhirdman/chainkills/notify"
+)
+
+const deadLettersUsage = `usage: bot [-config path] dead-letters <command>
+
+commands:
+  list               list the deliveries that failed every attempt
+  inspect <id>       show a dead letter with its error
+  replay <id|all>    send dead letters again
+  delete <id|all>    delete dead letters`
+
+// runDeadLetters runs the dead-letters subcommand and returns the exit code.
+// Replayed deliveries go back to the outbox, the running bot sends them
+// within a minute.
+func runDeadLetters(ctx context.Context, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, deadLettersUsage)
+		return 2
+	}
+
+	cache, err := backend.Backend()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to get backend:", err)
+		return 1
+	}
+	commands := notify.NewDeadLetterCommands(notify.NewDeadLetters(cache, notify.NewOutbox(cache)), nil)
+
+	command, id := args[0], ""
+	if len(args) > 1 {
+		id = args[1]
+	}
+
+	switch {
+	case command == "list":
+		lines, err := commands.List(ctx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to list dead letters:", err)
+			return 1
+		}
+		if len(lines) == 0 {
+			fmt.Println("no dead letters")
+		}
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+	case command == "inspect" && id != "":
+		details, err := commands.Inspect(ctx, id)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Println(details)
+	case command == "replay" && id != "":
+		count, err := commands.Replay(ctx, id)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to replay dead letters:", err)
+			return 1
+		}
+		fmt.Printf("replayed %d dead letters, the bot sends them within a minute\n", count)
+	case command == "delete" && id != "":
+		count, err := commands.Delete(ctx, id)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to delete dead letters:", err)
+			return 1
+		}
+		fmt.Printf("deleted %d dead letters\n", count)
+	default:
+		fmt.Fprintln(os.Stderr, deadLettersUsage)
+		return 2
+	}
+
+	return 0
+}
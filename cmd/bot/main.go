@@ -3,23 +3,31 @@ package main
 import (
 	"context"
 	"flag"
-	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
-	"strings"
 	"sync"
 	"time"
 
+	"git.sr.ht/~barveyhirdman/chainkills/backend"
 	"git.sr.ht/~barveyhirdman/chainkills/common"
 	"git.sr.ht/~barveyhirdman/chainkills/config"
 	"git.sr.ht/~barveyhirdman/chainkills/discord"
+	"git.sr.ht/~barveyhirdman/chainkills/esi"
 	"git.sr.ht/~barveyhirdman/chainkills/instrumentation"
+	"git.sr.ht/~barveyhirdman/chainkills/notify"
 	"git.sr.ht/~barveyhirdman/chainkills/systems"
 	"git.sr.ht/~barveyhirdman/chainkills/version"
 	"github.com/bwmarrin/discordgo"
 )
 
+const (
+	// How long to wait for messages being sent when stopping
+	shutdownTimeout = 10 * time.Second
+	// How often to look for deliveries in the outbox that aren't queued
+	outboxInterval = time.Minute
+)
+
 var (
 	configPath string
 	ver        bool
@@ -53,6 +61,10 @@ func main() {
 	l := slog.New(h).With("version", config.Get().Version)
 	slog.SetDefault(l)
 
+	if flag.Arg(0) == "dead-letters" {
+		os.Exit(runDeadLetters(rootCtx, flag.Args()[1:]))
+	}
+
 	shutdownFns, err := instrumentation.Init(rootCtx)
 	if err != nil {
 		slog.Error("failed to initialize tracer", "error", err)
@@ -64,82 +76,38 @@ func main() {
 		}
 	}()
 
-	discord.Init()
-	session, err := discordgo.New("Bot " + config.Get().Discord.Token)
+	cache, err := backend.Backend()
 	if err != nil {
-		slog.Error("failed to create discord session", "error", err)
+		slog.Error("failed to get backend", "error", err)
 		os.Exit(1)
 	}
+	resolver := esi.NewResolver(
+		config.Get().ESI.BaseURL,
+		cache,
+		time.Duration(config.Get().ESI.NameTTL)*time.Minute,
+	)
+	systems.SetNameResolver(resolver)
+	systems.SetUniverseResolver(resolver)
 
-	session.Identify.Intents = discordgo.IntentsGuilds | discordgo.IntentsGuildMessages | discordgo.IntentsMessageContent
-
-	registeredCommands := make(map[string]*discordgo.ApplicationCommand, 0)
-	registeredHandlers := make(map[string]func(), 0)
-
-	registeredHandlers["HandleGuildCreate"] = session.AddHandler(discord.HandleGuildCreate)
-	registeredHandlers["HandleGuildDelete"] = session.AddHandler(discord.HandleGuildDelete)
-	registeredHandlers["HandleSlasCommand"] = session.AddHandler(discord.HandleSlashCommand)
+	discord.Init()
 
-	commands := []*discordgo.ApplicationCommand{
-		discord.IgnoreSystemIDCommand,
-		discord.IgnoreSystemNameCommand,
-		discord.IgnoreRegionIDCommand,
-	}
+	// without a token there is no bot, kills go to webhooks and other platforms
+	var session *discordgo.Session
 	cmdWg := &sync.WaitGroup{}
-	session.AddHandler(func(s *discordgo.Session, m *discordgo.Ready) {
-		for _, cmd := range commands {
-			cmdWg.Add(1)
-
-			go func(c *discordgo.ApplicationCommand) {
-				defer cmdWg.Done()
-
-				if _, err := s.ApplicationCommandCreate(s.State.User.ID, "", c); err != nil {
-					slog.Error("failed to register command", "command", cmd.Name, "error", err)
-					return
-				}
-
-				opts := make([]string, 0, len(c.Options))
-				for _, opt := range c.Options {
-					opts = append(opts, fmt.Sprintf("%s - %s", opt.Name, opt.Type.String()))
-				}
-				slog.Info("registered command", "command", c.Name, "options", strings.Join(opts, ", "))
-				registeredCommands[c.Name] = c
-			}(cmd)
-		}
-	})
-
-	defer func() {
-		if session.State != nil && session.State.User != nil {
-			for _, v := range registeredHandlers {
-				v()
-			}
-
-			for _, v := range registeredCommands {
-				err := session.ApplicationCommandDelete(session.State.User.ID, "", v.ID)
-				if err != nil {
-					slog.Error("failed to delete command", "comand", v.Name, "error", err)
-				}
-			}
-		}
-
-		if err := session.Close(); err != nil {
-			slog.Error("failed to close discord session", "error", err)
-		}
-	}()
-
-	if config.Get().Discord.Verbose {
-		session.LogLevel = discordgo.LogDebug
+	if config.Get().Discord.Token != "" {
+		var closeSession func()
+		session, closeSession = openSession(cmdWg)
+		defer closeSession()
+	} else {
+		slog.Info("no discord token configured, not starting the bot")
 	}
 
-	if err := session.Open(); err != nil {
-		slog.Error("failed to open discord session", "error", err)
-		os.Exit(1)
-	}
-
-	register := systems.Register()
-	if _, err := register.Update(rootCtx); err != nil {
-		slog.Error("failed to update systems", "error", err)
-		os.Exit(1)
+	registers := systems.Registers()
+	for _, register := range registers.All() {
+		if _, err := register.Update(rootCtx); err != nil {
+			slog.Error("failed to update systems", "map", register.Map().Name, "error", err)
+			os.Exit(1)
+		}
 	}
 
 	// wait for commands to be registered
@@ -152,83 +120,107 @@ func main() {
 	slog.Debug("starting ticker", "interval", tickerDuration.String())
 	tick := time.NewTicker(tickerDuration)
 
-	errors := make(chan error)
 	go func() {
-		for {
-			select {
-			case <-tick.C:
-				_, err := register.Update(rootCtx)
-				if err != nil {
-					slog.Error("failed to update systems", "error", err)
+		for range tick.C {
+			for _, register := range registers.All() {
+				if _, err := register.Update(rootCtx); err != nil {
+					slog.Error("failed to update systems", "map", register.Map().Name, "error", err)
 				}
-			case e := <-errors:
-				slog.Error("error received", "error", e)
 			}
 		}
 	}()
 
-	stop := make(chan struct{})
-	fetchLoop := true
-	go func() {
-		retries := 0
-		for {
-			if err := systems.StartListener(out, stop, errors); err != nil {
-				slog.Error("failed to start listener", "error", err)
+	for _, register := range registers.All() {
+		if !register.Map().Events {
+			continue
+		}
+
+		go func() {
+			if err := register.ListenMapEvents(rootCtx); err != nil {
+				slog.Error("stopped listening to map events", "map", register.Map().Name, "error", err)
 			}
-			if !fetchLoop {
-				return
+		}()
+	}
+
+	sources := make([]systems.Source, 0)
+	for _, name := range config.Get().Zkillboard.SourceNames() {
+		source, err := systems.NewSource(name)
+		if err != nil {
+			slog.Error("failed to create source", "source", name, "error", err)
+			os.Exit(1)
+		}
+		sources = append(sources, source)
+	}
+
+	mux := systems.NewMultiplexer(config.Get().Zkillboard.Mode, sources...)
+	muxDone := make(chan struct{})
+	go func() {
+		defer close(muxDone)
+		if err := mux.Start(rootCtx, out); err != nil {
+			slog.Error("failed to start sources", "error", err)
+		}
+	}()
+
+	notifiers := notify.Notifiers{
+		notify.PlatformDiscord:  notify.NewDiscord(session, discord.NewWebhookSender()),
+		notify.PlatformSlack:    notify.NewSlack(),
+		notify.PlatformMatrix:   notify.NewMatrix(config.Get().Matrix.Homeserver, config.Get().Matrix.Token),
+		notify.PlatformTelegram: notify.NewTelegram(config.Get().Telegram.BaseURL, config.Get().Telegram.Token),
+		notify.PlatformHTTP:     notify.NewHTTP(config.Get().HTTP.Secret),
+	}
+
+	// deliveries stay in the outbox until they were sent, whatever is left
+	// when the bot stops is sent on the next start
+	outbox := notify.NewOutbox(cache)
+	deadLetters := notify.NewDeadLetters(cache, outbox)
+	dispatcher := notify.NewDispatcher(rootCtx, notifiers, outbox, deadLetters, config.Get().Delivery)
+	discord.SetDeadLetters(notify.NewDeadLetterCommands(deadLetters, dispatcher))
+
+	// picks up dead letters replayed from the command line
+	outboxTick := time.NewTicker(outboxInterval)
+	go func() {
+		for range outboxTick.C {
+			if err := dispatcher.SendPending(rootCtx); err != nil {
+				slog.Error("failed to read outbox", "error", err)
 			}
-			dur := 5 * time.Second
-			slog.Warn("listener failed", "retries", retries, "sleep", dur.String())
-			time.Sleep(dur)
 		}
 	}()
 
+	sendDone := make(chan struct{})
 	go func() {
+		defer close(sendDone)
+
+		if err := dispatcher.SendPending(rootCtx); err != nil {
+			slog.Error("failed to read outbox", "error", err)
+		}
+
 		for msg := range out {
 			if msg.KillmailID == 0 {
 				continue
 			}
 
-			channels := config.Get().Discord.Channels
-
-			validChannels := make([]string, 0)
-			for _, c := range channels {
-				if _, err := session.State.Channel(c); err == nil {
-					validChannels = append(validChannels, c)
-				} else {
-					slog.Warn("channel not found", "channel", c)
-				}
-			}
+			destinations := registers.Destinations(rootCtx, msg)
 			if config.Get().Discord.DryRun {
 				slog.Warn("dry run enabled, not sending message",
 					"message", msg,
-					"channels", validChannels,
+					"destinations", destinations,
 				)
 				continue
 			}
 
-			embed, err := msg.Embed()
+			notification := notify.Notification{
+				KillmailID: msg.KillmailID,
+				Embed:      msg.Embed(rootCtx),
+				Payload:    msg.Payload(rootCtx),
+			}
+			deliveries, err := outbox.Add(rootCtx, notification, destinations)
 			if err != nil {
-				slog.Error("failed to prepare embed", "error", err)
-				return
+				// still worth sending, it just won't survive a restart
+				slog.Error("failed to add deliveries to outbox", "id", msg.KillmailID, "error", err)
 			}
-			cwg := &sync.WaitGroup{}
-			common.GetBackpressureMonitor().Increase("channel_send")
-			for _, channel := range validChannels {
-				cwg.Add(1)
-				go func(ccc string) {
-					defer func() {
-						common.GetBackpressureMonitor().Decrease("channel_send")
-						cwg.Done()
-					}()
-					if _, err := session.ChannelMessageSendEmbed(ccc, embed); err != nil {
-						slog.Error("failed to send message", "error", err)
-						return
-					}
-				}(channel)
+			for _, d := range deliveries {
+				dispatcher.Send(d)
 			}
-			cwg.Wait()
 
 			common.GetBackpressureMonitor().Decrease("killmail")
 		}
@@ -238,9 +230,18 @@ func main() {
 	signal.Notify(sigChan, os.Interrupt)
 
 	<-sigChan
-	fetchLoop = false
-	close(stop)
+	mux.Stop()
+	<-muxDone
 	tick.Stop()
+	outboxTick.Stop()
 	close(out)
+	<-sendDone
+	dispatcher.Close()
+
+	select {
+	case <-dispatcher.Done():
+	case <-time.After(shutdownTimeout):
+		slog.Warn("stopped waiting for messages to be sent, they stay in the outbox")
+	}
 	slog.Info("exiting")
 }
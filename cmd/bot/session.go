@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"git.sr.ht/~barveyhirdman/chainkills/discord"
+	"github.com/bwmarrin/discordgo"
+)
+
+// openSession connects the bot and registers its commands once Discord
+// reports ready, cmdWg is done when they are all registered. The returned
+// function removes the commands and closes the session.
+func openSession(cmdWg *sync.WaitGroup) (*discordgo.Session, func()) {
+	session, err := discordgo.New("Bot " + config.Get().Discord.Token)
+	if err != nil {
+		slog.Error("failed to create discord session", "error", err)
+		os.Exit(1)
+	}
+
+	session.Identify.Intents = discordgo.IntentsGuilds | discordgo.IntentsGuildMessages | discordgo.IntentsMessageContent
+
+	registeredCommands := make(map[string]*discordgo.ApplicationCommand, 0)
+	registeredHandlers := make(map[string]func(), 0)
+
+	registeredHandlers["HandleGuildCreate"] = session.AddHandler(discord.HandleGuildCreate)
+	registeredHandlers["HandleGuildDelete"] = session.AddHandler(discord.HandleGuildDelete)
+	registeredHandlers["HandleSlasCommand"] = session.AddHandler(discord.HandleSlashCommand)
+
+	commands := []*discordgo.ApplicationCommand{
+		discord.IgnoreSystemIDCommand,
+		discord.IgnoreSystemNameCommand,
+		discord.IgnoreRegionIDCommand,
+		discord.ShipFilterCommand,
+		discord.SystemFilterCommand,
+		discord.DeadLettersCommand,
+	}
+	session.AddHandler(func(s *discordgo.Session, m *discordgo.Ready) {
+		for _, cmd := range commands {
+			cmdWg.Add(1)
+
+			go func(c *discordgo.ApplicationCommand) {
+				defer cmdWg.Done()
+
+				if _, err := s.ApplicationCommandCreate(s.State.User.ID, "", c); err != nil {
+					slog.Error("failed to register command", "command", cmd.Name, "error", err)
+					return
+				}
+
+				opts := make([]string, 0, len(c.Options))
+				for _, opt := range c.Options {
+					opts = append(opts, fmt.Sprintf("%s - %s", opt.Name, opt.Type.String()))
+				}
+				slog.Info("registered command", "command", c.Name, "options", strings.Join(opts, ", "))
+				registeredCommands[c.Name] = c
+			}(cmd)
+		}
+	})
+
+	closeSession := func() {
+		if session.State != nil && session.State.User != nil {
+			for _, v := range registeredHandlers {
+				v()
+			}
+
+			for _, v := range registeredCommands {
+				err := session.ApplicationCommandDelete(session.State.User.ID, "", v.ID)
+				if err != nil {
+					slog.Error("failed to delete command", "comand", v.Name, "error", err)
+				}
+			}
+		}
+
+		if err := session.Close(); err != nil {
+			slog.Error("failed to close discord session", "error", err)
+		}
+	}
+
+	if config.Get().Discord.Verbose {
+		session.LogLevel = discordgo.LogDebug
+	}
+
+	if err := session.Open(); err != nil {
+		slog.Error("failed to open discord session", "error", err)
+		os.Exit(1)
+	}
+
+	return session, closeSession
+}
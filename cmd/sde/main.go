@@ -0,0 +1,79 @@
+// Command sde regenerates the static data in the systems package from the
+// Fuzzwork CSV conversion of the EVE static data export.
+//
+// Download mapRegions, mapConstellations, mapSolarSystems,
+// mapLocationWormholeClasses, invCategories, invGroups and invTypes from
+// https://www.fuzzwork.co.uk/dump/latest/ into one directory, either as .csv
+// or .csv.bz2, and run
+//
+//	go run ./cmd/sde -sde path/to/dump
+//
+// make static does both, it downloads the dump into ./sde unless the
+// directory already exists.
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	sdePath    string
+	outPath    string
+	categories string
+)
+
+func main() {
+	flag.StringVar(&sdePath, "sde", "sde", "Directory containing the SDE CSV dumps")
+	flag.StringVar(&outPath, "out", "systems", "Directory to write the generated files to")
+	flag.StringVar(&categories, "categories", "6,18,22,23,40,46,65,87", "Comma separated inventory category IDs to include")
+	flag.Parse()
+
+	categoryIDs := make([]int, 0)
+	for _, c := range strings.Split(categories, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(c))
+		if err != nil {
+			slog.Error("invalid category id", "category", c, "error", err)
+			os.Exit(1)
+		}
+		categoryIDs = append(categoryIDs, id)
+	}
+
+	u, err := readUniverse(sdePath)
+	if err != nil {
+		slog.Error("failed to read universe", "error", err)
+		os.Exit(1)
+	}
+
+	if err := write(filepath.Join(outPath, "static.go"), systemsTemplate, u); err != nil {
+		slog.Error("failed to write systems", "error", err)
+		os.Exit(1)
+	}
+
+	slog.Info("generated systems",
+		"regions", len(u.Regions),
+		"constellations", len(u.Constellations),
+		"systems", len(u.Systems),
+	)
+
+	inv, err := readInventory(sdePath, categoryIDs)
+	if err != nil {
+		slog.Error("failed to read inventory", "error", err)
+		os.Exit(1)
+	}
+
+	if err := write(filepath.Join(outPath, "static_inventory.go"), inventoryTemplate, inv); err != nil {
+		slog.Error("failed to write inventory", "error", err)
+		os.Exit(1)
+	}
+
+	slog.Info("generated inventory",
+		"categories", len(inv.Categories),
+		"groups", len(inv.Groups),
+		"types", len(inv.Types),
+	)
+}
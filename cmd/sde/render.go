@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"math"
+	"os"
+	"strconv"
+	"text/template"
+)
+
+const header = `// Code generated by cmd/sde from the EVE static data export. DO NOT EDIT.
+
+package systems
+`
+
+var systemsTemplate = template.Must(template.New("systems").Funcs(template.FuncMap{
+	"security": formatSecurity,
+}).Parse(header + `
+var cachedRegions = map[int]CachedRegion{
+{{- range .Regions }}
+	{{ .ID }}: {RegionID: {{ .ID }}, RegionName: {{ printf "%q" .Name }}},
+{{- end }}
+}
+
+var cachedConstellations = map[int]CachedConstellation{
+{{- range .Constellations }}
+	{{ .ID }}: {ConstellationID: {{ .ID }}, ConstellationName: {{ printf "%q" .Name }}, RegionID: {{ .RegionID }}},
+{{- end }}
+}
+
+var cachedSystems = map[int]CachedSystem{
+{{- range .Systems }}
+	{{ .ID }}: {RegionID: {{ .RegionID }}, SystemID: {{ .ID }}, SystemName: {{ printf "%q" .Name }}
+		{{- if .ConstellationID }}, ConstellationID: {{ .ConstellationID }}{{ end }}
+		{{- if .Security }}, Security: {{ security .Security }}{{ end }}
+		{{- if .Class }}, WormholeClass: {{ .Class }}{{ end }}},
+{{- end }}
+}
+`))
+
+var inventoryTemplate = template.Must(template.New("inventory").Parse(header + `
+var cachedCategories = map[int]CachedCategory{
+{{- range .Categories }}
+	{{ .ID }}: {CategoryID: {{ .ID }}, CategoryName: {{ printf "%q" .Name }}},
+{{- end }}
+}
+
+var cachedGroups = map[int]CachedGroup{
+{{- range .Groups }}
+	{{ .ID }}: {GroupID: {{ .ID }}, GroupName: {{ printf "%q" .Name }}, CategoryID: {{ .CategoryID }}},
+{{- end }}
+}
+
+var cachedTypes = map[int]CachedType{
+{{- range .Types }}
+	{{ .ID }}: {TypeID: {{ .ID }}, TypeName: {{ printf "%q" .Name }}, GroupID: {{ .GroupID }}},
+{{- end }}
+}
+`))
+
+// formatSecurity keeps four decimals, which is enough to round the status the
+// same way the game does.
+func formatSecurity(security float64) string {
+	return strconv.FormatFloat(math.Round(security*1e4)/1e4, 'f', -1, 64)
+}
+
+func render(t *template.Template, data any) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, data); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+
+	return src, nil
+}
+
+func write(path string, t *template.Template, data any) error {
+	src, err := render(t, data)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, src, 0644)
+}
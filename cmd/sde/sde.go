@@ -0,0 +1,335 @@
+package main
+
+import (
+	"compress/bzip2"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Wormhole classes the generator needs to know about, as used by
+// mapLocationWormholeClasses.
+const (
+	classHighsec = 7
+	classLowsec  = 8
+	classNullsec = 9
+
+	// Region IDs from here on are wormhole, abyssal and other non k-space
+	// regions
+	firstWormholeRegionID = 11000000
+)
+
+type region struct {
+	ID   int
+	Name string
+}
+
+type constellation struct {
+	ID       int
+	Name     string
+	RegionID int
+}
+
+type system struct {
+	ID              int
+	Name            string
+	RegionID        int
+	ConstellationID int
+	Security        float64
+	Class           int
+}
+
+type universe struct {
+	Regions        []region
+	Constellations []constellation
+	Systems        []system
+}
+
+type category struct {
+	ID   int
+	Name string
+}
+
+type group struct {
+	ID         int
+	Name       string
+	CategoryID int
+}
+
+type itemType struct {
+	ID      int
+	Name    string
+	GroupID int
+}
+
+type inventory struct {
+	Categories []category
+	Groups     []group
+	Types      []itemType
+}
+
+// readTable reads a CSV dump from the directory, either plain or bzip2
+// compressed as served by Fuzzwork, and returns its rows keyed by column.
+func readTable(dir, name string) ([]map[string]string, error) {
+	var r io.Reader
+
+	fp, err := os.Open(filepath.Join(dir, name+".csv"))
+	switch {
+	case err == nil:
+		r = fp
+	case errors.Is(err, fs.ErrNotExist):
+		fp, err = os.Open(filepath.Join(dir, name+".csv.bz2"))
+		if err != nil {
+			return nil, err
+		}
+		r = bzip2.NewReader(fp)
+	default:
+		return nil, err
+	}
+	defer fp.Close()
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header of %s: %w", name, err)
+	}
+
+	rows := make([]map[string]string, 0)
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+
+		row := make(map[string]string, len(header))
+		for i, column := range header {
+			if i < len(record) {
+				row[column] = strings.TrimSpace(record[i])
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// hasValue reports whether a column is set. The dumps use None for NULL.
+func hasValue(row map[string]string, column string) bool {
+	v, ok := row[column]
+	return ok && v != "" && v != "None"
+}
+
+func intValue(row map[string]string, column string) (int, error) {
+	if !hasValue(row, column) {
+		return 0, nil
+	}
+
+	v, err := strconv.Atoi(row[column])
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", column, row[column], err)
+	}
+
+	return v, nil
+}
+
+// readUniverse loads regions, constellations and systems. Wormhole classes
+// are optional; without them only k-space classes derived from security
+// status are set.
+func readUniverse(dir string) (universe, error) {
+	var u universe
+
+	regionRows, err := readTable(dir, "mapRegions")
+	if err != nil {
+		return u, err
+	}
+	for _, row := range regionRows {
+		id, err := intValue(row, "regionID")
+		if err != nil {
+			return u, err
+		}
+		u.Regions = append(u.Regions, region{ID: id, Name: row["regionName"]})
+	}
+
+	constellationRows, err := readTable(dir, "mapConstellations")
+	if err != nil {
+		return u, err
+	}
+	for _, row := range constellationRows {
+		id, err := intValue(row, "constellationID")
+		if err != nil {
+			return u, err
+		}
+		regionID, err := intValue(row, "regionID")
+		if err != nil {
+			return u, err
+		}
+		u.Constellations = append(u.Constellations, constellation{
+			ID:       id,
+			Name:     row["constellationName"],
+			RegionID: regionID,
+		})
+	}
+
+	classes := make(map[int]int)
+	classRows, err := readTable(dir, "mapLocationWormholeClasses")
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return u, err
+	}
+	for _, row := range classRows {
+		location, err := intValue(row, "locationID")
+		if err != nil {
+			return u, err
+		}
+		class, err := intValue(row, "wormholeClassID")
+		if err != nil {
+			return u, err
+		}
+		classes[location] = class
+	}
+
+	systemRows, err := readTable(dir, "mapSolarSystems")
+	if err != nil {
+		return u, err
+	}
+	for _, row := range systemRows {
+		var s system
+		if s.ID, err = intValue(row, "solarSystemID"); err != nil {
+			return u, err
+		}
+		if s.RegionID, err = intValue(row, "regionID"); err != nil {
+			return u, err
+		}
+		if s.ConstellationID, err = intValue(row, "constellationID"); err != nil {
+			return u, err
+		}
+		s.Name = row["solarSystemName"]
+
+		hasSecurity := hasValue(row, "security")
+		if hasSecurity {
+			if s.Security, err = strconv.ParseFloat(row["security"], 64); err != nil {
+				return u, fmt.Errorf("invalid security of %s: %w", s.Name, err)
+			}
+		}
+
+		s.Class = systemClass(s, classes, hasSecurity)
+		u.Systems = append(u.Systems, s)
+	}
+
+	slices.SortFunc(u.Regions, func(a, b region) int { return a.ID - b.ID })
+	slices.SortFunc(u.Constellations, func(a, b constellation) int { return a.ID - b.ID })
+	slices.SortFunc(u.Systems, func(a, b system) int { return a.ID - b.ID })
+
+	return u, nil
+}
+
+// systemClass picks the most specific wormhole class for the system. The SDE
+// only has coarse classes for k-space, so they're derived from the security
+// status instead.
+func systemClass(s system, classes map[int]int, hasSecurity bool) int {
+	class := classes[s.ID]
+	if class == 0 {
+		class = classes[s.ConstellationID]
+	}
+	if class == 0 {
+		class = classes[s.RegionID]
+	}
+
+	kspace := class == 0 || class == classHighsec || class == classLowsec || class == classNullsec
+	if s.RegionID >= firstWormholeRegionID || !kspace || !hasSecurity {
+		return class
+	}
+
+	switch {
+	case math.Round(s.Security*10)/10 >= 0.5:
+		return classHighsec
+	case s.Security > 0:
+		return classLowsec
+	default:
+		return classNullsec
+	}
+}
+
+// readInventory loads the published types of the given categories along with
+// their groups.
+func readInventory(dir string, categories []int) (inventory, error) {
+	var inv inventory
+
+	categoryRows, err := readTable(dir, "invCategories")
+	if err != nil {
+		return inv, err
+	}
+	for _, row := range categoryRows {
+		id, err := intValue(row, "categoryID")
+		if err != nil {
+			return inv, err
+		}
+		if !slices.Contains(categories, id) {
+			continue
+		}
+		inv.Categories = append(inv.Categories, category{ID: id, Name: row["categoryName"]})
+	}
+
+	groupRows, err := readTable(dir, "invGroups")
+	if err != nil {
+		return inv, err
+	}
+	groups := make(map[int]struct{})
+	for _, row := range groupRows {
+		var g group
+		if g.ID, err = intValue(row, "groupID"); err != nil {
+			return inv, err
+		}
+		if g.CategoryID, err = intValue(row, "categoryID"); err != nil {
+			return inv, err
+		}
+		if !slices.Contains(categories, g.CategoryID) {
+			continue
+		}
+		g.Name = row["groupName"]
+		groups[g.ID] = struct{}{}
+		inv.Groups = append(inv.Groups, g)
+	}
+
+	typeRows, err := readTable(dir, "invTypes")
+	if err != nil {
+		return inv, err
+	}
+	for _, row := range typeRows {
+		if hasValue(row, "published") && row["published"] != "1" {
+			continue
+		}
+
+		var t itemType
+		if t.ID, err = intValue(row, "typeID"); err != nil {
+			return inv, err
+		}
+		if t.GroupID, err = intValue(row, "groupID"); err != nil {
+			return inv, err
+		}
+		if _, ok := groups[t.GroupID]; !ok {
+			continue
+		}
+		t.Name = row["typeName"]
+		inv.Types = append(inv.Types, t)
+	}
+
+	slices.SortFunc(inv.Categories, func(a, b category) int { return a.ID - b.ID })
+	slices.SortFunc(inv.Groups, func(a, b group) int { return a.ID - b.ID })
+	slices.SortFunc(inv.Types, func(a, b itemType) int { return a.ID - b.ID })
+
+	return inv, nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadUniverse(t *testing.T) {
+	u, err := readUniverse("testdata")
+	require.NoError(t, err)
+
+	require.Len(t, u.Regions, 4)
+	require.Equal(t, region{ID: 10000002, Name: "The Forge"}, u.Regions[0])
+	require.Len(t, u.Constellations, 4)
+
+	classes := make(map[string]int)
+	for _, s := range u.Systems {
+		classes[s.Name] = s.Class
+	}
+
+	tests := []struct {
+		label    string
+		system   string
+		expected int
+	}{
+		{label: "highsec", system: "Jita", expected: classHighsec},
+		{label: "rounded up to highsec", system: "Highsec Edge", expected: classHighsec},
+		{label: "lowsec", system: "Lowsec", expected: classLowsec},
+		{label: "nullsec", system: "Nullsec", expected: classNullsec},
+		{label: "pochven from region", system: "Otela", expected: 25},
+		{label: "wormhole from region", system: "J210000", expected: 1},
+		{label: "drifter from system", system: "J055520", expected: 14},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			require.Equal(t, tt.expected, classes[tt.system])
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestReadInventory(t *testing.T) {
+	inv, err := readInventory("testdata", []int{6})
+	require.NoError(t, err)
+
+	require.Equal(t, []category{{ID: 6, Name: "Ship"}}, inv.Categories)
+	require.Len(t, inv.Groups, 2)
+	require.Equal(t, []itemType{
+		{ID: 587, Name: "Rifter", GroupID: 25},
+		{ID: 670, Name: "Capsule", GroupID: 29},
+	}, inv.Types)
+}
+
+func TestRender(t *testing.T) {
+	u, err := readUniverse("testdata")
+	require.NoError(t, err)
+
+	src, err := render(systemsTemplate, u)
+	require.NoError(t, err)
+	require.Contains(t, string(src), `30000142: {RegionID: 10000002, SystemID: 30000142, SystemName: "Jita", ConstellationID: 20000020, Security: 0.9459, WormholeClass: 7},`)
+	require.Contains(t, string(src), `20000788: {ConstellationID: 20000788, ConstellationName: "Krai Perun", RegionID: 10000070},`)
+
+	inv, err := readInventory("testdata", []int{6})
+	require.NoError(t, err)
+
+	src, err = render(inventoryTemplate, inv)
+	require.NoError(t, err)
+	require.Contains(t, string(src), `670: {TypeID: 670, TypeName: "Capsule", GroupID: 29},`)
+}
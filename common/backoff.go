@@ -0,0 +1,61 @@
+package common
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Backoff calculates exponentially growing delays with random jitter.
+type Backoff struct {
+	mx *sync.Mutex
+
+	min     time.Duration
+	max     time.Duration
+	factor  float64
+	jitter  float64
+	attempt int
+}
+
+func NewBackoff(min, max time.Duration) *Backoff {
+	return &Backoff{
+		mx:     &sync.Mutex{},
+		min:    min,
+		max:    max,
+		factor: 2,
+		jitter: 0.2,
+	}
+}
+
+// Next returns the delay before the next attempt and increases the attempt
+// counter.
+func (b *Backoff) Next() time.Duration {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	delay := float64(b.min) * math.Pow(b.factor, float64(b.attempt))
+	if delay > float64(b.max) || math.IsInf(delay, 0) {
+		delay = float64(b.max)
+	}
+	b.attempt++
+
+	// spread the delay by +/- jitter so clients don't reconnect in lockstep
+	delay += delay * b.jitter * (2*rand.Float64() - 1)
+
+	return time.Duration(delay)
+}
+
+func (b *Backoff) Reset() {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	b.attempt = 0
+}
+
+func (b *Backoff) Attempt() int {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	return b.attempt
+}
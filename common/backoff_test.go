@@ -0,0 +1,31 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackoff(t *testing.T) {
+	b := NewBackoff(time.Second, 10*time.Second)
+
+	expected := []time.Duration{
+		time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		8 * time.Second,
+		10 * time.Second,
+		10 * time.Second,
+	}
+
+	for i, e := range expected {
+		d := b.Next()
+		require.InDelta(t, float64(e), float64(d), float64(e)*0.2, "attempt %d", i)
+	}
+	require.Equal(t, len(expected), b.Attempt())
+
+	b.Reset()
+	require.Equal(t, 0, b.Attempt())
+	require.InDelta(t, float64(time.Second), float64(b.Next()), float64(time.Second)*0.2)
+}
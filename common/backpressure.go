@@ -9,9 +9,15 @@ import (
 	"sync"
 )
 
-var monitor *BackpressureMonitor
+var (
+	monitor   *BackpressureMonitor
+	monitorMx = &sync.Mutex{}
+)
 
 func GetBackpressureMonitor() *BackpressureMonitor {
+	monitorMx.Lock()
+	defer monitorMx.Unlock()
+
 	if monitor == nil {
 		monitor = NewBackpressureMonitor()
 	}
@@ -20,6 +26,8 @@ func GetBackpressureMonitor() *BackpressureMonitor {
 }
 
 type BackpressureMonitor struct {
+	mx *sync.Mutex
+
 	services map[string]*Service
 }
 
@@ -46,15 +54,18 @@ func (s Service) String() string {
 
 func NewBackpressureMonitor() *BackpressureMonitor {
 	return &BackpressureMonitor{
+		mx:       &sync.Mutex{},
 		services: make(map[string]*Service),
 	}
 }
 
-func (s BackpressureMonitor) Log(level slog.Level) {
+func (s *BackpressureMonitor) Log(level slog.Level) {
+	s.mx.Lock()
 	services := make([]string, 0, len(s.services))
 	for _, service := range s.services {
 		services = append(services, service.String())
 	}
+	s.mx.Unlock()
 
 	memStats := &runtime.MemStats{}
 	runtime.ReadMemStats(memStats)
@@ -67,28 +78,41 @@ func (s BackpressureMonitor) Log(level slog.Level) {
 	)
 }
 
-func (b *BackpressureMonitor) Increase(service string) {
-	if _, ok := b.services[service]; !ok {
-		b.services[service] = NewService(service)
+func (b *BackpressureMonitor) service(name string) *Service {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if _, ok := b.services[name]; !ok {
+		b.services[name] = NewService(name)
 	}
 
-	b.services[service].mx.Lock()
-	b.services[service].count++
-	b.services[service].mx.Unlock()
+	return b.services[name]
+}
 
-	slog.Debug("increased backpressure", "service", service, "count", b.services[service].count)
+func (b *BackpressureMonitor) Increase(service string) {
+	s := b.service(service)
+
+	s.mx.Lock()
+	s.count++
+	count := s.count
+	s.mx.Unlock()
+
+	slog.Debug("increased backpressure", "service", service, "count", count)
 }
 
 func (b *BackpressureMonitor) Decrease(service string) {
-	if bb, ok := b.services[service]; !ok || bb.count == 0 {
+	s := b.service(service)
+
+	s.mx.Lock()
+	if s.count == 0 {
+		s.mx.Unlock()
 		return
 	}
+	s.count--
+	count := s.count
+	s.mx.Unlock()
 
-	b.services[service].mx.Lock()
-	b.services[service].count--
-	b.services[service].mx.Unlock()
-
-	slog.Debug("decreased backpressure", "service", service, "count", b.services[service].count)
+	slog.Debug("decreased backpressure", "service", service, "count", count)
 }
 
 func mib(bytes uint64) float64 {
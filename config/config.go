@@ -14,6 +14,7 @@ var c *Cfg
 type Cfg struct {
 	Verbose           bool     `yaml:"verbose"`
 	OnlyWHKills       bool     `yaml:"only_wh_kills"`
+	WHClasses         []string `yaml:"wh_classes"`
 	RefreshInterval   int      `yaml:"refresh_interval"`
 	AdminName         string   `yaml:"admin_name"`
 	AdminEmail        string   `yaml:"admin_email"`
@@ -23,10 +24,23 @@ type Cfg struct {
 	IgnoreSystemNames []string `yaml:"ignore_system_names"`
 	IgnoreSystemIDs   []int    `yaml:"ignore_system_ids"`
 	IgnoreRegionIDs   []int    `yaml:"ignore_region_ids"`
-	Redict            Redict   `yaml:"redict"`
-	Wanderer          Wanderer `yaml:"wanderer"`
-	Discord           Discord  `yaml:"discord"`
-	Friends           Friends  `yaml:"friends"`
+	IgnoreLabels      []string `yaml:"ignore_labels"`
+	ValueThreshold    `yaml:",inline"`
+	JumpLimit         `yaml:",inline"`
+	ShipFilters       ShipFilters   `yaml:"ship_filters"`
+	SystemFilters     SystemFilters `yaml:"system_filters"`
+	Redict            Redict        `yaml:"redict"`
+	Wanderer          Wanderer      `yaml:"wanderer"`
+	Maps              []Map         `yaml:"maps"`
+	Zkillboard        Zkillboard    `yaml:"zkillboard"`
+	ESI               ESI           `yaml:"esi"`
+	Discord           Discord       `yaml:"discord"`
+	Routing           Routing       `yaml:"routing"`
+	Matrix            Matrix        `yaml:"matrix"`
+	Telegram          Telegram      `yaml:"telegram"`
+	HTTP              HTTP          `yaml:"http"`
+	Delivery          Delivery      `yaml:"delivery"`
+	Friends           Friends       `yaml:"friends"`
 }
 
 type Redict struct {
@@ -38,16 +52,244 @@ type Redict struct {
 }
 
 type Wanderer struct {
-	Token string `yaml:"token"`
-	Slug  string `yaml:"slug"`
-	Host  string `yaml:"host"`
+	Token   string `yaml:"token"`
+	Slug    string `yaml:"slug"`
+	Host    string `yaml:"host"`
+	Events  bool   `yaml:"events"`   // Follow the map's event stream, polling only reconciles
+	Home    string `yaml:"home"`     // Home system name or ID
+	HomeTag string `yaml:"home_tag"` // Use the system with this tag on the map as home when home is empty
+}
+
+// Map is one Wanderer map with its own systems, filters and channels.
+type Map struct {
+	Name          string `yaml:"name"` // Used in logs, defaults to the slug
+	Wanderer      `yaml:",inline"`
+	SystemFilters SystemFilters `yaml:"system_filters"` // Applied on top of the global system filters
+	Channels      []Channel     `yaml:"channels"`       // Where kills in the map's systems go, defaults to discord.channels
+}
+
+// UnmarshalYAML decodes the map with the same defaults as the wanderer
+// section.
+func (m *Map) UnmarshalYAML(node *yaml.Node) error {
+	type plain Map
+	p := plain{Wanderer: Wanderer{Events: true}}
+	if err := node.Decode(&p); err != nil {
+		return err
+	}
+
+	*m = Map(p)
+	return nil
+}
+
+// MapList returns the configured maps, falling back to the single map in the
+// wanderer section. Missing names, hosts and channels are filled in from the
+// global settings.
+func (c *Cfg) MapList() []Map {
+	if len(c.Maps) == 0 {
+		return []Map{{
+			Name:     c.Wanderer.Slug,
+			Wanderer: c.Wanderer,
+			Channels: c.Discord.Channels,
+		}}
+	}
+
+	maps := make([]Map, 0, len(c.Maps))
+	for _, m := range c.Maps {
+		if m.Name == "" {
+			m.Name = m.Slug
+		}
+		if m.Host == "" {
+			m.Host = c.Wanderer.Host
+		}
+		if len(m.Channels) == 0 {
+			m.Channels = c.Discord.Channels
+		}
+		maps = append(maps, m)
+	}
+
+	return maps
+}
+
+type Zkillboard struct {
+	Source       string    `yaml:"source"`        // websocket, redisq or poll, used when sources is empty
+	Sources      []string  `yaml:"sources"`       // Sources to run through the multiplexer
+	Mode         string    `yaml:"mode"`          // all or failover
+	PollInterval int       `yaml:"poll_interval"` // Seconds between two zKillboard API polls
+	APIURL       string    `yaml:"api_url"`       // zKillboard API used by the poll source and the backfill
+	Websocket    Websocket `yaml:"websocket"`
+	RedisQ       RedisQ    `yaml:"redisq"`
+	Backfill     Backfill  `yaml:"backfill"`
+}
+
+// SourceNames returns the configured sources, falling back to the single
+// source setting.
+func (z Zkillboard) SourceNames() []string {
+	if len(z.Sources) > 0 {
+		return z.Sources
+	}
+
+	return []string{z.Source}
+}
+
+type Websocket struct {
+	URL              string `yaml:"url"`
+	Killstream       bool   `yaml:"killstream"`         // Subscribe to every kill instead of the systems in the chain
+	PingInterval     int    `yaml:"ping_interval"`      // Seconds between pings
+	PongTimeout      int    `yaml:"pong_timeout"`       // Seconds to wait for any message or pong before reconnecting
+	StaleAfter       int    `yaml:"stale_after"`        // Minutes without a killmail on the killstream before reconnecting, 0 to disable
+	SystemStaleAfter int    `yaml:"system_stale_after"` // The same for per-system subscriptions, 0 to disable
+	BackoffMin       int    `yaml:"backoff_min"`        // Seconds to wait before the first reconnect
+	BackoffMax       int    `yaml:"backoff_max"`        // Upper limit for the reconnect wait in seconds
+	MaxFailures      int    `yaml:"max_failures"`       // Consecutive failed connects before giving up, 0 to retry forever
+}
+
+type RedisQ struct {
+	URL     string `yaml:"url"`
+	QueueID string `yaml:"queue_id"`
+	TTW     int    `yaml:"ttw"` // Seconds the server holds the request open when there are no kills
+}
+
+type Backfill struct {
+	Enabled   bool `yaml:"enabled"`
+	MaxWindow int  `yaml:"max_window"` // Longest gap to backfill in minutes
+}
+
+type ESI struct {
+	BaseURL string `yaml:"base_url"`
+	NameTTL int    `yaml:"name_ttl"` // How long resolved names are cached in minutes
+}
+
+// ValueThreshold limits killmails by their zKillboard total value. Zero turns
+// a limit off.
+type ValueThreshold struct {
+	MinValue float64 `yaml:"min_value"`
+	MaxValue float64 `yaml:"max_value"`
+	// Post friendly losses regardless of their value
+	AlwaysPostFriendlyLosses bool `yaml:"always_post_friendly_losses"`
+}
+
+// JumpLimit limits killmails by the number of jumps between home and the
+// system they happened in. Zero turns the limit off.
+type JumpLimit struct {
+	MaxJumps int `yaml:"max_jumps"`
+	// Post killmails with a friendly victim or attacker regardless of the distance
+	FriendsIgnoreMaxJumps bool `yaml:"friends_ignore_max_jumps"`
+}
+
+// ShipFilters limits killmails by the victim's ship. Entries are type, group
+// or category names or IDs. When any include list is set, only matching ships
+// are posted; excludes always win.
+type ShipFilters struct {
+	IncludeTypes      []string `yaml:"include_types"`
+	ExcludeTypes      []string `yaml:"exclude_types"`
+	IncludeGroups     []string `yaml:"include_groups"`
+	ExcludeGroups     []string `yaml:"exclude_groups"`
+	IncludeCategories []string `yaml:"include_categories"`
+	ExcludeCategories []string `yaml:"exclude_categories"`
+}
+
+// SystemFilters limit the systems taken from the map. Classes and bands use
+// their names, constellations are names or IDs and patterns are globs matched
+// against system names. When any include list is set, only matching systems
+// are kept; excludes always win.
+type SystemFilters struct {
+	IncludeClasses        []string `yaml:"include_classes"`
+	ExcludeClasses        []string `yaml:"exclude_classes"`
+	IncludeBands          []string `yaml:"include_bands"`
+	ExcludeBands          []string `yaml:"exclude_bands"`
+	IncludeConstellations []string `yaml:"include_constellations"`
+	ExcludeConstellations []string `yaml:"exclude_constellations"`
+	IncludePatterns       []string `yaml:"include_patterns"`
+	ExcludePatterns       []string `yaml:"exclude_patterns"`
 }
 
 type Discord struct {
 	DryRun   bool `yaml:"dry_run"`
 	Verbose  bool
 	Token    string
-	Channels []string
+	Channels []Channel
+	// Receives the killmails beyond the global max_jumps instead of dropping them
+	LowPriorityChannel Channel `yaml:"low_priority_channel"`
+}
+
+// Channel is a Discord channel the bot posts to, or a webhook when the URL is
+// set. The ID of a webhook is only used in logs. On other platforms the ID is
+// the Matrix room or Telegram chat, Slack takes an incoming webhook and http
+// the URL the killmail JSON is posted to.
+type Channel struct {
+	ID             string `yaml:"id"`
+	Webhook        string `yaml:"webhook"`
+	Platform       string `yaml:"platform"` // discord, slack, matrix, telegram or http, defaults to discord
+	ValueThreshold `yaml:",inline"`
+	JumpLimit      `yaml:",inline"`
+}
+
+// UnmarshalYAML accepts both a bare channel ID and a channel with settings.
+func (c *Channel) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		c.ID = node.Value
+		return nil
+	}
+
+	type plain Channel
+	return node.Decode((*plain)(c))
+}
+
+// IsSet reports whether the channel has somewhere to post to, an ID or a
+// webhook.
+func (c Channel) IsSet() bool {
+	return c.ID != "" || c.Webhook != ""
+}
+
+type Matrix struct {
+	Homeserver string `yaml:"homeserver"` // Base URL of the homeserver's client-server API
+	Token      string `yaml:"token"`      // Access token of the user posting the kills
+}
+
+type Telegram struct {
+	BaseURL string `yaml:"base_url"`
+	Token   string `yaml:"token"` // Bot API token
+}
+
+// HTTP configures the JSON webhooks of channels with the http platform.
+type HTTP struct {
+	Secret string `yaml:"secret"` // Key of the HMAC-SHA256 signature, requests aren't signed without it
+}
+
+// Delivery configures the queue every destination gets, so a busy channel
+// doesn't hold up the others.
+type Delivery struct {
+	QueueSize  int    `yaml:"queue_size"`  // Messages waiting per destination before the overflow policy applies
+	Overflow   string `yaml:"overflow"`    // drop_oldest or collapse
+	Attempts   int    `yaml:"attempts"`    // Attempts to send a message before it goes to the dead letters
+	BackoffMin int    `yaml:"backoff_min"` // Seconds before the first retry, doubling with every attempt
+	BackoffMax int    `yaml:"backoff_max"` // Upper limit for the wait between retries in seconds
+}
+
+// Routing sends killmails to channels by rules instead of to the channels of
+// their map. Without rules the map channels are used.
+type Routing struct {
+	Mode  string `yaml:"mode"` // first stops at the first matching rule, all sends to every matching rule
+	Rules []Rule `yaml:"rules"`
+}
+
+type Rule struct {
+	Name     string    `yaml:"name"` // Used in logs
+	Match    RuleMatch `yaml:"match"`
+	Channels []Channel `yaml:"channels"`
+	Mention  string    `yaml:"mention"` // Sent along with the embed, e.g. @here or <@&role ID>
+}
+
+// RuleMatch holds the conditions of a rule. Every condition that is set has
+// to match, a rule without conditions matches every killmail.
+type RuleMatch struct {
+	Involvement   []string `yaml:"involvement"` // friendly_loss, friendly_kill or neutral
+	MinValue      float64  `yaml:"min_value"`
+	MaxValue      float64  `yaml:"max_value"`
+	ShipGroups    []string `yaml:"ship_groups"`    // Victim ship group names or IDs
+	SystemClasses []string `yaml:"system_classes"` // Same names as wh_classes, plus Highsec, Lowsec and Nullsec
+	Maps          []string `yaml:"maps"`           // Map names
+	MaxJumps      int      `yaml:"max_jumps"`      // Jumps from the home of the map
 }
 
 type Friends struct {
@@ -82,6 +324,47 @@ func Read(path string) error {
 			TTL:    1440, // 24 hours
 			Prefix: "global",
 		},
+		Wanderer: Wanderer{
+			Events: true,
+		},
+		Telegram: Telegram{
+			BaseURL: "https://api.telegram.org",
+		},
+		Delivery: Delivery{
+			QueueSize:  20,
+			Overflow:   "collapse",
+			Attempts:   8,
+			BackoffMin: 5,
+			BackoffMax: 300,
+		},
+		ESI: ESI{
+			BaseURL: "https://esi.evetech.net/latest",
+			NameTTL: 10080, // 7 days
+		},
+		Zkillboard: Zkillboard{
+			Source:       "websocket",
+			Mode:         "all",
+			PollInterval: 300,
+			APIURL:       "https://zkillboard.com/api",
+			Websocket: Websocket{
+				URL:              "wss://zkillboard.com/websocket/",
+				PingInterval:     30,
+				PongTimeout:      60,
+				StaleAfter:       10,
+				SystemStaleAfter: 60,
+				BackoffMin:       1,
+				BackoffMax:       120,
+				MaxFailures:      10,
+			},
+			RedisQ: RedisQ{
+				URL: "https://zkillredisq.stream/listen.php",
+				TTW: 10,
+			},
+			Backfill: Backfill{
+				Enabled:   true,
+				MaxWindow: 1440,
+			},
+		},
 	}
 
 	if err := yaml.NewDecoder(fp).Decode(&cfg); err != nil {
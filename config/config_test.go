@@ -0,0 +1,74 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDiscordChannels(t *testing.T) {
+	doc := `
+channels:
+  - "123"
+  - id: "456"
+    min_value: 100000000
+    always_post_friendly_losses: true
+    max_jumps: 2
+low_priority_channel: "789"
+`
+
+	var d Discord
+	require.NoError(t, yaml.Unmarshal([]byte(doc), &d))
+
+	require.Equal(t, []Channel{
+		{ID: "123"},
+		{
+			ID:             "456",
+			ValueThreshold: ValueThreshold{MinValue: 100000000, AlwaysPostFriendlyLosses: true},
+			JumpLimit:      JumpLimit{MaxJumps: 2},
+		},
+	}, d.Channels)
+	require.Equal(t, Channel{ID: "789"}, d.LowPriorityChannel)
+}
+
+func TestMapList(t *testing.T) {
+	doc := `
+wanderer:
+  host: https://wanderer.example
+  slug: single
+discord:
+  channels: ["123"]
+maps:
+  - slug: corp
+    token: abc
+  - name: staging
+    slug: staging-map
+    host: https://other.example
+    events: false
+    channels: ["456"]
+`
+
+	var cfg Cfg
+	require.NoError(t, yaml.Unmarshal([]byte(doc), &cfg))
+
+	require.Equal(t, []Map{
+		{
+			Name:     "corp",
+			Wanderer: Wanderer{Host: "https://wanderer.example", Slug: "corp", Token: "abc", Events: true},
+			Channels: []Channel{{ID: "123"}},
+		},
+		{
+			Name:     "staging",
+			Wanderer: Wanderer{Host: "https://other.example", Slug: "staging-map"},
+			Channels: []Channel{{ID: "456"}},
+		},
+	}, cfg.MapList())
+
+	cfg.Maps = nil
+	require.Equal(t, []Map{{
+		Name:     "single",
+		Wanderer: Wanderer{Host: "https://wanderer.example", Slug: "single"},
+		Channels: []Channel{{ID: "123"}},
+	}}, cfg.MapList())
+}
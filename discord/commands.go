@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
 
 	"git.sr.ht/~barveyhirdman/chainkills/backend"
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
 	"github.com/bwmarrin/discordgo"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
@@ -52,6 +54,85 @@ var IgnoreRegionIDCommand = &discordgo.ApplicationCommand{
 	},
 }
 
+var ShipFilterCommand = &discordgo.ApplicationCommand{
+	Name:        "ship-filter",
+	Description: "Include or exclude killmails by the victim's ship",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "mode",
+			Description: "Whether to only post matching ships or never post them",
+			Required:    true,
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{Name: "include", Value: systems.FilterInclude},
+				{Name: "exclude", Value: systems.FilterExclude},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "kind",
+			Description: "What the value refers to",
+			Required:    true,
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{Name: "type", Value: systems.KindType},
+				{Name: "group", Value: systems.KindGroup},
+				{Name: "category", Value: systems.KindCategory},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "value",
+			Description: "Name or ID of the type, group or category, e.g. Capsule",
+			Required:    true,
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionBoolean,
+			Name:        "remove",
+			Description: "Remove the entry instead of adding it",
+		},
+	},
+}
+
+var SystemFilterCommand = &discordgo.ApplicationCommand{
+	Name:        "system-filter",
+	Description: "Include or exclude systems in the chain by class, security, constellation or name",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "mode",
+			Description: "Whether to only keep matching systems or drop them",
+			Required:    true,
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{Name: "include", Value: systems.FilterInclude},
+				{Name: "exclude", Value: systems.FilterExclude},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "kind",
+			Description: "What the value refers to",
+			Required:    true,
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{Name: "wormhole class", Value: systems.KindClass},
+				{Name: "security band", Value: systems.KindBand},
+				{Name: "constellation", Value: systems.KindConstellation},
+				{Name: "name pattern", Value: systems.KindPattern},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "value",
+			Description: "e.g. C5, drifter, low, j-space, a constellation name or J1*",
+			Required:    true,
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionBoolean,
+			Name:        "remove",
+			Description: "Remove the entry instead of adding it",
+		},
+	},
+}
+
 func HandleIgnoreSystemID(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
 	sctx, span := otel.Tracer(packageName).Start(context.Background(), "HandleIgnoreSystemID")
 	defer span.End()
@@ -106,6 +187,12 @@ func HandleSlashCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
 		HandleIgnoreSystemName(ctx, s, i)
 	case "ignore-region-id":
 		HandleIgnoreRegionID(ctx, s, i)
+	case "ship-filter":
+		HandleShipFilter(ctx, s, i)
+	case "system-filter":
+		HandleSystemFilter(ctx, s, i)
+	case "dead-letters":
+		HandleDeadLetters(ctx, s, i)
 	}
 }
 
@@ -159,21 +246,27 @@ func HandleIgnoreRegionID(ctx context.Context, s *discordgo.Session, i *discordg
 		return
 	}
 
-	if err := backend.IgnoreSystemID(sctx, i.ApplicationCommandData().Options[0].IntValue()); err != nil {
-		slog.Error("failed to add ignored system id", "error", err)
+	regionID := i.ApplicationCommandData().Options[0].IntValue()
+
+	if err := backend.IgnoreRegionID(sctx, regionID); err != nil {
+		slog.Error("failed to add ignored region id", "error", err)
 		span.SetStatus(codes.Error, err.Error())
 		span.RecordError(err)
 		return
 	}
 
-	systemID := i.ApplicationCommandData().Options[0].IntValue()
+	regionName := systems.ResolveNames(sctx, uint64(regionID))[uint64(regionID)]
+	if regionName == "" {
+		regionName = "Region ID"
+	}
 
 	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
 			Content: fmt.Sprintf(
-				"System ID %d has been ignored",
-				systemID,
+				"%s (%d) has been ignored",
+				regionName,
+				regionID,
 			),
 		},
 	}); err != nil {
@@ -184,3 +277,125 @@ func HandleIgnoreRegionID(ctx context.Context, s *discordgo.Session, i *discordg
 
 	span.SetStatus(codes.Ok, "ok")
 }
+
+func HandleShipFilter(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+	sctx, span := otel.Tracer(packageName).Start(context.Background(), "HandleShipFilter")
+	defer span.End()
+
+	backend, err := backend.Backend()
+	if err != nil {
+		slog.Error("failed to get backend", "error", err)
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return
+	}
+
+	options := make(map[string]*discordgo.ApplicationCommandInteractionDataOption)
+	for _, o := range i.ApplicationCommandData().Options {
+		options[o.Name] = o
+	}
+
+	mode := options["mode"].StringValue()
+	kind := options["kind"].StringValue()
+	value := options["value"].StringValue()
+	remove := options["remove"] != nil && options["remove"].BoolValue()
+
+	var content string
+	id, name, ok := systems.LookupInventory(kind, value)
+	switch {
+	case !ok:
+		content = fmt.Sprintf("Unknown %s %s", kind, value)
+	default:
+		filter := systems.ShipFilterName(mode, kind)
+		if name == "" {
+			name = fmt.Sprintf("%s ID", kind)
+		}
+
+		if remove {
+			err = backend.RemoveFilter(sctx, filter, strconv.Itoa(id))
+			content = fmt.Sprintf("%s (%d) has been removed from the %s list", name, id, mode)
+		} else {
+			err = backend.AddFilter(sctx, filter, strconv.Itoa(id))
+			content = fmt.Sprintf("%s (%d) has been added to the %s list", name, id, mode)
+		}
+
+		if err != nil {
+			slog.Error("failed to update ship filter", "filter", filter, "error", err)
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+			return
+		}
+	}
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+		},
+	}); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		slog.Error("failed to respond to interaction", "error", err)
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+}
+
+func HandleSystemFilter(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+	sctx, span := otel.Tracer(packageName).Start(context.Background(), "HandleSystemFilter")
+	defer span.End()
+
+	backend, err := backend.Backend()
+	if err != nil {
+		slog.Error("failed to get backend", "error", err)
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return
+	}
+
+	options := make(map[string]*discordgo.ApplicationCommandInteractionDataOption)
+	for _, o := range i.ApplicationCommandData().Options {
+		options[o.Name] = o
+	}
+
+	mode := options["mode"].StringValue()
+	kind := options["kind"].StringValue()
+	remove := options["remove"] != nil && options["remove"].BoolValue()
+
+	var content string
+	value, err := systems.ValidateSystemFilter(sctx, kind, options["value"].StringValue())
+	switch {
+	case err != nil:
+		content = fmt.Sprintf("Can't use this filter: %s", err)
+	default:
+		filter := systems.SystemFilterName(mode, kind)
+
+		if remove {
+			err = backend.RemoveFilter(sctx, filter, value)
+			content = fmt.Sprintf("%s %s has been removed from the %s list", kind, value, mode)
+		} else {
+			err = backend.AddFilter(sctx, filter, value)
+			content = fmt.Sprintf("%s %s has been added to the %s list, it applies from the next map refresh", kind, value, mode)
+		}
+
+		if err != nil {
+			slog.Error("failed to update system filter", "filter", filter, "error", err)
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+			return
+		}
+	}
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+		},
+	}); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		slog.Error("failed to respond to interaction", "error", err)
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+}
@@ -0,0 +1,163 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Discord rejects messages longer than this
+const maxMessageLength = 2000
+
+// DeadLetters lists, inspects, replays and deletes deliveries that failed
+// every attempt. The id "all" selects every dead letter.
+type DeadLetters interface {
+	List(ctx context.Context) ([]string, error)
+	Inspect(ctx context.Context, id string) (string, error)
+	Replay(ctx context.Context, id string) (int, error)
+	Delete(ctx context.Context, id string) (int, error)
+}
+
+var (
+	deadLettersMx = &sync.Mutex{}
+	deadLetters   DeadLetters
+)
+
+// SetDeadLetters makes the dead letters available to the dead-letters
+// command.
+func SetDeadLetters(d DeadLetters) {
+	deadLettersMx.Lock()
+	defer deadLettersMx.Unlock()
+
+	deadLetters = d
+}
+
+func getDeadLetters() DeadLetters {
+	deadLettersMx.Lock()
+	defer deadLettersMx.Unlock()
+
+	return deadLetters
+}
+
+var DeadLettersCommand = &discordgo.ApplicationCommand{
+	Name:        "dead-letters",
+	Description: "List, inspect or replay messages that couldn't be sent",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "action",
+			Description: "What to do with the dead letters",
+			Required:    true,
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{Name: "list", Value: "list"},
+				{Name: "inspect", Value: "inspect"},
+				{Name: "replay", Value: "replay"},
+				{Name: "delete", Value: "delete"},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "id",
+			Description: "ID of the dead letter from the list, or all to replay or delete every one",
+		},
+	},
+}
+
+func HandleDeadLetters(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "HandleDeadLetters")
+	defer span.End()
+
+	options := make(map[string]*discordgo.ApplicationCommandInteractionDataOption)
+	for _, o := range i.ApplicationCommandData().Options {
+		options[o.Name] = o
+	}
+
+	action := options["action"].StringValue()
+	id := ""
+	if options["id"] != nil {
+		id = strings.TrimSpace(options["id"].StringValue())
+	}
+
+	content, err := deadLettersContent(sctx, getDeadLetters(), action, id)
+	if err != nil {
+		slog.Error("failed to handle dead letters", "action", action, "id", id, "error", err)
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		content = fmt.Sprintf("Failed to %s dead letters: %s", action, err)
+	}
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: truncate(content, maxMessageLength),
+		},
+	}); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		slog.Error("failed to respond to interaction", "error", err)
+		return
+	}
+
+	span.SetStatus(codes.Ok, "ok")
+}
+
+func deadLettersContent(ctx context.Context, d DeadLetters, action, id string) (string, error) {
+	if d == nil {
+		return "Dead letters aren't available yet", nil
+	}
+	if action != "list" && id == "" {
+		return fmt.Sprintf("Which dead letter to %s? Pass an ID from the list", action), nil
+	}
+
+	switch action {
+	case "list":
+		lines, err := d.List(ctx)
+		if err != nil {
+			return "", err
+		}
+		if len(lines) == 0 {
+			return "No dead letters", nil
+		}
+		return fmt.Sprintf("%d dead letters:\n```\n%s\n```", len(lines), strings.Join(lines, "\n")), nil
+	case "inspect":
+		details, err := d.Inspect(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("```\n%s\n```", details), nil
+	case "replay":
+		count, err := d.Replay(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Replaying %d dead letters", count), nil
+	case "delete":
+		count, err := d.Delete(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Deleted %d dead letters", count), nil
+	}
+
+	return fmt.Sprintf("Unknown action %s", action), nil
+}
+
+// truncate shortens content to the length, keeping code blocks closed.
+func truncate(content string, length int) string {
+	if len(content) <= length {
+		return content
+	}
+
+	cut := strings.ToValidUTF8(content[:length-len("\n…\n```")], "")
+	if strings.Count(cut, "```")%2 == 1 {
+		return cut + "\n…\n```"
+	}
+
+	return cut + "\n…"
+}
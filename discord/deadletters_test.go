@@ -0,0 +1,117 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDeadLetters struct {
+	lines []string
+}
+
+func (f *fakeDeadLetters) List(context.Context) ([]string, error) {
+	return f.lines, nil
+}
+
+func (f *fakeDeadLetters) Inspect(_ context.Context, id string) (string, error) {
+	if id != "1-abc" {
+		return "", errors.New("no dead letter " + id)
+	}
+	return "ID: 1-abc", nil
+}
+
+func (f *fakeDeadLetters) Replay(_ context.Context, id string) (int, error) {
+	if id == "all" {
+		return len(f.lines), nil
+	}
+	return 1, nil
+}
+
+func (f *fakeDeadLetters) Delete(context.Context, string) (int, error) {
+	return 1, nil
+}
+
+func TestDeadLettersContent(t *testing.T) {
+	d := &fakeDeadLetters{lines: []string{"1-abc: killmail 1", "2-abc: killmail 2"}}
+
+	tests := []struct {
+		label    string
+		letters  DeadLetters
+		action   string
+		id       string
+		expected string
+		fails    bool
+	}{
+		{
+			label:    "not available",
+			action:   "list",
+			expected: "Dead letters aren't available yet",
+		},
+		{
+			label:    "list",
+			letters:  d,
+			action:   "list",
+			expected: "2 dead letters:\n```\n1-abc: killmail 1\n2-abc: killmail 2\n```",
+		},
+		{
+			label:    "empty list",
+			letters:  &fakeDeadLetters{},
+			action:   "list",
+			expected: "No dead letters",
+		},
+		{
+			label:    "inspect",
+			letters:  d,
+			action:   "inspect",
+			id:       "1-abc",
+			expected: "```\nID: 1-abc\n```",
+		},
+		{
+			label:   "inspect unknown",
+			letters: d,
+			action:  "inspect",
+			id:      "3-abc",
+			fails:   true,
+		},
+		{
+			label:    "replay needs an ID",
+			letters:  d,
+			action:   "replay",
+			expected: "Which dead letter to replay? Pass an ID from the list",
+		},
+		{
+			label:    "replay all",
+			letters:  d,
+			action:   "replay",
+			id:       "all",
+			expected: "Replaying 2 dead letters",
+		},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			content, err := deadLettersContent(context.Background(), tt.letters, tt.action, tt.id)
+			if tt.fails {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, content)
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	require.Equal(t, "short", truncate("short", 10))
+
+	long := "```\n" + strings.Repeat("line\n", 500) + "```"
+	truncated := truncate(long, maxMessageLength)
+	require.LessOrEqual(t, len(truncated), maxMessageLength)
+	require.True(t, strings.HasSuffix(truncated, "\n…\n```"))
+}
@@ -0,0 +1,217 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/bwmarrin/discordgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Requests that may hit a rate limit before the message is handed back as
+// failed. Other failures are retried by the dispatcher, not here.
+const webhookRateLimits = 3
+
+var errRateLimited = errors.New("webhook is rate limited")
+
+// WebhookSender posts messages to Discord webhooks without a bot session. It
+// keeps the rate limits Discord reports for every webhook and waits until a
+// webhook may be used again instead of having messages rejected.
+type WebhookSender struct {
+	mx *sync.Mutex
+
+	client *http.Client
+	// time from which each webhook, keyed by URL, may be used again
+	resets map[string]time.Time
+	global time.Time
+}
+
+func NewWebhookSender() *WebhookSender {
+	return &WebhookSender{
+		mx:     &sync.Mutex{},
+		client: &http.Client{Timeout: 10 * time.Second},
+		resets: make(map[string]time.Time),
+	}
+}
+
+// rateLimitResponse is the body Discord sends along with a 429.
+type rateLimitResponse struct {
+	Message    string  `json:"message"`
+	RetryAfter float64 `json:"retry_after"`
+	Global     bool    `json:"global"`
+}
+
+// Send posts the message to the webhook, waiting out its rate limit first.
+// Messages that hit a rate limit anyway are sent again after the time
+// Discord asks for, any other failure is returned.
+func (w *WebhookSender) Send(ctx context.Context, webhook string, message *discordgo.WebhookParams) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "WebhookSend")
+	defer span.End()
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		// rate limits, including the one of a 429, are waited out here
+		if err := w.Wait(sctx, webhook); err != nil {
+			return err
+		}
+
+		err := w.post(sctx, webhook, body)
+		if err == nil {
+			span.SetAttributes(attribute.Int("attempts", attempt))
+			return nil
+		}
+		if !errors.Is(err, errRateLimited) || attempt >= webhookRateLimits {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+
+		slog.Warn("webhook is rate limited, waiting", "attempt", attempt)
+	}
+}
+
+// Wait blocks until the webhook and the global rate limit allow a request.
+func (w *WebhookSender) Wait(ctx context.Context, webhook string) error {
+	w.mx.Lock()
+	until := w.resets[webhook]
+	if w.global.After(until) {
+		until = w.global
+	}
+	w.mx.Unlock()
+
+	delay := time.Until(until)
+	if delay <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+// post sends the request once.
+func (w *WebhookSender) post(ctx context.Context, webhook string, body []byte) error {
+	target, err := webhookURL(webhook)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("User-Agent", fmt.Sprintf("%s/%s:%s", config.Get().AdminName, config.Get().AppName, config.Get().Version))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		// the URL holds the webhook's token, keep it out of the error
+		if uerr := (*url.Error)(nil); errors.As(err, &uerr) {
+			err = uerr.Err
+		}
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("failed to close response body", "error", err)
+		}
+	}()
+
+	w.update(webhook, resp)
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return errRateLimited
+	case resp.StatusCode >= 500:
+		return fmt.Errorf("unexpected status code from webhook: %d", resp.StatusCode)
+	case resp.StatusCode >= 300:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status code from webhook: %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	return nil
+}
+
+// update stores when the webhook may be used again from the rate limit
+// headers, or from the body of a 429.
+func (w *WebhookSender) update(webhook string, resp *http.Response) {
+	now := time.Now()
+
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if after, ok := parseSeconds(resp.Header.Get("X-RateLimit-Reset-After")); ok {
+			w.resets[webhook] = now.Add(after)
+		}
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	limit := rateLimitResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&limit); err != nil {
+		slog.Debug("failed to decode rate limit response", "error", err)
+	}
+
+	after := time.Duration(limit.RetryAfter * float64(time.Second))
+	if header, ok := parseSeconds(resp.Header.Get("Retry-After")); ok && header > after {
+		after = header
+	}
+	if after <= 0 {
+		after = time.Second
+	}
+
+	if limit.Global || resp.Header.Get("X-RateLimit-Global") == "true" {
+		w.global = now.Add(after)
+		return
+	}
+	w.resets[webhook] = now.Add(after)
+}
+
+// webhookURL asks Discord to answer with the created message, so failures
+// are reported instead of only acknowledged.
+func webhookURL(webhook string) (string, error) {
+	u, err := url.Parse(webhook)
+	if err != nil {
+		return "", fmt.Errorf("invalid webhook url: %w", err)
+	}
+
+	q := u.Query()
+	q.Set("wait", "true")
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+func parseSeconds(value string) (time.Duration, bool) {
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return time.Duration(seconds * float64(time.Second)), true
+}
@@ -0,0 +1,94 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSend(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/webhooks/1/token", r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get("wait"))
+		require.Equal(t, "test/chainkills:v0.0.0", r.Header.Get("User-Agent"))
+
+		var params discordgo.WebhookParams
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+		require.Equal(t, "@here", params.Content)
+		require.Len(t, params.Embeds, 1)
+
+		switch calls.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"You are being rate limited.","retry_after":0.1,"global":false}`))
+		default:
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset-After", "0.2")
+			_, _ = w.Write([]byte(`{"id":"1"}`))
+		}
+	}))
+	defer srv.Close()
+
+	sender := NewWebhookSender()
+	message := &discordgo.WebhookParams{
+		Content: "@here",
+		Embeds:  []*discordgo.MessageEmbed{{Title: "kill"}},
+	}
+
+	start := time.Now()
+	require.NoError(t, sender.Send(context.Background(), srv.URL+"/api/webhooks/1/token", message))
+	require.Equal(t, int32(2), calls.Load())
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	// the bucket is empty until the reset
+	start = time.Now()
+	require.NoError(t, sender.Send(context.Background(), srv.URL+"/api/webhooks/1/token", message))
+	require.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+}
+
+func TestWebhookSendFails(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	tests := []struct {
+		label string
+		code  int
+		calls int32
+	}{
+		{label: "bad request", code: http.StatusBadRequest, calls: 1},
+		{label: "rate limited", code: http.StatusTooManyRequests, calls: webhookRateLimits},
+		{label: "server error", code: http.StatusBadGateway, calls: 1},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			var calls atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				if tt.code == http.StatusTooManyRequests {
+					w.Header().Set("Retry-After", "0.01")
+				}
+				w.WriteHeader(tt.code)
+			}))
+			defer srv.Close()
+
+			sender := NewWebhookSender()
+
+			err := sender.Send(context.Background(), srv.URL, &discordgo.WebhookParams{})
+			require.Error(t, err)
+			require.Equal(t, tt.calls, calls.Load())
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
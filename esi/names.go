@@ -0,0 +1,206 @@
+package esi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	packageName = "git.sr.ht/~barveyhirdman/chainkills/esi"
+
+	// ESI refuses more IDs than this in a single request
+	maxBatchSize = 1000
+)
+
+// Name is a single entry returned by /universe/names/.
+type Name struct {
+	ID       uint64 `json:"id"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
+
+// NameCache stores resolved names between lookups.
+type NameCache interface {
+	GetNames(ctx context.Context, ids []string) (map[string]string, error)
+	SetNames(ctx context.Context, names map[string]string, ttl time.Duration) error
+}
+
+// Resolver looks up names of EVE IDs through ESI and keeps them in a cache.
+type Resolver struct {
+	client  *http.Client
+	baseURL string
+	cache   NameCache
+	ttl     time.Duration
+}
+
+func NewResolver(baseURL string, cache NameCache, ttl time.Duration) *Resolver {
+	return &Resolver{
+		client:  &http.Client{Timeout: 10 * time.Second},
+		baseURL: baseURL,
+		cache:   cache,
+		ttl:     ttl,
+	}
+}
+
+// Names implements systems.NameResolver. Lookup errors are logged and the
+// IDs that could be resolved are returned.
+func (r *Resolver) Names(ctx context.Context, ids []uint64) map[uint64]string {
+	names, err := r.Resolve(ctx, ids...)
+	if err != nil {
+		slog.Warn("failed to resolve names", "ids", ids, "error", err)
+	}
+
+	return names
+}
+
+// Name returns the name of a single ID, or the ID itself if it can't be
+// resolved.
+func (r *Resolver) Name(ctx context.Context, id uint64) string {
+	if name, ok := r.Names(ctx, []uint64{id})[id]; ok {
+		return name
+	}
+
+	return strconv.FormatUint(id, 10)
+}
+
+// Resolve returns the names of the given IDs, first from the cache and then
+// from ESI.
+func (r *Resolver) Resolve(ctx context.Context, ids ...uint64) (map[uint64]string, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "Resolve")
+	defer span.End()
+
+	names := make(map[uint64]string, len(ids))
+
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == 0 {
+			continue
+		}
+		keys = append(keys, strconv.FormatUint(id, 10))
+	}
+	if len(keys) == 0 {
+		return names, nil
+	}
+
+	cached, err := r.cache.GetNames(sctx, keys)
+	if err != nil {
+		slog.Warn("failed to get names from cache", "error", err)
+		span.RecordError(err)
+		cached = map[string]string{}
+	}
+
+	missing := make([]uint64, 0)
+	for _, key := range keys {
+		id, _ := strconv.ParseUint(key, 10, 64)
+		if name, ok := cached[key]; ok {
+			names[id] = name
+			continue
+		}
+		missing = append(missing, id)
+	}
+
+	span.SetAttributes(
+		attribute.Int("cached", len(names)),
+		attribute.Int("missing", len(missing)),
+	)
+
+	if len(missing) == 0 {
+		return names, nil
+	}
+
+	resolved := make(map[string]string)
+	var resolveErr error
+	for start := 0; start < len(missing); start += maxBatchSize {
+		end := min(start+maxBatchSize, len(missing))
+
+		entries, err := r.fetch(sctx, missing[start:end])
+		if err != nil {
+			resolveErr = errors.Join(resolveErr, err)
+			span.RecordError(err)
+		}
+
+		for _, entry := range entries {
+			names[entry.ID] = entry.Name
+			resolved[strconv.FormatUint(entry.ID, 10)] = entry.Name
+		}
+	}
+
+	if len(resolved) > 0 {
+		if err := r.cache.SetNames(sctx, resolved, r.ttl); err != nil {
+			slog.Warn("failed to cache names", "error", err)
+			span.RecordError(err)
+		}
+	}
+
+	if resolveErr != nil {
+		span.SetStatus(codes.Error, resolveErr.Error())
+	}
+
+	return names, resolveErr
+}
+
+// fetch posts a batch of IDs to ESI. ESI rejects the whole batch if a single
+// ID is invalid, in which case the batch is split until the bad IDs are
+// isolated.
+func (r *Resolver) fetch(ctx context.Context, ids []uint64) ([]Name, error) {
+	body, err := json.Marshal(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.baseURL+"/universe/names/?datasource=tranquility", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent())
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("failed to close response body", "error", err)
+		}
+	}()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound && len(ids) > 1:
+		half := len(ids) / 2
+
+		left, lerr := r.fetch(ctx, ids[:half])
+		right, rerr := r.fetch(ctx, ids[half:])
+
+		return append(left, right...), errors.Join(lerr, rerr)
+	case resp.StatusCode == http.StatusNotFound:
+		slog.Debug("id can't be resolved", "id", ids[0])
+		return nil, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("unexpected status code from esi: %d", resp.StatusCode)
+	}
+
+	var entries []Name
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func userAgent() string {
+	return fmt.Sprintf("%s/%s:%s %s", config.Get().AdminName, config.Get().AppName, config.Get().Version, config.Get().AdminEmail)
+}
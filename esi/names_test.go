@@ -0,0 +1,89 @@
+package esi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/backend/memory"
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+var knownNames = map[uint64]Name{
+	670:      {ID: 670, Name: "Capsule", Category: "inventory_type"},
+	30000142: {ID: 30000142, Name: "Jita", Category: "solar_system"},
+	10000002: {ID: 10000002, Name: "The Forge", Category: "region"},
+}
+
+func newESI(t *testing.T, requests *atomic.Int32) string {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/universe/names/", r.URL.Path)
+
+		var ids []uint64
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&ids))
+
+		names := make([]Name, 0, len(ids))
+		for _, id := range ids {
+			name, ok := knownNames[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":"Ensure all IDs are valid before resolving."}`))
+				return
+			}
+			names = append(names, name)
+		}
+
+		require.NoError(t, json.NewEncoder(w).Encode(names))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv.URL
+}
+
+func TestResolve(t *testing.T) {
+	require.NoError(t, config.Read("../systems/testdata/config.test.yaml"))
+
+	var requests atomic.Int32
+	cache, err := memory.New()
+	require.NoError(t, err)
+
+	r := NewResolver(newESI(t, &requests), cache, time.Hour)
+
+	names, err := r.Resolve(context.Background(), 670, 30000142, 0)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]string{670: "Capsule", 30000142: "Jita"}, names)
+	require.Equal(t, int32(1), requests.Load())
+
+	// cached names don't hit ESI again
+	names, err = r.Resolve(context.Background(), 670, 30000142)
+	require.NoError(t, err)
+	require.Len(t, names, 2)
+	require.Equal(t, int32(1), requests.Load())
+
+	require.Equal(t, "The Forge", r.Name(context.Background(), 10000002))
+}
+
+func TestResolveInvalidID(t *testing.T) {
+	require.NoError(t, config.Read("../systems/testdata/config.test.yaml"))
+
+	var requests atomic.Int32
+	cache, err := memory.New()
+	require.NoError(t, err)
+
+	r := NewResolver(newESI(t, &requests), cache, time.Hour)
+
+	names, err := r.Resolve(context.Background(), 670, 1, 10000002)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]string{670: "Capsule", 10000002: "The Forge"}, names)
+
+	require.Equal(t, "1", r.Name(context.Background(), 1))
+}
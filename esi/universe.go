@@ -0,0 +1,233 @@
+package esi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// errNotFound is returned for IDs ESI doesn't know.
+var errNotFound = errors.New("not found")
+
+type solarSystem struct {
+	SystemID        int     `json:"system_id"`
+	Name            string  `json:"name"`
+	ConstellationID int     `json:"constellation_id"`
+	SecurityStatus  float64 `json:"security_status"`
+}
+
+type constellation struct {
+	ConstellationID int    `json:"constellation_id"`
+	Name            string `json:"name"`
+	RegionID        int    `json:"region_id"`
+}
+
+type invType struct {
+	TypeID  int    `json:"type_id"`
+	Name    string `json:"name"`
+	GroupID int    `json:"group_id"`
+}
+
+type invGroup struct {
+	GroupID    int    `json:"group_id"`
+	Name       string `json:"name"`
+	CategoryID int    `json:"category_id"`
+}
+
+type invCategory struct {
+	CategoryID int    `json:"category_id"`
+	Name       string `json:"name"`
+}
+
+// System implements systems.UniverseResolver. The region comes from the
+// constellation, /universe/systems/ doesn't have it.
+func (r *Resolver) System(ctx context.Context, systemID int) (systems.CachedSystem, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "System")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("system_id", systemID))
+
+	var s solarSystem
+	if err := r.request(sctx, http.MethodGet, fmt.Sprintf("/universe/systems/%d/", systemID), nil, &s); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return systems.CachedSystem{}, err
+	}
+
+	system := systems.CachedSystem{
+		SystemID:        s.SystemID,
+		SystemName:      s.Name,
+		ConstellationID: s.ConstellationID,
+		Security:        s.SecurityStatus,
+	}
+	if c, err := r.Constellation(sctx, s.ConstellationID); err == nil {
+		system.RegionID = c.RegionID
+	}
+
+	return system, nil
+}
+
+// Constellation implements systems.UniverseResolver.
+func (r *Resolver) Constellation(ctx context.Context, constellationID int) (systems.CachedConstellation, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "Constellation")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("constellation_id", constellationID))
+
+	var c constellation
+	if err := r.request(sctx, http.MethodGet, fmt.Sprintf("/universe/constellations/%d/", constellationID), nil, &c); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return systems.CachedConstellation{}, err
+	}
+
+	return systems.CachedConstellation{
+		ConstellationID:   c.ConstellationID,
+		ConstellationName: c.Name,
+		RegionID:          c.RegionID,
+	}, nil
+}
+
+// FindConstellation implements systems.UniverseResolver. /universe/ids/
+// only matches exact names, so the name is looked up as it was given.
+func (r *Resolver) FindConstellation(ctx context.Context, name string) (systems.CachedConstellation, bool, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "FindConstellation")
+	defer span.End()
+
+	body, err := json.Marshal([]string{strings.TrimSpace(name)})
+	if err != nil {
+		return systems.CachedConstellation{}, false, err
+	}
+
+	ids := struct {
+		Constellations []struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		} `json:"constellations"`
+	}{}
+	if err := r.request(sctx, http.MethodPost, "/universe/ids/", body, &ids); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return systems.CachedConstellation{}, false, err
+	}
+	if len(ids.Constellations) == 0 {
+		return systems.CachedConstellation{}, false, nil
+	}
+
+	c, err := r.Constellation(sctx, ids.Constellations[0].ID)
+	if err != nil {
+		return systems.CachedConstellation{}, false, err
+	}
+
+	return c, true, nil
+}
+
+// Type implements systems.UniverseResolver.
+func (r *Resolver) Type(ctx context.Context, typeID int) (systems.CachedType, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "Type")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("type_id", typeID))
+
+	var t invType
+	if err := r.request(sctx, http.MethodGet, fmt.Sprintf("/universe/types/%d/", typeID), nil, &t); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return systems.CachedType{}, err
+	}
+
+	return systems.CachedType{
+		TypeID:   t.TypeID,
+		TypeName: t.Name,
+		GroupID:  t.GroupID,
+	}, nil
+}
+
+// Group implements systems.UniverseResolver.
+func (r *Resolver) Group(ctx context.Context, groupID int) (systems.CachedGroup, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "Group")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("group_id", groupID))
+
+	var g invGroup
+	if err := r.request(sctx, http.MethodGet, fmt.Sprintf("/universe/groups/%d/", groupID), nil, &g); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return systems.CachedGroup{}, err
+	}
+
+	return systems.CachedGroup{
+		GroupID:    g.GroupID,
+		GroupName:  g.Name,
+		CategoryID: g.CategoryID,
+	}, nil
+}
+
+// Category implements systems.UniverseResolver.
+func (r *Resolver) Category(ctx context.Context, categoryID int) (systems.CachedCategory, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "Category")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("category_id", categoryID))
+
+	var c invCategory
+	if err := r.request(sctx, http.MethodGet, fmt.Sprintf("/universe/categories/%d/", categoryID), nil, &c); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return systems.CachedCategory{}, err
+	}
+
+	return systems.CachedCategory{
+		CategoryID:   c.CategoryID,
+		CategoryName: c.Name,
+	}, nil
+}
+
+// request sends a request to ESI and decodes the response into v.
+func (r *Resolver) request(ctx context.Context, method, path string, body []byte, v any) error {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, r.baseURL+path+"?datasource=tranquility", reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent())
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("failed to close response body", "error", err)
+		}
+	}()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%s: %w", path, errNotFound)
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("unexpected status code from esi: %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,97 @@
+package esi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/backend/memory"
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"github.com/stretchr/testify/require"
+)
+
+func newUniverseESI(t *testing.T) string {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "tranquility", r.URL.Query().Get("datasource"))
+
+		switch r.URL.Path {
+		case "/universe/systems/30000142/":
+			fmt.Fprint(w, `{"system_id":30000142,"name":"Jita","constellation_id":20000020,"security_status":0.9459}`)
+		case "/universe/constellations/20000020/":
+			fmt.Fprint(w, `{"constellation_id":20000020,"name":"Kimotoro","region_id":10000002}`)
+		case "/universe/types/52907/":
+			fmt.Fprint(w, `{"type_id":52907,"name":"Zirnitra","group_id":485}`)
+		case "/universe/groups/485/":
+			fmt.Fprint(w, `{"group_id":485,"name":"Dreadnought","category_id":6}`)
+		case "/universe/categories/6/":
+			fmt.Fprint(w, `{"category_id":6,"name":"Ship"}`)
+		case "/universe/ids/":
+			require.Equal(t, http.MethodPost, r.Method)
+
+			var names []string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&names))
+			if names[0] != "Kimotoro" {
+				fmt.Fprint(w, `{}`)
+				return
+			}
+			fmt.Fprint(w, `{"constellations":[{"id":20000020,"name":"Kimotoro"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv.URL
+}
+
+func TestUniverse(t *testing.T) {
+	require.NoError(t, config.Read("../systems/testdata/config.test.yaml"))
+
+	cache, err := memory.New()
+	require.NoError(t, err)
+
+	r := NewResolver(newUniverseESI(t), cache, time.Hour)
+	ctx := context.Background()
+
+	system, err := r.System(ctx, 30000142)
+	require.NoError(t, err)
+	require.Equal(t, "Jita", system.SystemName)
+	require.Equal(t, 20000020, system.ConstellationID)
+	require.Equal(t, 10000002, system.RegionID)
+	require.Equal(t, 0.9459, system.Security)
+
+	_, err = r.System(ctx, 1)
+	require.ErrorIs(t, err, errNotFound)
+
+	constellation, ok, err := r.FindConstellation(ctx, "Kimotoro")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 20000020, constellation.ConstellationID)
+	require.Equal(t, "Kimotoro", constellation.ConstellationName)
+
+	_, ok, err = r.FindConstellation(ctx, "Nowhere")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	tp, err := r.Type(ctx, 52907)
+	require.NoError(t, err)
+	require.Equal(t, systems.CachedType{TypeID: 52907, TypeName: "Zirnitra", GroupID: 485}, tp)
+
+	group, err := r.Group(ctx, 485)
+	require.NoError(t, err)
+	require.Equal(t, systems.CachedGroup{GroupID: 485, GroupName: "Dreadnought", CategoryID: 6}, group)
+
+	category, err := r.Category(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, systems.CachedCategory{CategoryID: 6, CategoryName: "Ship"}, category)
+
+	_, err = r.Type(ctx, 1)
+	require.ErrorIs(t, err, errNotFound)
+}
@@ -0,0 +1,269 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// ReplayAll selects every dead letter for replaying or deleting.
+const ReplayAll = "all"
+
+// DeadLetterStore is the part of the backend that keeps the dead letters.
+type DeadLetterStore interface {
+	AddDeadLetter(ctx context.Context, id, entry string) error
+	GetDeadLetters(ctx context.Context) (map[string]string, error)
+	RemoveDeadLetter(ctx context.Context, id string) error
+}
+
+// DeadLetter is a delivery that failed every attempt, along with the last
+// error.
+type DeadLetter struct {
+	Delivery Delivery  `json:"delivery"`
+	Error    string    `json:"error"`
+	Attempts int       `json:"attempts"`
+	Failed   time.Time `json:"failed"`
+}
+
+// String summarises the dead letter on one line for listings.
+func (l DeadLetter) String() string {
+	return fmt.Sprintf("%s: %s to %s, failed %s after %d attempts",
+		l.Delivery.ID,
+		l.subject(),
+		l.Delivery.Destination,
+		l.Failed.Format(time.RFC3339),
+		l.Attempts,
+	)
+}
+
+// Details describes the dead letter with the error that made it fail.
+func (l DeadLetter) Details() string {
+	lines := []string{
+		fmt.Sprintf("ID: %s", l.Delivery.ID),
+		fmt.Sprintf("Destination: %s", l.Delivery.Destination),
+		fmt.Sprintf("Killmail: %s", l.subject()),
+	}
+	if embed := l.Delivery.Notification.Embed; embed != nil {
+		lines = append(lines, fmt.Sprintf("Title: %s", embed.Title))
+		if embed.URL != "" {
+			lines = append(lines, fmt.Sprintf("Link: %s", embed.URL))
+		}
+	}
+	lines = append(lines,
+		fmt.Sprintf("Queued: %s", l.Delivery.Enqueued.Format(time.RFC3339)),
+		fmt.Sprintf("Failed: %s after %d attempts", l.Failed.Format(time.RFC3339), l.Attempts),
+		fmt.Sprintf("Error: %s", l.Error),
+	)
+
+	return strings.Join(lines, "\n")
+}
+
+func (l DeadLetter) subject() string {
+	if collapsed := l.Delivery.Notification.Collapsed; len(collapsed) > 0 {
+		return fmt.Sprintf("summary of %d killmails", len(collapsed))
+	}
+
+	return fmt.Sprintf("killmail %d", l.Delivery.Notification.KillmailID)
+}
+
+// DeadLetters keeps the deliveries that failed every attempt. Replaying puts
+// them back in the outbox, from where the bot sends them again.
+type DeadLetters struct {
+	store  DeadLetterStore
+	outbox *Outbox
+}
+
+func NewDeadLetters(store DeadLetterStore, outbox *Outbox) *DeadLetters {
+	return &DeadLetters{
+		store:  store,
+		outbox: outbox,
+	}
+}
+
+func (d *DeadLetters) Add(ctx context.Context, delivery Delivery, attempts int, cause error) error {
+	entry, err := json.Marshal(DeadLetter{
+		Delivery: delivery,
+		Error:    cause.Error(),
+		Attempts: attempts,
+		Failed:   time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return d.store.AddDeadLetter(ctx, delivery.ID, string(entry))
+}
+
+// List returns the dead letters, oldest failure first.
+func (d *DeadLetters) List(ctx context.Context) ([]DeadLetter, error) {
+	entries, err := d.store.GetDeadLetters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	letters := make([]DeadLetter, 0, len(entries))
+	for id, entry := range entries {
+		l := DeadLetter{}
+		if err := json.Unmarshal([]byte(entry), &l); err != nil {
+			slog.Warn("skipping unreadable dead letter", "id", id, "error", err)
+			continue
+		}
+		letters = append(letters, l)
+	}
+
+	sort.SliceStable(letters, func(i, j int) bool {
+		if letters[i].Failed.Equal(letters[j].Failed) {
+			return letters[i].Delivery.ID < letters[j].Delivery.ID
+		}
+		return letters[i].Failed.Before(letters[j].Failed)
+	})
+
+	return letters, nil
+}
+
+func (d *DeadLetters) Get(ctx context.Context, id string) (DeadLetter, bool, error) {
+	letters, err := d.List(ctx)
+	if err != nil {
+		return DeadLetter{}, false, err
+	}
+
+	for _, l := range letters {
+		if l.Delivery.ID == id {
+			return l, true, nil
+		}
+	}
+
+	return DeadLetter{}, false, nil
+}
+
+// Replay moves the dead letter with the ID, or all of them, back into the
+// outbox and returns their deliveries.
+func (d *DeadLetters) Replay(ctx context.Context, id string) ([]Delivery, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "DeadLettersReplay")
+	defer span.End()
+
+	letters, err := d.selected(sctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	deliveries := make([]Delivery, 0, len(letters))
+	for _, l := range letters {
+		delivery := l.Delivery
+		delivery.Enqueued = time.Now().UTC()
+
+		if err := d.outbox.Put(sctx, delivery); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return deliveries, err
+		}
+		if err := d.store.RemoveDeadLetter(sctx, delivery.ID); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return deliveries, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	span.SetAttributes(attribute.Int("replayed", len(deliveries)))
+	return deliveries, nil
+}
+
+// Remove deletes the dead letter with the ID, or all of them, and returns
+// how many were deleted.
+func (d *DeadLetters) Remove(ctx context.Context, id string) (int, error) {
+	letters, err := d.selected(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, l := range letters {
+		if err := d.store.RemoveDeadLetter(ctx, l.Delivery.ID); err != nil {
+			return i, err
+		}
+	}
+
+	return len(letters), nil
+}
+
+func (d *DeadLetters) selected(ctx context.Context, id string) ([]DeadLetter, error) {
+	if id == ReplayAll {
+		return d.List(ctx)
+	}
+
+	l, ok, err := d.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("no dead letter %s", id)
+	}
+
+	return []DeadLetter{l}, nil
+}
+
+// DeadLetterCommands answers the dead letter commands of Discord and the
+// command line. Without a dispatcher replayed deliveries wait in the outbox
+// until the bot picks them up.
+type DeadLetterCommands struct {
+	letters    *DeadLetters
+	dispatcher *Dispatcher
+}
+
+func NewDeadLetterCommands(letters *DeadLetters, dispatcher *Dispatcher) *DeadLetterCommands {
+	return &DeadLetterCommands{
+		letters:    letters,
+		dispatcher: dispatcher,
+	}
+}
+
+func (c *DeadLetterCommands) List(ctx context.Context) ([]string, error) {
+	letters, err := c.letters.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0, len(letters))
+	for _, l := range letters {
+		lines = append(lines, l.String())
+	}
+
+	return lines, nil
+}
+
+func (c *DeadLetterCommands) Inspect(ctx context.Context, id string) (string, error) {
+	l, ok, err := c.letters.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("no dead letter %s", id)
+	}
+
+	return l.Details(), nil
+}
+
+func (c *DeadLetterCommands) Replay(ctx context.Context, id string) (int, error) {
+	deliveries, err := c.letters.Replay(ctx, id)
+	if c.dispatcher != nil {
+		for _, d := range deliveries {
+			c.dispatcher.Send(d)
+		}
+	}
+
+	return len(deliveries), err
+}
+
+func (c *DeadLetterCommands) Delete(ctx context.Context, id string) (int, error) {
+	return c.letters.Remove(ctx, id)
+}
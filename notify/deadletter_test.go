@@ -0,0 +1,104 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadLetters(t *testing.T) {
+	store := newTestStore()
+
+	ctx := context.Background()
+	outbox := NewOutbox(store)
+	deadLetters := NewDeadLetters(store, outbox)
+
+	destination := systems.Destination{Webhook: "https://discord.com/api/webhooks/1/secret", ChannelID: "allies"}
+	deliveries := testDeliveries(t, outbox, destination, 3)
+	for _, d := range deliveries {
+		require.NoError(t, deadLetters.Add(ctx, d, 8, errors.New("unexpected status code from webhook: 404")))
+		require.NoError(t, outbox.Done(ctx, d))
+	}
+
+	letters, err := deadLetters.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, letters, 3)
+
+	l, ok, err := deadLetters.Get(ctx, deliveries[1].ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Contains(t, l.String(), deliveries[1].ID+": killmail 2 to webhook allies, failed ")
+	require.Contains(t, l.Details(), "Link: https://zkillboard.com/kill/2/")
+	require.Contains(t, l.Details(), "Error: unexpected status code from webhook: 404")
+	require.NotContains(t, l.Details(), "secret")
+
+	_, ok, err = deadLetters.Get(ctx, "unknown")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// replaying moves the dead letter back into the outbox
+	replayed, err := deadLetters.Replay(ctx, deliveries[1].ID)
+	require.NoError(t, err)
+	require.Len(t, replayed, 1)
+
+	pending, err := outbox.Pending(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, deliveries[1].ID, pending[0].ID)
+
+	_, err = deadLetters.Replay(ctx, deliveries[1].ID)
+	require.Error(t, err)
+
+	removed, err := deadLetters.Remove(ctx, ReplayAll)
+	require.NoError(t, err)
+	require.Equal(t, 2, removed)
+
+	letters, err = deadLetters.List(ctx)
+	require.NoError(t, err)
+	require.Empty(t, letters)
+}
+
+func TestDeadLetterCommandsReplay(t *testing.T) {
+	store := newTestStore()
+
+	ctx := context.Background()
+	outbox := NewOutbox(store)
+	deadLetters := NewDeadLetters(store, outbox)
+
+	notifier := &flakyNotifier{mx: &sync.Mutex{}}
+	dispatcher := NewDispatcher(ctx, notifier, outbox, deadLetters, config.Delivery{QueueSize: 10})
+	commands := NewDeadLetterCommands(deadLetters, dispatcher)
+
+	for _, d := range testDeliveries(t, outbox, systems.Destination{ChannelID: "1"}, 2) {
+		require.NoError(t, deadLetters.Add(ctx, d, 1, errors.New("discord is down")))
+		require.NoError(t, outbox.Done(ctx, d))
+	}
+
+	lines, err := commands.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+
+	_, err = commands.Inspect(ctx, "unknown")
+	require.Error(t, err)
+
+	count, err := commands.Replay(ctx, ReplayAll)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	require.Eventually(t, func() bool {
+		return len(notifier.sentKillmails()) == 2
+	}, time.Second, 5*time.Millisecond)
+
+	dispatcher.Close()
+	<-dispatcher.Done()
+
+	pending, err := outbox.Pending(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
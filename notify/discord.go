@@ -0,0 +1,75 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/discord"
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"github.com/bwmarrin/discordgo"
+)
+
+// Discord posts to channels through the bot session, or to webhooks when the
+// destination has one. The session is nil when the bot runs without a token.
+type Discord struct {
+	session  *discordgo.Session
+	webhooks *discord.WebhookSender
+}
+
+func NewDiscord(session *discordgo.Session, webhooks *discord.WebhookSender) *Discord {
+	return &Discord{
+		session:  session,
+		webhooks: webhooks,
+	}
+}
+
+func (d *Discord) Notify(ctx context.Context, destination systems.Destination, n Notification) error {
+	if destination.Webhook != "" {
+		return d.webhooks.Send(ctx, destination.Webhook, &discordgo.WebhookParams{
+			Content: destination.Mention,
+			Embeds:  []*discordgo.MessageEmbed{n.Embed},
+		})
+	}
+
+	if d.session == nil {
+		return errors.New("no discord session to send to channels")
+	}
+
+	if _, err := d.session.State.Channel(destination.ChannelID); err != nil {
+		return fmt.Errorf("channel not found: %w", err)
+	}
+
+	_, err := d.session.ChannelMessageSendComplex(destination.ChannelID, &discordgo.MessageSend{
+		Content: destination.Mention,
+		Embeds:  []*discordgo.MessageEmbed{n.Embed},
+	}, discordgo.WithContext(ctx))
+	return err
+}
+
+// Wait blocks until Discord's rate limit bucket for the destination has room
+// for a message. The buckets are kept from the rate limit headers, by
+// discordgo for the session and by the webhook sender for webhooks.
+func (d *Discord) Wait(ctx context.Context, destination systems.Destination) error {
+	if destination.Webhook != "" {
+		return d.webhooks.Wait(ctx, destination.Webhook)
+	}
+
+	if d.session == nil {
+		return nil
+	}
+
+	limiter := d.session.Ratelimiter
+	delay := limiter.GetWaitTime(limiter.GetBucket(discordgo.EndpointChannelMessages(destination.ChannelID)), 1)
+	if delay <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
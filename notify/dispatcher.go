@@ -0,0 +1,413 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/common"
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	OverflowDropOldest = "drop_oldest"
+	OverflowCollapse   = "collapse"
+
+	// Discord allows 25 fields in an embed, one is left for the remainder
+	summaryMaxFields = 24
+)
+
+// Dispatcher sends deliveries through a queue per destination. Each queue
+// has its own worker that sends one delivery at a time and waits for the
+// destination's rate limit, so a burst to one channel doesn't hold up the
+// others. Full queues drop their oldest delivery or collapse into a summary.
+// Failed sends are retried with backoff, deliveries that fail every attempt
+// go to the dead letters.
+type Dispatcher struct {
+	mx *sync.Mutex
+
+	ctx         context.Context
+	notifier    Notifier
+	outbox      *Outbox
+	deadLetters *DeadLetters
+	size        int
+	overflow    string
+	attempts    int
+	backoffMin  time.Duration
+	backoffMax  time.Duration
+
+	queues map[string]*queue
+	// deliveries that are queued or being sent, by ID
+	active map[string]struct{}
+	// when deliveries left the outbox, so SendPending doesn't send them again
+	// from an older read of the outbox
+	finished map[string]time.Time
+	closed   bool
+	// closed with Close, ends waiting for retries
+	stop chan struct{}
+	wg   *sync.WaitGroup
+}
+
+type queue struct {
+	mx *sync.Mutex
+
+	destination systems.Destination
+	items       []Delivery
+	// signals the worker that there are items or the queue was closed
+	ready  chan struct{}
+	closed bool
+}
+
+func NewDispatcher(ctx context.Context, notifier Notifier, outbox *Outbox, deadLetters *DeadLetters, cfg config.Delivery) *Dispatcher {
+	return &Dispatcher{
+		mx:          &sync.Mutex{},
+		ctx:         ctx,
+		notifier:    notifier,
+		outbox:      outbox,
+		deadLetters: deadLetters,
+		size:        max(cfg.QueueSize, 1),
+		overflow:    cfg.Overflow,
+		attempts:    max(cfg.Attempts, 1),
+		backoffMin:  time.Duration(cfg.BackoffMin) * time.Second,
+		backoffMax:  time.Duration(cfg.BackoffMax) * time.Second,
+		queues:      make(map[string]*queue),
+		active:      make(map[string]struct{}),
+		finished:    make(map[string]time.Time),
+		stop:        make(chan struct{}),
+		wg:          &sync.WaitGroup{},
+	}
+}
+
+// Send queues the delivery for its destination, starting the destination's
+// worker with its first delivery. Deliveries that are queued already are
+// left alone.
+func (d *Dispatcher) Send(delivery Delivery) {
+	d.mx.Lock()
+	if d.closed {
+		d.mx.Unlock()
+		slog.Warn("dispatcher is closed, delivery stays in the outbox", "delivery", delivery.ID)
+		return
+	}
+	if _, ok := d.active[delivery.ID]; ok {
+		d.mx.Unlock()
+		return
+	}
+	d.active[delivery.ID] = struct{}{}
+
+	key := delivery.Destination.Key()
+	q, ok := d.queues[key]
+	if !ok {
+		q = &queue{
+			mx:          &sync.Mutex{},
+			destination: delivery.Destination,
+			ready:       make(chan struct{}, 1),
+		}
+		d.queues[key] = q
+
+		d.wg.Add(1)
+		go d.work(q)
+	}
+	d.mx.Unlock()
+
+	common.GetBackpressureMonitor().Increase("channel_send")
+	d.push(q, delivery)
+}
+
+// SendPending sends the deliveries in the outbox that aren't queued, which
+// are those left over from the last run and replayed dead letters.
+func (d *Dispatcher) SendPending(ctx context.Context) error {
+	start := time.Now()
+	pending, err := d.outbox.Pending(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range pending {
+		d.mx.Lock()
+		finished := d.finished[delivery.ID].After(start)
+		d.mx.Unlock()
+
+		// it was sent after the outbox was read
+		if finished {
+			continue
+		}
+		d.Send(delivery)
+	}
+
+	// deliveries that finished before the outbox was read aren't in it
+	d.mx.Lock()
+	for id, t := range d.finished {
+		if t.Before(start) {
+			delete(d.finished, id)
+		}
+	}
+	d.mx.Unlock()
+
+	return nil
+}
+
+// Close stops taking deliveries and retrying. The workers try what is
+// queued once and stop, Done is closed once they all did.
+func (d *Dispatcher) Close() {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	if d.closed {
+		return
+	}
+	d.closed = true
+	close(d.stop)
+	for _, q := range d.queues {
+		q.mx.Lock()
+		q.closed = true
+		q.mx.Unlock()
+		q.signal()
+	}
+}
+
+func (d *Dispatcher) Done() <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	return done
+}
+
+// push adds the delivery to the queue and applies the overflow policy when
+// the queue is full. The summary of a collapsed queue replaces its
+// deliveries in the outbox.
+func (d *Dispatcher) push(q *queue, delivery Delivery) {
+	q.mx.Lock()
+
+	var removed []Delivery
+	var summary *Delivery
+	switch {
+	case len(q.items) < d.size:
+		q.items = append(q.items, delivery)
+	case d.overflow == OverflowCollapse && platform(q.destination) != PlatformHTTP:
+		// tools reading the JSON want every killmail, not a summary
+		removed = q.items
+		s := collapse(append(q.items, delivery))
+		summary = &s
+		q.items = []Delivery{s}
+	default:
+		removed = []Delivery{q.items[0]}
+		q.items = append(q.items[1:], delivery)
+	}
+	q.mx.Unlock()
+	q.signal()
+
+	if summary != nil {
+		d.mx.Lock()
+		d.active[summary.ID] = struct{}{}
+		d.mx.Unlock()
+
+		if err := d.outbox.Put(d.ctx, *summary); err != nil {
+			slog.Error("failed to add summary to outbox", "delivery", summary.ID, "error", err)
+		}
+		d.finish(delivery)
+	}
+
+	if len(removed) > 0 {
+		slog.Warn("queue is full",
+			"destination", q.destination,
+			"policy", d.overflow,
+			"removed", len(removed),
+		)
+	}
+	for _, r := range removed {
+		d.finish(r)
+		common.GetBackpressureMonitor().Decrease("channel_send")
+	}
+}
+
+func (q *queue) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// wait blocks until the queue has a delivery, false means the queue was
+// closed and is empty.
+func (q *queue) wait(ctx context.Context) bool {
+	for {
+		q.mx.Lock()
+		empty, closed := len(q.items) == 0, q.closed
+		q.mx.Unlock()
+
+		if !empty {
+			return true
+		}
+		if closed {
+			return false
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-q.ready:
+		}
+	}
+}
+
+func (q *queue) pop() Delivery {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	delivery := q.items[0]
+	q.items = q.items[1:]
+
+	return delivery
+}
+
+func (d *Dispatcher) work(q *queue) {
+	defer d.wg.Done()
+
+	pacer, _ := d.notifier.(Pacer)
+	for q.wait(d.ctx) {
+		// take the delivery only once the destination accepts it, what piles
+		// up in the meantime is subject to the overflow policy
+		if pacer != nil {
+			if err := pacer.Wait(d.ctx, q.destination); err != nil {
+				return
+			}
+		}
+
+		d.deliver(q.pop())
+	}
+}
+
+// deliver sends the delivery, retrying with backoff. Once the attempts are
+// used up it goes to the dead letters. When the dispatcher is closed while
+// waiting to retry, the delivery stays in the outbox for the next start.
+func (d *Dispatcher) deliver(delivery Delivery) {
+	defer common.GetBackpressureMonitor().Decrease("channel_send")
+
+	backoff := common.NewBackoff(d.backoffMin, d.backoffMax)
+	for attempt := 1; ; attempt++ {
+		err := d.notifier.Notify(d.ctx, delivery.Destination, delivery.Notification)
+		if err == nil {
+			d.finish(delivery)
+			return
+		}
+
+		if attempt >= d.attempts {
+			slog.Error("failed to send message, moving it to the dead letters",
+				"destination", delivery.Destination,
+				"delivery", delivery.ID,
+				"attempts", attempt,
+				"error", err,
+			)
+			if err := d.deadLetters.Add(d.ctx, delivery, attempt, err); err != nil {
+				slog.Error("failed to add dead letter, delivery stays in the outbox", "delivery", delivery.ID, "error", err)
+				d.release(delivery)
+				return
+			}
+			d.finish(delivery)
+			return
+		}
+
+		wait := backoff.Next()
+		slog.Warn("failed to send message, retrying",
+			"destination", delivery.Destination,
+			"delivery", delivery.ID,
+			"attempt", attempt,
+			"retry_in", wait.String(),
+			"error", err,
+		)
+
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-d.stop:
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// finish removes a delivery that was sent, dead lettered, dropped or
+// collapsed from the outbox.
+func (d *Dispatcher) finish(delivery Delivery) {
+	if err := d.outbox.Done(d.ctx, delivery); err != nil {
+		slog.Error("failed to remove delivery from outbox", "delivery", delivery.ID, "error", err)
+	}
+
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	delete(d.active, delivery.ID)
+	d.finished[delivery.ID] = time.Now()
+}
+
+// release lets a delivery that stays in the outbox be sent again by
+// SendPending.
+func (d *Dispatcher) release(delivery Delivery) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	delete(d.active, delivery.ID)
+}
+
+// collapse merges the deliveries into a single summary. Earlier summaries
+// are unpacked, so the summary lists every killmail once.
+func collapse(deliveries []Delivery) Delivery {
+	notifications := make([]Notification, 0, len(deliveries))
+	for _, d := range deliveries {
+		if len(d.Notification.Collapsed) > 0 {
+			notifications = append(notifications, d.Notification.Collapsed...)
+			continue
+		}
+		notifications = append(notifications, d.Notification)
+	}
+
+	last := deliveries[len(deliveries)-1]
+	return Delivery{
+		ID:          "summary-" + last.ID,
+		Destination: last.Destination,
+		Notification: Notification{
+			Embed:     summaryEmbed(notifications),
+			Collapsed: notifications,
+		},
+		Enqueued: deliveries[0].Enqueued,
+	}
+}
+
+// summaryEmbed lists the killmails with a link each, every platform renders
+// embed fields.
+func summaryEmbed(notifications []Notification) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Type:        discordgo.EmbedTypeRich,
+		Title:       fmt.Sprintf("%d killmails", len(notifications)),
+		Description: "Sent as a summary because the channel fell behind",
+		Footer:      &discordgo.MessageEmbedFooter{Text: "zKillboard"},
+	}
+
+	for i, n := range notifications {
+		if n.Embed == nil {
+			continue
+		}
+		if len(embed.Fields) == summaryMaxFields {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:  "More",
+				Value: fmt.Sprintf("and %d more", len(notifications)-i),
+			})
+			break
+		}
+
+		embed.Color = n.Embed.Color
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  n.Embed.Title,
+			Value: n.Embed.URL,
+		})
+	}
+
+	return embed
+}
@@ -0,0 +1,362 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+)
+
+// gatedNotifier holds back destinations until their gate is opened and
+// records what was sent.
+type gatedNotifier struct {
+	mx    *sync.Mutex
+	gates map[string]chan struct{}
+	sent  map[string][]Notification
+}
+
+func newGatedNotifier(held ...string) *gatedNotifier {
+	g := &gatedNotifier{
+		mx:    &sync.Mutex{},
+		gates: make(map[string]chan struct{}),
+		sent:  make(map[string][]Notification),
+	}
+	for _, id := range held {
+		g.gates[id] = make(chan struct{})
+	}
+
+	return g
+}
+
+func (g *gatedNotifier) Wait(ctx context.Context, destination systems.Destination) error {
+	g.mx.Lock()
+	gate, ok := g.gates[destination.ChannelID]
+	g.mx.Unlock()
+	if !ok {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-gate:
+		return nil
+	}
+}
+
+func (g *gatedNotifier) Notify(_ context.Context, destination systems.Destination, n Notification) error {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	g.sent[destination.ChannelID] = append(g.sent[destination.ChannelID], n)
+	return nil
+}
+
+func (g *gatedNotifier) open(id string) {
+	close(g.gates[id])
+}
+
+func (g *gatedNotifier) sentTo(id string) []Notification {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	return g.sent[id]
+}
+
+func testDeliveries(t *testing.T, outbox *Outbox, destination systems.Destination, count int) []Delivery {
+	deliveries := make([]Delivery, 0, count)
+	for i := 1; i <= count; i++ {
+		n := Notification{
+			KillmailID: uint64(i),
+			Embed: &discordgo.MessageEmbed{
+				Title: fmt.Sprintf("Kill %d", i),
+				URL:   fmt.Sprintf("https://zkillboard.com/kill/%d/", i),
+			},
+		}
+		added, err := outbox.Add(context.Background(), n, []systems.Destination{destination})
+		require.NoError(t, err)
+		deliveries = append(deliveries, added...)
+	}
+
+	return deliveries
+}
+
+func killmailIDs(notifications []Notification) []uint64 {
+	ids := make([]uint64, 0, len(notifications))
+	for _, n := range notifications {
+		ids = append(ids, n.KillmailID)
+	}
+
+	return ids
+}
+
+func TestDispatcherQueuesPerDestination(t *testing.T) {
+	store := newTestStore()
+	outbox := NewOutbox(store)
+
+	notifier := newGatedNotifier("busy")
+	dispatcher := NewDispatcher(context.Background(), notifier, outbox, NewDeadLetters(store, outbox), config.Delivery{
+		QueueSize: 10,
+		Overflow:  OverflowDropOldest,
+	})
+
+	for _, d := range testDeliveries(t, outbox, systems.Destination{ChannelID: "busy"}, 3) {
+		dispatcher.Send(d)
+	}
+	for _, d := range testDeliveries(t, outbox, systems.Destination{ChannelID: "quiet"}, 1) {
+		dispatcher.Send(d)
+	}
+
+	// the quiet channel doesn't wait for the busy one
+	require.Eventually(t, func() bool {
+		return len(notifier.sentTo("quiet")) == 1
+	}, time.Second, 5*time.Millisecond)
+	require.Empty(t, notifier.sentTo("busy"))
+
+	notifier.open("busy")
+	dispatcher.Close()
+	<-dispatcher.Done()
+
+	require.Equal(t, []uint64{1, 2, 3}, killmailIDs(notifier.sentTo("busy")))
+
+	pending, err := outbox.Pending(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+func TestDispatcherOverflow(t *testing.T) {
+	tests := []struct {
+		label       string
+		destination systems.Destination
+		overflow    string
+		expected    []uint64
+		collapsed   []uint64
+	}{
+		{
+			label:       "drop oldest",
+			destination: systems.Destination{ChannelID: "busy"},
+			overflow:    OverflowDropOldest,
+			expected:    []uint64{3, 4, 5},
+		},
+		{
+			label:       "collapse",
+			destination: systems.Destination{ChannelID: "busy"},
+			overflow:    OverflowCollapse,
+			// the queue has room again once it collapsed
+			expected:  []uint64{0, 5},
+			collapsed: []uint64{1, 2, 3, 4},
+		},
+		{
+			label:       "collapse drops oldest for http",
+			destination: systems.Destination{Platform: PlatformHTTP, ChannelID: "busy", Webhook: "https://example.com/kills"},
+			overflow:    OverflowCollapse,
+			expected:    []uint64{3, 4, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			store := newTestStore()
+			outbox := NewOutbox(store)
+
+			notifier := newGatedNotifier("busy")
+			dispatcher := NewDispatcher(context.Background(), notifier, outbox, NewDeadLetters(store, outbox), config.Delivery{
+				QueueSize: 3,
+				Overflow:  tt.overflow,
+			})
+
+			for _, d := range testDeliveries(t, outbox, tt.destination, 5) {
+				dispatcher.Send(d)
+			}
+
+			// what was dropped or collapsed is gone from the outbox already
+			pending, err := outbox.Pending(context.Background())
+			require.NoError(t, err)
+			require.Len(t, pending, len(tt.expected))
+
+			notifier.open("busy")
+			dispatcher.Close()
+			<-dispatcher.Done()
+
+			sent := notifier.sentTo("busy")
+			require.Equal(t, tt.expected, killmailIDs(sent))
+			if tt.collapsed != nil {
+				require.Equal(t, tt.collapsed, killmailIDs(sent[0].Collapsed))
+				require.Equal(t, "4 killmails", sent[0].Embed.Title)
+				require.Equal(t, "https://zkillboard.com/kill/4/", sent[0].Embed.Fields[3].Value)
+			}
+
+			pending, err = outbox.Pending(context.Background())
+			require.NoError(t, err)
+			require.Empty(t, pending)
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestSummaryEmbed(t *testing.T) {
+	notifications := make([]Notification, 0, 30)
+	for i := 1; i <= 30; i++ {
+		notifications = append(notifications, Notification{
+			KillmailID: uint64(i),
+			Embed:      &discordgo.MessageEmbed{Title: fmt.Sprintf("Kill %d", i), URL: "https://zkillboard.com/"},
+		})
+	}
+
+	embed := summaryEmbed(notifications)
+	require.Equal(t, "30 killmails", embed.Title)
+	require.Len(t, embed.Fields, summaryMaxFields+1)
+	require.Equal(t, "and 6 more", embed.Fields[summaryMaxFields].Value)
+}
+
+// flakyNotifier fails the first sends and records the killmails it sent.
+type flakyNotifier struct {
+	mx       *sync.Mutex
+	failures int
+	calls    int
+	sent     []uint64
+}
+
+func (f *flakyNotifier) Notify(_ context.Context, _ systems.Destination, n Notification) error {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.calls++
+	if f.calls <= f.failures {
+		return fmt.Errorf("discord is down")
+	}
+
+	f.sent = append(f.sent, n.KillmailID)
+	return nil
+}
+
+func (f *flakyNotifier) sentKillmails() []uint64 {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	return f.sent
+}
+
+func TestDispatcherRetries(t *testing.T) {
+	tests := []struct {
+		label       string
+		failures    int
+		attempts    int
+		sent        []uint64
+		deadLetters int
+	}{
+		{
+			label:    "sends after failures",
+			failures: 2,
+			attempts: 3,
+			sent:     []uint64{1},
+		},
+		{
+			label:       "dead letters after the attempts",
+			failures:    3,
+			attempts:    3,
+			deadLetters: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			store := newTestStore()
+			outbox := NewOutbox(store)
+			deadLetters := NewDeadLetters(store, outbox)
+
+			notifier := &flakyNotifier{mx: &sync.Mutex{}, failures: tt.failures}
+			dispatcher := NewDispatcher(context.Background(), notifier, outbox, deadLetters, config.Delivery{
+				QueueSize: 10,
+				Attempts:  tt.attempts,
+			})
+			dispatcher.backoffMin = time.Millisecond
+			dispatcher.backoffMax = 5 * time.Millisecond
+
+			for _, d := range testDeliveries(t, outbox, systems.Destination{ChannelID: "1"}, 1) {
+				dispatcher.Send(d)
+			}
+
+			require.Eventually(t, func() bool {
+				pending, err := outbox.Pending(context.Background())
+				return err == nil && len(pending) == 0
+			}, time.Second, 5*time.Millisecond)
+
+			require.Equal(t, tt.sent, notifier.sentKillmails())
+
+			letters, err := deadLetters.List(context.Background())
+			require.NoError(t, err)
+			require.Len(t, letters, tt.deadLetters)
+			if tt.deadLetters > 0 {
+				require.Equal(t, "discord is down", letters[0].Error)
+				require.Equal(t, tt.attempts, letters[0].Attempts)
+			}
+
+			dispatcher.Close()
+			<-dispatcher.Done()
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestDispatcherCloseKeepsRetries(t *testing.T) {
+	store := newTestStore()
+	outbox := NewOutbox(store)
+
+	notifier := &flakyNotifier{mx: &sync.Mutex{}, failures: 1}
+	dispatcher := NewDispatcher(context.Background(), notifier, outbox, NewDeadLetters(store, outbox), config.Delivery{
+		QueueSize:  10,
+		Attempts:   3,
+		BackoffMin: 60,
+		BackoffMax: 60,
+	})
+
+	for _, d := range testDeliveries(t, outbox, systems.Destination{ChannelID: "1"}, 1) {
+		dispatcher.Send(d)
+	}
+
+	require.Eventually(t, func() bool {
+		notifier.mx.Lock()
+		defer notifier.mx.Unlock()
+		return notifier.calls == 1
+	}, time.Second, 5*time.Millisecond)
+
+	// closing doesn't wait out the backoff, the delivery is sent on the next start
+	dispatcher.Close()
+	<-dispatcher.Done()
+
+	pending, err := outbox.Pending(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+}
+
+func TestDispatcherSendPending(t *testing.T) {
+	store := newTestStore()
+	outbox := NewOutbox(store)
+
+	notifier := newGatedNotifier("1")
+	dispatcher := NewDispatcher(context.Background(), notifier, outbox, NewDeadLetters(store, outbox), config.Delivery{
+		QueueSize: 10,
+	})
+
+	deliveries := testDeliveries(t, outbox, systems.Destination{ChannelID: "1"}, 2)
+	dispatcher.Send(deliveries[0])
+
+	// only the delivery that isn't queued yet is added
+	require.NoError(t, dispatcher.SendPending(context.Background()))
+	require.NoError(t, dispatcher.SendPending(context.Background()))
+
+	notifier.open("1")
+	dispatcher.Close()
+	<-dispatcher.Done()
+
+	require.Equal(t, []uint64{1, 2}, killmailIDs(notifier.sentTo("1")))
+}
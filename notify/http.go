@@ -0,0 +1,148 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	SignatureHeader = "X-Chainkills-Signature"
+	VersionHeader   = "X-Chainkills-Payload-Version"
+
+	// requests that may hit a rate limit before the delivery is handed back
+	// as failed, other failures are retried by the dispatcher
+	httpRateLimits = 3
+)
+
+var errHTTPRateLimited = errors.New("http webhook is rate limited")
+
+// HTTP posts the killmail as JSON to the destination's webhook, for tools
+// that want the data rather than a chat message. With a secret the body is
+// signed with HMAC-SHA256, sent as sha256=<hex> in the signature header.
+type HTTP struct {
+	client *http.Client
+	secret []byte
+	// wait after a 429 without a Retry-After header
+	rateLimitWait time.Duration
+}
+
+func NewHTTP(secret string) *HTTP {
+	return &HTTP{
+		client:        &http.Client{Timeout: 10 * time.Second},
+		secret:        []byte(secret),
+		rateLimitWait: time.Second,
+	}
+}
+
+func (h *HTTP) Notify(ctx context.Context, destination systems.Destination, n Notification) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "HTTPNotify")
+	defer span.End()
+
+	if destination.Webhook == "" {
+		err := errors.New("http destinations need a webhook")
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	payload := n.Payload
+	for attempt := 1; ; attempt++ {
+		// the payload may have waited in the outbox or the dead letters
+		payload.SentAt = time.Now().UTC()
+		body, err := json.Marshal(payload)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+
+		wait, err := h.post(sctx, destination.Webhook, body)
+		if err == nil {
+			span.SetAttributes(attribute.Int("attempts", attempt))
+			return nil
+		}
+		if !errors.Is(err, errHTTPRateLimited) || attempt >= httpRateLimits {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+
+		slog.Warn("http webhook is rate limited, waiting",
+			"destination", destination,
+			"attempt", attempt,
+			"wait", wait.String(),
+		)
+
+		select {
+		case <-sctx.Done():
+			return sctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// post sends the request once. When the webhook is rate limited it returns
+// how long to wait before the next request.
+func (h *HTTP) post(ctx context.Context, target string, body []byte) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return 0, withoutURL(err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("User-Agent", userAgent())
+	req.Header.Add(VersionHeader, strconv.Itoa(systems.PayloadVersion))
+	if len(h.secret) > 0 {
+		req.Header.Add(SignatureHeader, Sign(h.secret, body))
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		// webhook URLs often carry a token
+		return 0, withoutURL(err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("failed to close response body", "error", err)
+		}
+	}()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return 0, nil
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		wait := h.rateLimitWait
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			wait = time.Duration(seconds) * time.Second
+		}
+		return wait, errHTTPRateLimited
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return 0, fmt.Errorf("unexpected status code from http webhook: %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+}
+
+// Sign returns the signature header value for the body. Receivers compute
+// the same from the raw body and compare with hmac.Equal.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,119 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTP(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	var payload systems.Payload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		require.Equal(t, "1", r.Header.Get(VersionHeader))
+		require.Equal(t, Sign([]byte("secret"), body), r.Header.Get(SignatureHeader))
+		require.NoError(t, json.Unmarshal(body, &payload))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	km := systems.Killmail{KillmailID: 123, SolarSystemID: 31000355}
+	n := testNotification
+	n.Payload = km.Payload(context.Background())
+	// rendered long before, e.g. a replayed dead letter
+	n.Payload.SentAt = time.Now().Add(-time.Hour)
+
+	destination := systems.Destination{Platform: PlatformHTTP, Webhook: srv.URL}
+	require.NoError(t, NewHTTP("secret").Notify(context.Background(), destination, n))
+
+	require.Equal(t, systems.PayloadVersion, payload.Version)
+	require.WithinDuration(t, time.Now(), payload.SentAt, time.Minute)
+	require.Equal(t, uint64(123), payload.Killmail.KillmailID)
+	require.Equal(t, "J164417", payload.System.Name)
+
+	require.Error(t, NewHTTP("secret").Notify(context.Background(), systems.Destination{Platform: PlatformHTTP}, n))
+}
+
+func TestHTTPUnsigned(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.Header.Get(SignatureHeader))
+	}))
+	defer srv.Close()
+
+	require.NoError(t, NewHTTP("").Notify(context.Background(), systems.Destination{Webhook: srv.URL}, testNotification))
+}
+
+func TestHTTPRateLimits(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	tests := []struct {
+		label    string
+		statuses []int
+		requests int32
+		fails    bool
+	}{
+		{
+			label:    "waits out a rate limit",
+			statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+			requests: 2,
+		},
+		{
+			label:    "gives up after the rate limits",
+			statuses: []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			requests: httpRateLimits,
+			fails:    true,
+		},
+		{
+			label:    "leaves server errors to the dispatcher",
+			statuses: []int{http.StatusBadGateway, http.StatusOK},
+			requests: 1,
+			fails:    true,
+		},
+		{
+			label:    "doesn't retry client errors",
+			statuses: []int{http.StatusBadRequest, http.StatusOK},
+			requests: 1,
+			fails:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			var requests atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				i := requests.Add(1) - 1
+				w.WriteHeader(tt.statuses[i])
+			}))
+			defer srv.Close()
+
+			notifier := NewHTTP("secret")
+			notifier.rateLimitWait = 10 * time.Millisecond
+
+			err := notifier.Notify(context.Background(), systems.Destination{Webhook: srv.URL}, testNotification)
+			if tt.fails {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.requests, requests.Load())
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
@@ -0,0 +1,125 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Matrix sends messages to rooms through the client-server API. The
+// destination's channel ID is the room ID, and the user behind the token has
+// to be a member of the room already.
+type Matrix struct {
+	client     *http.Client
+	homeserver string
+	token      string
+}
+
+func NewMatrix(homeserver, token string) *Matrix {
+	return &Matrix{
+		client:     &http.Client{Timeout: 10 * time.Second},
+		homeserver: strings.TrimRight(homeserver, "/"),
+		token:      token,
+	}
+}
+
+// matrixTxnID is the same for every attempt at sending the notification to
+// the room, so the homeserver takes retries of a message it already posted
+// as the same message. Summaries are told apart by the killmails they list.
+func matrixTxnID(room string, n Notification) string {
+	h := sha256.New()
+	h.Write([]byte(room))
+	fmt.Fprintf(h, ":%d", n.KillmailID)
+	for _, c := range n.Collapsed {
+		fmt.Fprintf(h, ":%d", c.KillmailID)
+	}
+
+	return fmt.Sprintf("chainkills-%d-%x", n.KillmailID, h.Sum(nil)[:6])
+}
+
+type matrixMessage struct {
+	MsgType       string `json:"msgtype"`
+	Body          string `json:"body"`
+	Format        string `json:"format"`
+	FormattedBody string `json:"formatted_body"`
+}
+
+type matrixError struct {
+	ErrCode string `json:"errcode"`
+	Error   string `json:"error"`
+}
+
+func (m *Matrix) Notify(ctx context.Context, destination systems.Destination, n Notification) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "MatrixNotify")
+	defer span.End()
+
+	txnID := matrixTxnID(destination.ChannelID, n)
+	target := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+		m.homeserver,
+		url.PathEscape(destination.ChannelID),
+		url.PathEscape(txnID),
+	)
+	span.SetAttributes(attribute.String("room", destination.ChannelID))
+
+	body, err := json.Marshal(matrixMessage{
+		MsgType:       "m.text",
+		Body:          plainText(destination.Mention, n.Embed),
+		Format:        "org.matrix.custom.html",
+		FormattedBody: htmlText(destination.Mention, n.Embed, "<br>"),
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(sctx, http.MethodPut, target, bytes.NewReader(body))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", m.token))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("User-Agent", userAgent())
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("failed to close response body", "error", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		merr := matrixError{}
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		if json.Unmarshal(raw, &merr) != nil || merr.ErrCode == "" {
+			merr.Error = string(bytes.TrimSpace(raw))
+		}
+
+		err := fmt.Errorf("unexpected status code from matrix: %d: %s %s", resp.StatusCode, merr.ErrCode, merr.Error)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	packageName = "git.sr.ht/~barveyhirdman/chainkills/notify"
+
+	PlatformDiscord  = "discord"
+	PlatformSlack    = "slack"
+	PlatformMatrix   = "matrix"
+	PlatformTelegram = "telegram"
+	PlatformHTTP     = "http"
+)
+
+// Notification is a killmail rendered once and sent to every destination.
+// The Discord embed is the rendered form, other platforms convert it to their
+// own formatting. The payload is the killmail as data for the http platform.
+type Notification struct {
+	KillmailID uint64                  `json:"killmail_id"`
+	Embed      *discordgo.MessageEmbed `json:"embed"`
+	Payload    systems.Payload         `json:"payload"`
+	// the killmails a summary stands for, see Dispatcher
+	Collapsed []Notification `json:"collapsed,omitempty"`
+}
+
+// Notifier delivers notifications to a single chat platform.
+type Notifier interface {
+	Notify(ctx context.Context, destination systems.Destination, n Notification) error
+}
+
+// Pacer is implemented by notifiers that know when a destination accepts the
+// next message. Queues wait for it before taking the next delivery, so
+// deliveries keep piling up and can be collapsed while a destination is rate
+// limited.
+type Pacer interface {
+	Wait(ctx context.Context, destination systems.Destination) error
+}
+
+// Notifiers sends notifications through the notifier of the destination's
+// platform, keyed by platform name.
+type Notifiers map[string]Notifier
+
+func (n Notifiers) Notify(ctx context.Context, destination systems.Destination, notification Notification) error {
+	notifier, ok := n[platform(destination)]
+	if !ok {
+		return fmt.Errorf("no notifier for platform %s", platform(destination))
+	}
+
+	return notifier.Notify(ctx, destination, notification)
+}
+
+func (n Notifiers) Wait(ctx context.Context, destination systems.Destination) error {
+	if pacer, ok := n[platform(destination)].(Pacer); ok {
+		return pacer.Wait(ctx, destination)
+	}
+
+	return nil
+}
+
+func platform(destination systems.Destination) string {
+	if destination.Platform == "" {
+		return PlatformDiscord
+	}
+
+	return destination.Platform
+}
+
+func userAgent() string {
+	return fmt.Sprintf("%s/%s:%s", config.Get().AdminName, config.Get().AppName, config.Get().Version)
+}
+
+// withoutURL removes the request URL from client errors for APIs that put
+// tokens in it.
+func withoutURL(err error) error {
+	if uerr := (*url.Error)(nil); errors.As(err, &uerr) {
+		return uerr.Err
+	}
+
+	return err
+}
+
+// plainText renders the embed as plain text for clients without formatting.
+func plainText(mention string, embed *discordgo.MessageEmbed) string {
+	lines := make([]string, 0)
+	if mention != "" {
+		lines = append(lines, mention)
+	}
+
+	lines = append(lines, embed.Title)
+	if embed.URL != "" {
+		lines = append(lines, embed.URL)
+	}
+	if embed.Author != nil && embed.Author.Name != "" {
+		lines = append(lines, embed.Author.Name)
+	}
+	if embed.Description != "" {
+		lines = append(lines, embed.Description)
+	}
+
+	for _, field := range embed.Fields {
+		lines = append(lines, fmt.Sprintf("%s: %s", field.Name, strings.ReplaceAll(field.Value, "\n", ", ")))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// htmlText renders the embed with the HTML subset Matrix and Telegram share.
+// Telegram doesn't know <br>, so the line break is up to the caller.
+func htmlText(mention string, embed *discordgo.MessageEmbed, lineBreak string) string {
+	lines := make([]string, 0)
+	if mention != "" {
+		lines = append(lines, html.EscapeString(mention))
+	}
+
+	title := html.EscapeString(embed.Title)
+	if embed.URL != "" {
+		title = fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(embed.URL), title)
+	}
+	lines = append(lines, "<b>"+title+"</b>")
+
+	if embed.Author != nil && embed.Author.Name != "" {
+		lines = append(lines, html.EscapeString(embed.Author.Name))
+	}
+	if embed.Description != "" {
+		lines = append(lines, "<i>"+html.EscapeString(embed.Description)+"</i>")
+	}
+
+	for _, field := range embed.Fields {
+		value := strings.ReplaceAll(html.EscapeString(field.Value), "\n", lineBreak)
+		lines = append(lines, fmt.Sprintf("<b>%s</b>: %s", html.EscapeString(field.Name), value))
+	}
+
+	return strings.Join(lines, lineBreak)
+}
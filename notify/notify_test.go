@@ -0,0 +1,192 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"git.sr.ht/~barveyhirdman/chainkills/discord"
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+)
+
+var testNotification = Notification{
+	KillmailID: 123,
+	Embed: &discordgo.MessageEmbed{
+		URL:    "https://zkillboard.com/kill/123/",
+		Title:  "Pilot lost a Rifter",
+		Color:  systems.ColorOurLoss,
+		Author: &discordgo.MessageEmbedAuthor{Name: "Corp <A> / Alliance"},
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Location", Value: "J152820 C5 (C-R00001)"},
+			{Name: "Value", Value: "1.20M ISK\nDropped: 100.00K ISK"},
+		},
+		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: "https://images.evetech.net/types/587/render?size=128"},
+		Footer:    &discordgo.MessageEmbedFooter{Text: "zKillboard"},
+	},
+}
+
+type recordingNotifier struct {
+	destinations []systems.Destination
+}
+
+func (r *recordingNotifier) Notify(_ context.Context, d systems.Destination, _ Notification) error {
+	r.destinations = append(r.destinations, d)
+	return nil
+}
+
+func TestNotifiers(t *testing.T) {
+	discordNotifier := &recordingNotifier{}
+	matrixNotifier := &recordingNotifier{}
+	notifiers := Notifiers{PlatformDiscord: discordNotifier, PlatformMatrix: matrixNotifier}
+
+	require.NoError(t, notifiers.Notify(context.Background(), systems.Destination{ChannelID: "1"}, testNotification))
+	require.NoError(t, notifiers.Notify(context.Background(), systems.Destination{Platform: PlatformMatrix, ChannelID: "!room:example"}, testNotification))
+	require.Error(t, notifiers.Notify(context.Background(), systems.Destination{Platform: "irc", ChannelID: "#chain"}, testNotification))
+
+	require.Len(t, discordNotifier.destinations, 1)
+	require.Len(t, matrixNotifier.destinations, 1)
+}
+
+func TestDiscord(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params discordgo.WebhookParams
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+		require.Equal(t, "<@&42>", params.Content)
+		require.Equal(t, "Pilot lost a Rifter", params.Embeds[0].Title)
+	}))
+	defer srv.Close()
+
+	notifier := NewDiscord(nil, discord.NewWebhookSender())
+	require.NoError(t, notifier.Notify(context.Background(), systems.Destination{Webhook: srv.URL, Mention: "<@&42>"}, testNotification))
+
+	// channels need the bot
+	require.Error(t, notifier.Notify(context.Background(), systems.Destination{ChannelID: "1"}, testNotification))
+}
+
+func TestSlack(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	var message slackMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	destination := systems.Destination{Platform: PlatformSlack, Webhook: srv.URL, Mention: "<!here>"}
+	require.NoError(t, NewSlack().Notify(context.Background(), destination, testNotification))
+
+	require.Equal(t, "<!here> Pilot lost a Rifter", message.Text)
+	require.Len(t, message.Attachments, 1)
+	require.Equal(t, "#990000", message.Attachments[0].Color)
+
+	blocks := message.Attachments[0].Blocks
+	require.Len(t, blocks, 3)
+	require.Equal(t, "*<https://zkillboard.com/kill/123/|Pilot lost a Rifter>*\nCorp &lt;A&gt; / Alliance", blocks[0].Text.Text)
+	require.Equal(t, "image", blocks[0].Accessory.Type)
+	require.Equal(t, "*Value*\n1.20M ISK\nDropped: 100.00K ISK", blocks[1].Fields[1].Text)
+	require.Equal(t, "context", blocks[2].Type)
+
+	require.Error(t, NewSlack().Notify(context.Background(), systems.Destination{Platform: PlatformSlack}, testNotification))
+}
+
+func TestSlackFails(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("no_service"))
+	}))
+	defer srv.Close()
+
+	err := NewSlack().Notify(context.Background(), systems.Destination{Webhook: srv.URL}, testNotification)
+	require.ErrorContains(t, err, "no_service")
+}
+
+func TestMatrix(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	var message matrixMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Regexp(t, `^/_matrix/client/v3/rooms/!chain:example\.org/send/m\.room\.message/chainkills-123-[0-9a-f]{12}$`, r.URL.Path)
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+
+		_, _ = w.Write([]byte(`{"event_id":"$1"}`))
+	}))
+	defer srv.Close()
+
+	destination := systems.Destination{Platform: PlatformMatrix, ChannelID: "!chain:example.org", Mention: "@room"}
+	require.NoError(t, NewMatrix(srv.URL+"/", "secret").Notify(context.Background(), destination, testNotification))
+
+	require.Equal(t, "m.text", message.MsgType)
+	require.Equal(t, "org.matrix.custom.html", message.Format)
+	require.Equal(t, "@room\nPilot lost a Rifter\nhttps://zkillboard.com/kill/123/\nCorp <A> / Alliance\nLocation: J152820 C5 (C-R00001)\nValue: 1.20M ISK, Dropped: 100.00K ISK", message.Body)
+	require.Equal(t, `@room<br><b><a href="https://zkillboard.com/kill/123/">Pilot lost a Rifter</a></b><br>Corp &lt;A&gt; / Alliance<br><b>Location</b>: J152820 C5 (C-R00001)<br><b>Value</b>: 1.20M ISK<br>Dropped: 100.00K ISK`, message.FormattedBody)
+}
+
+func TestMatrixTxnID(t *testing.T) {
+	summary := Notification{Collapsed: []Notification{{KillmailID: 1}, {KillmailID: 2}}}
+	other := Notification{Collapsed: []Notification{{KillmailID: 1}, {KillmailID: 3}}}
+
+	require.Equal(t, matrixTxnID("!a:example.org", testNotification), matrixTxnID("!a:example.org", testNotification))
+	require.NotEqual(t, matrixTxnID("!a:example.org", testNotification), matrixTxnID("!b:example.org", testNotification))
+	require.NotEqual(t, matrixTxnID("!a:example.org", summary), matrixTxnID("!a:example.org", other))
+}
+
+func TestMatrixFails(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errcode":"M_FORBIDDEN","error":"User not in room"}`))
+	}))
+	defer srv.Close()
+
+	err := NewMatrix(srv.URL, "secret").Notify(context.Background(), systems.Destination{ChannelID: "!chain:example.org"}, testNotification)
+	require.ErrorContains(t, err, "M_FORBIDDEN User not in room")
+}
+
+func TestTelegram(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	var message telegramMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/botsecret/sendMessage", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+
+		if message.ChatID == "-1" {
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":{}}`))
+	}))
+	defer srv.Close()
+
+	notifier := NewTelegram(srv.URL, "secret")
+	require.NoError(t, notifier.Notify(context.Background(), systems.Destination{ChannelID: "-1001234567890"}, testNotification))
+
+	require.Equal(t, "-1001234567890", message.ChatID)
+	require.Equal(t, "HTML", message.ParseMode)
+	require.Equal(t, "<b><a href=\"https://zkillboard.com/kill/123/\">Pilot lost a Rifter</a></b>\nCorp &lt;A&gt; / Alliance\n<b>Location</b>: J152820 C5 (C-R00001)\n<b>Value</b>: 1.20M ISK\nDropped: 100.00K ISK", message.Text)
+
+	err := notifier.Notify(context.Background(), systems.Destination{ChannelID: "-1"}, testNotification)
+	require.ErrorContains(t, err, "chat not found")
+}
+
+func TestTelegramHidesToken(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	err := NewTelegram("http://127.0.0.1:1", "secret").Notify(context.Background(), systems.Destination{ChannelID: "1"}, testNotification)
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "secret")
+}
@@ -0,0 +1,134 @@
+package notify
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// OutboxStore is the part of the backend that keeps the outbox.
+type OutboxStore interface {
+	AddOutbox(ctx context.Context, id, entry string) error
+	GetOutbox(ctx context.Context) (map[string]string, error)
+	RemoveOutbox(ctx context.Context, id string) error
+}
+
+// Delivery is a notification for a single destination.
+type Delivery struct {
+	ID           string              `json:"id"`
+	Destination  systems.Destination `json:"destination"`
+	Notification Notification        `json:"notification"`
+	Enqueued     time.Time           `json:"enqueued"`
+}
+
+func NewDelivery(n Notification, destination systems.Destination) Delivery {
+	return Delivery{
+		ID:           deliveryID(n.KillmailID, destination),
+		Destination:  destination,
+		Notification: n,
+		Enqueued:     time.Now().UTC(),
+	}
+}
+
+// deliveryID is stable for a killmail and destination, so a killmail is
+// queued once per destination. Destination keys can hold webhook tokens,
+// hence the hash.
+func deliveryID(killmailID uint64, destination systems.Destination) string {
+	sum := sha256.Sum256([]byte(destination.Key()))
+	return fmt.Sprintf("%d-%x", killmailID, sum[:6])
+}
+
+// Outbox keeps deliveries in the backend until they are sent, so killmails
+// that were accepted survive restarts and outages of the platforms.
+type Outbox struct {
+	store OutboxStore
+}
+
+func NewOutbox(store OutboxStore) *Outbox {
+	return &Outbox{store: store}
+}
+
+// Add stores a delivery of the notification for every destination and
+// returns them.
+func (o *Outbox) Add(ctx context.Context, n Notification, destinations []systems.Destination) ([]Delivery, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "OutboxAdd")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("destinations", len(destinations)))
+
+	deliveries := make([]Delivery, 0, len(destinations))
+	for _, destination := range destinations {
+		deliveries = append(deliveries, NewDelivery(n, destination))
+	}
+
+	for _, d := range deliveries {
+		if err := o.Put(sctx, d); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return deliveries, err
+		}
+	}
+
+	return deliveries, nil
+}
+
+// Put stores the delivery, replacing one with the same ID.
+func (o *Outbox) Put(ctx context.Context, d Delivery) error {
+	entry, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	return o.store.AddOutbox(ctx, d.ID, string(entry))
+}
+
+// Done removes a delivery once it was sent.
+func (o *Outbox) Done(ctx context.Context, d Delivery) error {
+	return o.store.RemoveOutbox(ctx, d.ID)
+}
+
+// Pending returns the deliveries that weren't sent yet, oldest first.
+// Entries that can't be decoded are dropped from the outbox.
+func (o *Outbox) Pending(ctx context.Context) ([]Delivery, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "OutboxPending")
+	defer span.End()
+
+	entries, err := o.store.GetOutbox(sctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	deliveries := make([]Delivery, 0, len(entries))
+	for id, entry := range entries {
+		d := Delivery{}
+		if err := json.Unmarshal([]byte(entry), &d); err != nil {
+			slog.Warn("dropping unreadable outbox entry", "id", id, "error", err)
+			if err := o.store.RemoveOutbox(sctx, id); err != nil {
+				slog.Error("failed to remove outbox entry", "id", id, "error", err)
+			}
+			continue
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		if deliveries[i].Enqueued.Equal(deliveries[j].Enqueued) {
+			return deliveries[i].ID < deliveries[j].ID
+		}
+		return deliveries[i].Enqueued.Before(deliveries[j].Enqueued)
+	})
+
+	span.SetAttributes(attribute.Int("pending", len(deliveries)))
+	return deliveries, nil
+}
@@ -0,0 +1,59 @@
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutbox(t *testing.T) {
+	store := newTestStore()
+
+	ctx := context.Background()
+	outbox := NewOutbox(store)
+
+	destinations := []systems.Destination{
+		{ChannelID: "1"},
+		{Platform: PlatformSlack, Webhook: "https://hooks.slack.com/services/secret"},
+	}
+
+	deliveries, err := outbox.Add(ctx, testNotification, destinations)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.NotEqual(t, deliveries[0].ID, deliveries[1].ID)
+	require.NotContains(t, deliveries[1].ID, "secret")
+
+	older := NewDelivery(Notification{KillmailID: 100, Embed: testNotification.Embed}, destinations[0])
+	older.Enqueued = time.Now().Add(-time.Hour)
+	require.NoError(t, outbox.Put(ctx, older))
+
+	// the same killmail and destination is queued once
+	_, err = outbox.Add(ctx, testNotification, destinations[:1])
+	require.NoError(t, err)
+
+	require.NoError(t, store.AddOutbox(ctx, "broken", "{"))
+
+	pending, err := outbox.Pending(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+	require.Equal(t, older.ID, pending[0].ID)
+	require.Equal(t, uint64(100), pending[0].Notification.KillmailID)
+	require.Equal(t, destinations[1], pending[1].Destination)
+	require.Equal(t, destinations[0], pending[2].Destination)
+	require.Equal(t, testNotification.Embed.Title, pending[2].Notification.Embed.Title)
+
+	entries, err := store.GetOutbox(ctx)
+	require.NoError(t, err)
+	require.NotContains(t, entries, "broken")
+
+	for _, d := range pending {
+		require.NoError(t, outbox.Done(ctx, d))
+	}
+
+	pending, err = outbox.Pending(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
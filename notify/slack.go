@@ -0,0 +1,177 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"github.com/bwmarrin/discordgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Slack allows at most this many fields in a section block
+const slackMaxFields = 10
+
+// Slack posts to Slack incoming webhooks, the destination's webhook is the
+// URL Slack generated for the channel.
+type Slack struct {
+	client *http.Client
+}
+
+func NewSlack() *Slack {
+	return &Slack{
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type slackMessage struct {
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments,omitempty"`
+}
+
+type slackAttachment struct {
+	Color  string       `json:"color,omitempty"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type      string      `json:"type"`
+	Text      *slackText  `json:"text,omitempty"`
+	Fields    []slackText `json:"fields,omitempty"`
+	Elements  []slackText `json:"elements,omitempty"`
+	Accessory *slackImage `json:"accessory,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackImage struct {
+	Type     string `json:"type"`
+	ImageURL string `json:"image_url"`
+	AltText  string `json:"alt_text"`
+}
+
+func (s *Slack) Notify(ctx context.Context, destination systems.Destination, n Notification) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "SlackNotify")
+	defer span.End()
+
+	if destination.Webhook == "" {
+		err := errors.New("slack destinations need a webhook")
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	body, err := json.Marshal(slackPayload(destination.Mention, n.Embed))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(sctx, http.MethodPost, destination.Webhook, bytes.NewReader(body))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("User-Agent", userAgent())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		err = withoutURL(err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("failed to close response body", "error", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		err := fmt.Errorf("unexpected status code from slack: %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// slackPayload renders the embed as Block Kit blocks in a colored attachment.
+// The text is the notification fallback and carries the mention.
+func slackPayload(mention string, embed *discordgo.MessageEmbed) slackMessage {
+	title := slackEscape(embed.Title)
+	if embed.URL != "" {
+		title = fmt.Sprintf("<%s|%s>", embed.URL, title)
+	}
+
+	summary := []string{"*" + title + "*"}
+	if embed.Author != nil && embed.Author.Name != "" {
+		summary = append(summary, slackEscape(embed.Author.Name))
+	}
+	if embed.Description != "" {
+		summary = append(summary, "_"+slackEscape(embed.Description)+"_")
+	}
+
+	header := slackBlock{
+		Type: "section",
+		Text: &slackText{Type: "mrkdwn", Text: strings.Join(summary, "\n")},
+	}
+	if embed.Thumbnail != nil && embed.Thumbnail.URL != "" {
+		header.Accessory = &slackImage{Type: "image", ImageURL: embed.Thumbnail.URL, AltText: "ship"}
+	}
+	blocks := []slackBlock{header}
+
+	fields := make([]slackText, 0, len(embed.Fields))
+	for _, field := range embed.Fields {
+		fields = append(fields, slackText{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*%s*\n%s", slackEscape(field.Name), slackEscape(field.Value)),
+		})
+	}
+	for start := 0; start < len(fields); start += slackMaxFields {
+		end := min(start+slackMaxFields, len(fields))
+		blocks = append(blocks, slackBlock{Type: "section", Fields: fields[start:end]})
+	}
+
+	if embed.Footer != nil && embed.Footer.Text != "" {
+		blocks = append(blocks, slackBlock{
+			Type:     "context",
+			Elements: []slackText{{Type: "mrkdwn", Text: slackEscape(embed.Footer.Text)}},
+		})
+	}
+
+	text := slackEscape(embed.Title)
+	if mention != "" {
+		text = mention + " " + text
+	}
+
+	return slackMessage{
+		Text: text,
+		Attachments: []slackAttachment{{
+			Color:  fmt.Sprintf("#%06x", embed.Color),
+			Blocks: blocks,
+		}},
+	}
+}
+
+// slackEscape escapes the characters Slack uses for links and mentions.
+func slackEscape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
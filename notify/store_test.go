@@ -0,0 +1,70 @@
+package notify
+
+import (
+	"context"
+	"sync"
+)
+
+// testStore keeps the outbox and the dead letters in maps, the memory backend
+// refuses to store them.
+type testStore struct {
+	mx          *sync.Mutex
+	outbox      map[string]string
+	deadLetters map[string]string
+}
+
+func newTestStore() *testStore {
+	return &testStore{
+		mx:          &sync.Mutex{},
+		outbox:      make(map[string]string),
+		deadLetters: make(map[string]string),
+	}
+}
+
+func (s *testStore) AddOutbox(_ context.Context, id, entry string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.outbox[id] = entry
+	return nil
+}
+
+func (s *testStore) GetOutbox(_ context.Context) (map[string]string, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return copyEntries(s.outbox), nil
+}
+
+func (s *testStore) RemoveOutbox(_ context.Context, id string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	delete(s.outbox, id)
+	return nil
+}
+
+func (s *testStore) AddDeadLetter(_ context.Context, id, entry string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.deadLetters[id] = entry
+	return nil
+}
+
+func (s *testStore) GetDeadLetters(_ context.Context) (map[string]string, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return copyEntries(s.deadLetters), nil
+}
+
+func (s *testStore) RemoveDeadLetter(_ context.Context, id string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	delete(s.deadLetters, id)
+	return nil
+}
+
+func copyEntries(entries map[string]string) map[string]string {
+	out := make(map[string]string, len(entries))
+	for id, entry := range entries {
+		out[id] = entry
+	}
+	return out
+}
@@ -0,0 +1,105 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/systems"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Telegram sends messages through the Bot API. The destination's channel ID
+// is the chat ID, e.g. -1001234567890 for a channel.
+type Telegram struct {
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+func NewTelegram(baseURL, token string) *Telegram {
+	return &Telegram{
+		client:  &http.Client{Timeout: 10 * time.Second},
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+	}
+}
+
+type telegramMessage struct {
+	ChatID    string `json:"chat_id"`
+	Text      string `json:"text"`
+	ParseMode string `json:"parse_mode"`
+}
+
+type telegramResponse struct {
+	OK          bool   `json:"ok"`
+	ErrorCode   int    `json:"error_code"`
+	Description string `json:"description"`
+}
+
+func (t *Telegram) Notify(ctx context.Context, destination systems.Destination, n Notification) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "TelegramNotify")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("chat", destination.ChannelID))
+
+	body, err := json.Marshal(telegramMessage{
+		ChatID:    destination.ChannelID,
+		Text:      htmlText(destination.Mention, n.Embed, "\n"),
+		ParseMode: "HTML",
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	target := fmt.Sprintf("%s/bot%s/sendMessage", t.baseURL, t.token)
+	req, err := http.NewRequestWithContext(sctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		err = withoutURL(err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("User-Agent", userAgent())
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		// the URL holds the bot token
+		err = withoutURL(err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("failed to close response body", "error", err)
+		}
+	}()
+
+	result := telegramResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		err = fmt.Errorf("unexpected response from telegram: %d: %w", resp.StatusCode, err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	if !result.OK {
+		err := fmt.Errorf("telegram refused the message: %d: %s", result.ErrorCode, result.Description)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package systems
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/backend"
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	// zKillboard does not accept longer timeframes
+	maxBackfillWindow = 7 * 24 * time.Hour
+
+	lastSeenPersistInterval = 30 * time.Second
+)
+
+var watermark = &lastSeen{mx: &sync.Mutex{}}
+
+// lastSeen keeps track of when a live source last received a message. It is
+// persisted to the backend every now and then so that the gap can be
+// backfilled after a restart.
+type lastSeen struct {
+	mx *sync.Mutex
+
+	last      time.Time
+	persisted time.Time
+}
+
+func (l *lastSeen) touch(ctx context.Context) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.last = time.Now()
+	if l.last.Sub(l.persisted) < lastSeenPersistInterval {
+		return
+	}
+	l.persisted = l.last
+
+	b, err := backend.Backend()
+	if err != nil {
+		slog.Warn("failed to get backend", "error", err)
+		return
+	}
+
+	if err := b.SetLastSeen(ctx, l.last); err != nil {
+		slog.Warn("failed to persist last seen timestamp", "error", err)
+	}
+}
+
+func (l *lastSeen) get(ctx context.Context) time.Time {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if !l.last.IsZero() {
+		return l.last
+	}
+
+	b, err := backend.Backend()
+	if err != nil {
+		slog.Warn("failed to get backend", "error", err)
+		return time.Time{}
+	}
+
+	t, err := b.GetLastSeen(ctx)
+	if err != nil {
+		slog.Warn("failed to get last seen timestamp", "error", err)
+		return time.Time{}
+	}
+
+	return t
+}
+
+// LastSeen returns when a live source last received a message.
+func LastSeen() time.Time {
+	return watermark.get(context.Background())
+}
+
+// Backfill fetches the killmails in the systems of the register since the
+// given time and publishes them like the live sources do, so NPC kills and
+// killmails that were seen already are dropped. Killmails from before since
+// are expected to have come through the live source already and are skipped.
+func Backfill(ctx context.Context, since time.Time, outbox chan Killmail) (int, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "Backfill")
+	defer span.End()
+
+	logger := slog.Default().With(
+		"trace_id", span.SpanContext().TraceID().String(),
+		"span_id", span.SpanContext().SpanID().String(),
+	)
+
+	window := time.Since(since)
+	if maxWindow := time.Duration(config.Get().Zkillboard.Backfill.MaxWindow) * time.Minute; window > maxWindow {
+		logger.Warn("gap is too long, backfilling only part of it",
+			"gap", window.String(),
+			"max_window", maxWindow.String(),
+		)
+		window = maxWindow
+	}
+	if window > maxBackfillWindow {
+		window = maxBackfillWindow
+	}
+
+	systems := Registers().Systems()
+
+	span.SetAttributes(
+		attribute.String("since", since.Format(time.RFC3339)),
+		attribute.String("window", window.String()),
+		attribute.Int("system_count", len(systems)),
+	)
+	logger.Info("backfilling killmails", "since", since, "window", window.String(), "system_count", len(systems))
+
+	sent := 0
+	for _, system := range systems {
+		kms, err := FetchSystemKillmailsSince(sctx, fmt.Sprintf("%d", system.SolarSystemID), int(window/time.Second))
+		if err != nil {
+			logger.Error("failed to backfill system", "system", system.SolarSystemID, "error", err)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			continue
+		}
+
+		for _, km := range kms {
+			if km.OriginalTimestamp.Before(since) {
+				continue
+			}
+
+			if publish(sctx, outbox, km) {
+				sent++
+			} else if ctx.Err() != nil {
+				return sent, ctx.Err()
+			}
+		}
+	}
+
+	logger.Info("backfill complete", "count", sent)
+	span.SetAttributes(attribute.Int("count", sent))
+	return sent, nil
+}
@@ -0,0 +1,32 @@
+package systems
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackfill(t *testing.T) {
+	newZkillAPI(t)
+	config.Get().Redict.Cache = true
+
+	outbox := make(chan Killmail, 1)
+	since := time.Now().Add(-time.Hour)
+
+	sent, err := Backfill(context.Background(), since, outbox)
+	require.NoError(t, err)
+	require.Equal(t, 1, sent)
+
+	km := <-outbox
+	require.Equal(t, uint64(1), km.KillmailID)
+	require.Equal(t, 31000001, km.SolarSystemID)
+
+	// the first run marked the killmail as seen
+	sent, err = Backfill(context.Background(), since, outbox)
+	require.NoError(t, err)
+	require.Zero(t, sent)
+	require.Empty(t, outbox)
+}
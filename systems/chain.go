@@ -0,0 +1,213 @@
+package systems
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// MapConnection is a connection between two systems on the map.
+type MapConnection struct {
+	Source int `json:"solar_system_source"`
+	Target int `json:"solar_system_target"`
+}
+
+// chain is the topology of the map: its connections, the home system and the
+// number of jumps from home to every system that can be reached.
+type chain struct {
+	home        int
+	connections []MapConnection
+	jumps       map[int]int
+}
+
+func newChain(home int, connections []MapConnection) chain {
+	return chain{
+		home:        home,
+		connections: connections,
+		jumps:       computeJumps(home, connections),
+	}
+}
+
+// computeJumps walks the connections breadth first from home. Connections
+// are used in both directions.
+func computeJumps(home int, connections []MapConnection) map[int]int {
+	jumps := make(map[int]int)
+	if home == 0 {
+		return jumps
+	}
+
+	neighbours := make(map[int][]int)
+	for _, c := range connections {
+		neighbours[c.Source] = append(neighbours[c.Source], c.Target)
+		neighbours[c.Target] = append(neighbours[c.Target], c.Source)
+	}
+
+	jumps[home] = 0
+	queue := []int{home}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, next := range neighbours[current] {
+			if _, seen := jumps[next]; seen {
+				continue
+			}
+			jumps[next] = jumps[current] + 1
+			queue = append(queue, next)
+		}
+	}
+
+	return jumps
+}
+
+// findHome returns the configured home system, or the system tagged as home
+// on the map. The configured home is a system name or ID.
+func findHome(cfg config.Wanderer, systems []System) int {
+	if cfg.Home != "" {
+		if id, err := strconv.Atoi(cfg.Home); err == nil {
+			return id
+		}
+		for _, sys := range systems {
+			if strings.EqualFold(sys.Name, cfg.Home) {
+				return sys.SolarSystemID
+			}
+		}
+		if sys, ok := GetSystemByName(cfg.Home); ok {
+			return sys.SystemID
+		}
+
+		slog.Warn("configured home system not found", "home", cfg.Home)
+		return 0
+	}
+
+	if cfg.HomeTag == "" {
+		return 0
+	}
+
+	for _, sys := range systems {
+		if strings.EqualFold(sys.Tag, cfg.HomeTag) {
+			return sys.SolarSystemID
+		}
+	}
+
+	return 0
+}
+
+// Home returns the ID of the home system.
+func (s *SystemRegister) Home() (int, bool) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return s.chain.home, s.chain.home != 0
+}
+
+// Jumps returns the number of jumps from home to the system. It's false if
+// there is no home or the system isn't connected to it.
+func (s *SystemRegister) Jumps(systemID int) (int, bool) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	jumps, ok := s.chain.jumps[systemID]
+	return jumps, ok
+}
+
+// Connections returns the connections on the map.
+func (s *SystemRegister) Connections() []MapConnection {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return s.chain.connections
+}
+
+// AddConnection adds a connection to the chain and updates the jumps.
+func (s *SystemRegister) AddConnection(c MapConnection) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	for _, existing := range s.chain.connections {
+		if sameConnection(existing, c) {
+			return
+		}
+	}
+
+	connections := make([]MapConnection, 0, len(s.chain.connections)+1)
+	connections = append(connections, s.chain.connections...)
+	s.chain = newChain(s.chain.home, append(connections, c))
+}
+
+// RemoveConnection removes a connection from the chain and updates the jumps.
+func (s *SystemRegister) RemoveConnection(c MapConnection) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	connections := make([]MapConnection, 0, len(s.chain.connections))
+	for _, existing := range s.chain.connections {
+		if !sameConnection(existing, c) {
+			connections = append(connections, existing)
+		}
+	}
+
+	s.chain = newChain(s.chain.home, connections)
+}
+
+func sameConnection(a, b MapConnection) bool {
+	return (a.Source == b.Source && a.Target == b.Target) ||
+		(a.Source == b.Target && a.Target == b.Source)
+}
+
+// fetchConnections gets the map's connections from Wanderer.
+func fetchConnections(ctx context.Context, cfg config.Wanderer) ([]MapConnection, error) {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "fetchConnections")
+	defer span.End()
+
+	url := fmt.Sprintf("%s/api/map/connections?slug=%s", cfg.Host, cfg.Slug)
+	span.SetAttributes(attribute.String("url", url))
+
+	req, err := http.NewRequestWithContext(sctx, http.MethodGet, url, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", cfg.Token))
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("User-Agent", fmt.Sprintf("%s/%s:%s", config.Get().AdminName, config.Get().AppName, config.Get().Version))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("failed to close response body", "error", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected status code from wanderer: %d", resp.StatusCode)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	list := struct{ Data []MapConnection }{}
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("connections", len(list.Data)))
+	return list.Data, nil
+}
@@ -0,0 +1,108 @@
+package systems
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeJumps(t *testing.T) {
+	connections := []MapConnection{
+		{Source: 1, Target: 2},
+		{Source: 2, Target: 3},
+		{Source: 4, Target: 3},
+		{Source: 1, Target: 4},
+		{Source: 5, Target: 6},
+	}
+
+	jumps := computeJumps(1, connections)
+	require.Equal(t, map[int]int{1: 0, 2: 1, 3: 2, 4: 1}, jumps)
+
+	require.Empty(t, computeJumps(0, connections))
+	require.Equal(t, map[int]int{7: 0}, computeJumps(7, connections))
+}
+
+func TestFindHome(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	systems := []System{
+		{Name: "J152820", SolarSystemID: 31001880},
+		{Name: "Staging", SolarSystemID: 31000355, Tag: "HOME"},
+	}
+
+	tests := []struct {
+		label    string
+		home     string
+		tag      string
+		expected int
+	}{
+		{label: "nothing configured", expected: 0},
+		{label: "by id", home: "31000001", expected: 31000001},
+		{label: "by map name", home: "staging", expected: 31000355},
+		{label: "by static name", home: "Jita", expected: 30000142},
+		{label: "unknown name", home: "Nowhere", expected: 0},
+		{label: "by tag", tag: "home", expected: 31000355},
+		{label: "home wins over tag", home: "J152820", tag: "home", expected: 31001880},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			cfg := config.Wanderer{Home: tt.home, HomeTag: tt.tag}
+			require.Equal(t, tt.expected, findHome(cfg, systems))
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestUpdateChain(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/map/systems", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[
+			{"name":"J152820","solar_system_id":31001880,"tag":"home"},
+			{"name":"J164417","solar_system_id":31000355},
+			{"name":"Jita","solar_system_id":30000142}
+		]}`)
+	})
+	mux.HandleFunc("/api/map/connections", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[
+			{"solar_system_source":31001880,"solar_system_target":31000355},
+			{"solar_system_source":31000355,"solar_system_target":30000142}
+		]}`)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	reg := newTestRegister(config.Wanderer{Host: srv.URL, Slug: "test", HomeTag: "HOME"})
+	_, err := reg.Update(context.Background())
+	require.NoError(t, err)
+
+	home, ok := reg.Home()
+	require.True(t, ok)
+	require.Equal(t, 31001880, home)
+	require.Len(t, reg.Connections(), 2)
+
+	jumps, ok := reg.Jumps(30000142)
+	require.True(t, ok)
+	require.Equal(t, 2, jumps)
+
+	_, ok = reg.Jumps(1)
+	require.False(t, ok)
+
+	reg.RemoveConnection(MapConnection{Source: 30000142, Target: 31000355})
+	_, ok = reg.Jumps(30000142)
+	require.False(t, ok)
+
+	reg.AddConnection(MapConnection{Source: 31001880, Target: 30000142})
+	jumps, ok = reg.Jumps(30000142)
+	require.True(t, ok)
+	require.Equal(t, 1, jumps)
+}
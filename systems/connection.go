@@ -0,0 +1,367 @@
+package systems
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/common"
+	"github.com/gorilla/websocket"
+)
+
+type ConnectionState int
+
+const (
+	StateDisconnected ConnectionState = iota
+	StateConnecting
+	StateSubscribed
+	StateStale
+	StateBackoff
+)
+
+func (s ConnectionState) String() string {
+	switch s {
+	case StateConnecting:
+		return "connecting"
+	case StateSubscribed:
+		return "subscribed"
+	case StateStale:
+		return "stale"
+	case StateBackoff:
+		return "backoff"
+	}
+
+	return "disconnected"
+}
+
+var (
+	ErrConnectionStale = errors.New("no message received within the stale timeout")
+
+	activeConnection atomic.Pointer[Connection]
+)
+
+// WebsocketState returns the state of the zKillboard websocket connection.
+func WebsocketState() ConnectionState {
+	if c := activeConnection.Load(); c != nil {
+		return c.State()
+	}
+
+	return StateDisconnected
+}
+
+// Connection keeps a websocket connection alive. It reconnects with
+// exponential backoff, pings the server and tears the connection down if the
+// server stops answering or no message arrives for too long.
+type Connection struct {
+	mx      *sync.Mutex
+	writeMx *sync.Mutex
+
+	url          string
+	dialer       *websocket.Dialer
+	backoff      *common.Backoff
+	pingInterval time.Duration
+	pongTimeout  time.Duration
+	staleAfter   time.Duration
+	maxFailures  int
+	onConnect    func(c *Connection, reconnect bool) error
+
+	conn        *websocket.Conn
+	state       ConnectionState
+	changed     time.Time
+	lastMessage time.Time
+}
+
+type ConnectionOption func(*Connection)
+
+// WithPing sets how often the server is pinged and how long to wait for a
+// pong or any other message. Values that aren't positive keep the defaults,
+// the pings are what notices a dead connection between killmails.
+func WithPing(interval, timeout time.Duration) ConnectionOption {
+	return func(c *Connection) {
+		if interval > 0 {
+			c.pingInterval = interval
+		}
+		if timeout > 0 {
+			c.pongTimeout = timeout
+		}
+	}
+}
+
+// WithStaleTimeout forces a reconnect if no message arrives for the given
+// duration. Zero turns the watchdog off.
+func WithStaleTimeout(d time.Duration) ConnectionOption {
+	return func(c *Connection) {
+		c.staleAfter = d
+	}
+}
+
+func WithBackoff(min, max time.Duration) ConnectionOption {
+	return func(c *Connection) {
+		c.backoff = common.NewBackoff(min, max)
+	}
+}
+
+// WithMaxFailures makes Run give up after the given number of consecutive
+// failed connection attempts. Zero retries forever.
+func WithMaxFailures(n int) ConnectionOption {
+	return func(c *Connection) {
+		c.maxFailures = n
+	}
+}
+
+// WithOnConnect registers a function that's called after every successful
+// dial, before the connection is considered subscribed.
+func WithOnConnect(fn func(c *Connection, reconnect bool) error) ConnectionOption {
+	return func(c *Connection) {
+		c.onConnect = fn
+	}
+}
+
+func NewConnection(url string, opts ...ConnectionOption) *Connection {
+	c := &Connection{
+		mx:      &sync.Mutex{},
+		writeMx: &sync.Mutex{},
+
+		url:          url,
+		dialer:       websocket.DefaultDialer,
+		backoff:      common.NewBackoff(time.Second, 2*time.Minute),
+		pingInterval: 30 * time.Second,
+		pongTimeout:  60 * time.Second,
+		staleAfter:   10 * time.Minute,
+
+		state:   StateDisconnected,
+		changed: time.Now(),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+func (c *Connection) State() ConnectionState {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.state
+}
+
+// Since returns when the connection entered its current state.
+func (c *Connection) Since() time.Time {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.changed
+}
+
+func (c *Connection) LastMessage() time.Time {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.lastMessage
+}
+
+// Healthy is false once the connection went stale or keeps failing to
+// reconnect.
+func (c *Connection) Healthy() bool {
+	switch c.State() {
+	case StateStale:
+		return false
+	case StateBackoff:
+		return c.backoff.Attempt() < 3
+	}
+
+	return true
+}
+
+func (c *Connection) setState(state ConnectionState) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.state == state {
+		return
+	}
+
+	slog.Debug("websocket connection state changed", "url", c.url, "from", c.state.String(), "to", state.String())
+	c.state = state
+	c.changed = time.Now()
+}
+
+// Send writes a JSON message to the current connection.
+func (c *Connection) Send(v any) error {
+	c.mx.Lock()
+	conn := c.conn
+	c.mx.Unlock()
+
+	if conn == nil {
+		return errors.New("websocket is not connected")
+	}
+
+	c.writeMx.Lock()
+	defer c.writeMx.Unlock()
+
+	return conn.WriteJSON(v)
+}
+
+// Run keeps the connection open and passes every message to handle until the
+// context is cancelled.
+func (c *Connection) Run(ctx context.Context, handle func([]byte)) error {
+	activeConnection.Store(c)
+	defer c.setState(StateDisconnected)
+
+	failures := 0
+	reconnect := false
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		c.setState(StateConnecting)
+		connected, err := c.session(ctx, handle, reconnect)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if connected {
+			failures = 0
+			reconnect = true
+		} else {
+			failures++
+		}
+
+		if c.maxFailures > 0 && failures >= c.maxFailures {
+			slog.Error("too many failed connection attempts, giving up", "url", c.url, "failures", failures, "error", err)
+			return err
+		}
+
+		wait := c.backoff.Next()
+		slog.Warn("websocket connection lost, reconnecting",
+			"url", c.url,
+			"error", err,
+			"attempt", c.backoff.Attempt(),
+			"sleep", wait.String(),
+		)
+
+		if c.State() != StateStale {
+			c.setState(StateBackoff)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// session runs a single connection. The returned bool reports whether the
+// connection got as far as being subscribed.
+func (c *Connection) session(ctx context.Context, handle func([]byte), reconnect bool) (bool, error) {
+	conn, _, err := c.dialer.DialContext(ctx, c.url, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		c.mx.Lock()
+		c.conn = nil
+		c.mx.Unlock()
+
+		if err := conn.Close(); err != nil && !errors.Is(err, websocket.ErrCloseSent) {
+			slog.Debug("failed to close websocket connection", "error", err)
+		}
+	}()
+
+	c.mx.Lock()
+	c.conn = conn
+	c.lastMessage = time.Now()
+	c.mx.Unlock()
+
+	if err := conn.SetReadDeadline(time.Now().Add(c.pongTimeout)); err != nil {
+		return false, err
+	}
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(c.pongTimeout))
+	})
+
+	if c.onConnect != nil {
+		if err := c.onConnect(c, reconnect); err != nil {
+			return false, err
+		}
+	}
+
+	c.setState(StateSubscribed)
+	c.backoff.Reset()
+
+	readErr := make(chan error, 1)
+	go func() {
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				readErr <- err
+				return
+			}
+
+			if err := conn.SetReadDeadline(time.Now().Add(c.pongTimeout)); err != nil {
+				readErr <- err
+				return
+			}
+
+			c.mx.Lock()
+			c.lastMessage = time.Now()
+			c.mx.Unlock()
+
+			if c.State() == StateStale {
+				c.setState(StateSubscribed)
+			}
+
+			handle(msg)
+		}
+	}()
+
+	ping := time.NewTicker(c.pingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("closing websocket connection", "url", c.url)
+			c.writeMx.Lock()
+			if err := conn.WriteControl(
+				websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+				time.Now().Add(time.Second),
+			); err != nil {
+				slog.Warn("failed to send close message", "error", err)
+			}
+			c.writeMx.Unlock()
+			_ = conn.Close()
+			<-readErr
+			return true, nil
+
+		case err := <-readErr:
+			return true, err
+
+		case <-ping.C:
+			if c.staleAfter > 0 && time.Since(c.LastMessage()) > c.staleAfter {
+				slog.Warn("websocket connection went stale", "url", c.url, "last_message", c.LastMessage())
+				c.setState(StateStale)
+				_ = conn.Close()
+				<-readErr
+				return true, ErrConnectionStale
+			}
+
+			c.writeMx.Lock()
+			err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(c.pongTimeout))
+			c.writeMx.Unlock()
+			if err != nil {
+				_ = conn.Close()
+				<-readErr
+				return true, err
+			}
+		}
+	}
+}
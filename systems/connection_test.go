@@ -0,0 +1,138 @@
+package systems
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func newWebsocketServer(t *testing.T, handler func(n int32, conn *websocket.Conn)) string {
+	t.Helper()
+
+	var connections atomic.Int32
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		handler(connections.Add(1), conn)
+	}))
+	t.Cleanup(srv.Close)
+
+	return "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func TestConnectionReconnects(t *testing.T) {
+	url := newWebsocketServer(t, func(n int32, conn *websocket.Conn) {
+		var sub map[string]string
+		if err := conn.ReadJSON(&sub); err != nil {
+			return
+		}
+
+		_ = conn.WriteMessage(websocket.TextMessage, []byte{byte('0' + n)})
+		if n == 1 {
+			// drop the first connection right away
+			return
+		}
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+
+	var reconnects atomic.Int32
+	conn := NewConnection(url,
+		WithBackoff(10*time.Millisecond, 50*time.Millisecond),
+		WithOnConnect(func(c *Connection, reconnect bool) error {
+			if reconnect {
+				reconnects.Add(1)
+			}
+			return c.Send(map[string]string{"action": "sub"})
+		}),
+	)
+
+	messages := make(chan string, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- conn.Run(ctx, func(msg []byte) {
+			messages <- string(msg)
+		})
+	}()
+
+	for _, expected := range []string{"1", "2"} {
+		select {
+		case msg := <-messages:
+			require.Equal(t, expected, msg)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for message")
+		}
+	}
+
+	require.Eventually(t, func() bool { return conn.State() == StateSubscribed }, time.Second, 10*time.Millisecond)
+	require.Equal(t, StateSubscribed, WebsocketState())
+	require.Equal(t, int32(1), reconnects.Load())
+
+	cancel()
+	require.NoError(t, <-done)
+	require.Equal(t, StateDisconnected, conn.State())
+}
+
+func TestConnectionStale(t *testing.T) {
+	url := newWebsocketServer(t, func(n int32, conn *websocket.Conn) {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+
+	conn := NewConnection(url,
+		WithPing(10*time.Millisecond, time.Second),
+		WithStaleTimeout(50*time.Millisecond),
+		WithBackoff(time.Second, time.Second),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- conn.Run(ctx, func([]byte) {})
+	}()
+
+	require.Eventually(t, func() bool { return conn.State() == StateStale }, 5*time.Second, 10*time.Millisecond)
+	require.False(t, conn.Healthy())
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestConnectionPingDefaults(t *testing.T) {
+	conn := NewConnection("ws://localhost", WithPing(0, 0))
+	require.Equal(t, 30*time.Second, conn.pingInterval)
+	require.Equal(t, 60*time.Second, conn.pongTimeout)
+
+	conn = NewConnection("ws://localhost", WithPing(time.Second, 2*time.Second))
+	require.Equal(t, time.Second, conn.pingInterval)
+	require.Equal(t, 2*time.Second, conn.pongTimeout)
+}
+
+func TestConnectionMaxFailures(t *testing.T) {
+	conn := NewConnection("ws://127.0.0.1:1/",
+		WithBackoff(time.Millisecond, time.Millisecond),
+		WithMaxFailures(3),
+	)
+
+	require.Error(t, conn.Run(context.Background(), func([]byte) {}))
+}
@@ -0,0 +1,185 @@
+package systems
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const imageServer = "https://images.evetech.net"
+
+// Embed renders the killmail as a Discord embed. Ship names come from the
+// static inventory data, other names are looked up through the name resolver;
+// anything that can't be resolved is shown by ID.
+func (k *Killmail) Embed(ctx context.Context) *discordgo.MessageEmbed {
+	names := ResolveNames(ctx, k.entityIDs()...)
+	name := func(id uint64, kind string) string {
+		if n, ok := names[id]; ok {
+			return n
+		}
+		return fmt.Sprintf("%s #%d", kind, id)
+	}
+
+	ship := name(k.Victim.ShipTypeID, "Type")
+
+	victim := name(k.Victim.CorporationID, "Corporation")
+	if k.Victim.CharacterID > 0 {
+		victim = name(k.Victim.CharacterID, "Character")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Type:      discordgo.EmbedTypeRich,
+		URL:       k.Zkill.URL,
+		Title:     fmt.Sprintf("%s lost a %s", victim, ship),
+		Color:     k.Color(),
+		Timestamp: k.OriginalTimestamp.Format(time.RFC3339),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "zKillboard",
+		},
+	}
+
+	if author := affiliation(k.Victim, name); author != "" {
+		embed.Author = &discordgo.MessageEmbedAuthor{
+			Name:    author,
+			IconURL: affiliationIcon(k.Victim),
+		}
+	}
+
+	if k.Victim.ShipTypeID > 0 {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{
+			URL: fmt.Sprintf("%s/types/%d/render?size=128", imageServer, k.Victim.ShipTypeID),
+		}
+	}
+
+	location := fmt.Sprintf("System #%d", k.SolarSystemID)
+	if system, ok := LookupSystem(ctx, k.SolarSystemID); ok {
+		location = fmt.Sprintf("%s (%s)", systemLabel(system), name(uint64(system.RegionID), "Region"))
+	}
+
+	value := formatISK(k.Zkill.TotalValue)
+	if k.Zkill.DroppedValue > 0 {
+		value = fmt.Sprintf("%s\nDropped: %s", value, formatISK(k.Zkill.DroppedValue))
+	}
+
+	fields := []*discordgo.MessageEmbedField{
+		{Name: "Location", Value: location, Inline: true},
+		{Name: "Value", Value: value, Inline: true},
+		{Name: "Attackers", Value: fmt.Sprintf("%d", len(k.Attackers)), Inline: true},
+	}
+
+	if jumps, ok := Registers().Jumps(k.SolarSystemID); ok {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Distance",
+			Value:  formatJumps(jumps),
+			Inline: true,
+		})
+	}
+
+	if k.Zkill.Points > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Points",
+			Value:  fmt.Sprintf("%d", k.Zkill.Points),
+			Inline: true,
+		})
+	}
+
+	tags := make([]string, 0, 2)
+	if k.Zkill.Solo {
+		tags = append(tags, "Solo")
+	}
+	if k.Zkill.Awox {
+		tags = append(tags, "Awox")
+	}
+	embed.Description = strings.Join(tags, " • ")
+
+	if fb, ok := k.FinalBlow(); ok {
+		pilot := name(fb.CorporationID, "Corporation")
+		if fb.CharacterID > 0 {
+			pilot = name(fb.CharacterID, "Character")
+		}
+		if fb.ShipTypeID > 0 {
+			pilot = fmt.Sprintf("%s (%s)", pilot, name(fb.ShipTypeID, "Type"))
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Final blow",
+			Value: fmt.Sprintf("%s\n%s", pilot, affiliation(fb, name)),
+		})
+	}
+
+	embed.Fields = fields
+
+	slog.Debug("prepared embed", "id", k.KillmailID, "title", embed.Title)
+	return embed
+}
+
+// systemLabel adds the class or the security status to the system name, e.g.
+// J123456 C5 or Amamake 0.4. Pochven systems are named as they are.
+func systemLabel(system CachedSystem) string {
+	switch system.Band() {
+	case BandWormhole:
+		if system.SystemName == system.WormholeClass.String() {
+			return system.SystemName
+		}
+		return fmt.Sprintf("%s %s", system.SystemName, system.WormholeClass)
+	case BandHighsec, BandLowsec, BandNullsec:
+		return fmt.Sprintf("%s %.1f", system.SystemName, system.RoundedSecurity())
+	}
+
+	return system.SystemName
+}
+
+func affiliation(c CharacterInfo, name func(uint64, string) string) string {
+	parts := make([]string, 0, 2)
+	if c.CorporationID > 0 {
+		parts = append(parts, name(c.CorporationID, "Corporation"))
+	}
+	if c.AllianceID > 0 {
+		parts = append(parts, name(c.AllianceID, "Alliance"))
+	}
+
+	return strings.Join(parts, " / ")
+}
+
+func affiliationIcon(c CharacterInfo) string {
+	if c.AllianceID > 0 {
+		return fmt.Sprintf("%s/alliances/%d/logo?size=64", imageServer, c.AllianceID)
+	}
+	if c.CorporationID > 0 {
+		return fmt.Sprintf("%s/corporations/%d/logo?size=64", imageServer, c.CorporationID)
+	}
+
+	return ""
+}
+
+// formatJumps describes how far a system is from home.
+func formatJumps(jumps int) string {
+	switch jumps {
+	case 0:
+		return "Home"
+	case 1:
+		return "1 jump from home"
+	}
+
+	return fmt.Sprintf("%d jumps from home", jumps)
+}
+
+// formatISK shortens an ISK amount, e.g. 1234567890 to 1.23B ISK.
+func formatISK(value float64) string {
+	switch {
+	case value >= 1e12:
+		return fmt.Sprintf("%.2fT ISK", value/1e12)
+	case value >= 1e9:
+		return fmt.Sprintf("%.2fB ISK", value/1e9)
+	case value >= 1e6:
+		return fmt.Sprintf("%.2fM ISK", value/1e6)
+	case value >= 1e3:
+		return fmt.Sprintf("%.2fK ISK", value/1e3)
+	}
+
+	return fmt.Sprintf("%.0f ISK", value)
+}
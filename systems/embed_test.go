@@ -0,0 +1,117 @@
+package systems
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+type staticResolver map[uint64]string
+
+func (s staticResolver) Names(_ context.Context, ids []uint64) map[uint64]string {
+	names := make(map[uint64]string)
+	for _, id := range ids {
+		if name, ok := s[id]; ok {
+			names[id] = name
+		}
+	}
+
+	return names
+}
+
+func TestEmbed(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	SetNameResolver(staticResolver{
+		100:      "Victim Pilot",
+		200:      "Victim Corp",
+		101:      "Killer Pilot",
+		201:      "Killer Corp",
+		1:        "Friendly Alliance",
+		10000002: "The Forge",
+	})
+	defer SetNameResolver(noopResolver{})
+	SetUniverseResolver(fakeUniverse{})
+	defer SetUniverseResolver(nil)
+
+	km := Killmail{
+		KillmailID:        123,
+		SolarSystemID:     30000142,
+		OriginalTimestamp: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Victim: CharacterInfo{
+			CharacterID:   100,
+			CorporationID: 200,
+			ShipTypeID:    670,
+		},
+		Attackers: []CharacterInfo{
+			{CharacterID: 102, CorporationID: 202},
+			{CharacterID: 101, CorporationID: 201, AllianceID: 1, ShipTypeID: 11567, FinalBlow: true},
+		},
+		Zkill: Zkb{
+			URL:          "https://zkillboard.com/kill/123/",
+			TotalValue:   12_345_678,
+			DroppedValue: 2_500_000,
+			Points:       1,
+			Awox:         true,
+		},
+	}
+
+	embed := km.Embed(context.Background())
+
+	require.Equal(t, "Victim Pilot lost a Capsule", embed.Title)
+	require.Equal(t, "https://zkillboard.com/kill/123/", embed.URL)
+	require.Equal(t, ColorOurKill, embed.Color)
+	require.Equal(t, "2025-01-02T03:04:05Z", embed.Timestamp)
+	require.Equal(t, "Victim Corp", embed.Author.Name)
+	require.Equal(t, "https://images.evetech.net/corporations/200/logo?size=64", embed.Author.IconURL)
+	require.Equal(t, "https://images.evetech.net/types/670/render?size=128", embed.Thumbnail.URL)
+
+	fields := make(map[string]string)
+	for _, f := range embed.Fields {
+		fields[f.Name] = f.Value
+	}
+	require.Equal(t, "Jita 0.9 (The Forge)", fields["Location"])
+	require.Equal(t, "12.35M ISK\nDropped: 2.50M ISK", fields["Value"])
+	require.Equal(t, "2", fields["Attackers"])
+	require.Equal(t, "1", fields["Points"])
+	require.Equal(t, "Awox", embed.Description)
+	require.Equal(t, "Killer Pilot (Avatar)\nKiller Corp / Friendly Alliance", fields["Final blow"])
+}
+
+func TestEmbedUnresolved(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	km := Killmail{
+		KillmailID:    1,
+		SolarSystemID: 1,
+		Victim: CharacterInfo{
+			CorporationID: 200,
+			ShipTypeID:    999999,
+		},
+	}
+
+	embed := km.Embed(context.Background())
+
+	require.Equal(t, "Corporation #200 lost a Type #999999", embed.Title)
+	require.Equal(t, "Corporation #200", embed.Author.Name)
+	require.Len(t, embed.Fields, 3)
+	require.Equal(t, "System #1", embed.Fields[0].Value)
+	require.Equal(t, "0 ISK", embed.Fields[1].Value)
+}
+
+func TestFormatISK(t *testing.T) {
+	require.Equal(t, "999 ISK", formatISK(999))
+	require.Equal(t, "1.50K ISK", formatISK(1500))
+	require.Equal(t, "2.00M ISK", formatISK(2_000_000))
+	require.Equal(t, "3.25B ISK", formatISK(3_250_000_000))
+	require.Equal(t, "1.00T ISK", formatISK(1e12))
+}
+
+func TestFormatJumps(t *testing.T) {
+	require.Equal(t, "Home", formatJumps(0))
+	require.Equal(t, "1 jump from home", formatJumps(1))
+	require.Equal(t, "3 jumps from home", formatJumps(3))
+}
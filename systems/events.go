@@ -0,0 +1,226 @@
+package systems
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/common"
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+)
+
+const (
+	EventAddSystem         = "add_system"
+	EventDeletedSystem     = "deleted_system"
+	EventConnectionAdded   = "connection_added"
+	EventConnectionRemoved = "connection_removed"
+
+	// Reconnect if the stream is silent for this long, Wanderer sends
+	// keepalives well within it
+	eventStreamIdle = 3 * time.Minute
+)
+
+// MapEvent is a single event from Wanderer's map event stream.
+type MapEvent struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	MapID     string          `json:"map_id"`
+	Timestamp time.Time       `json:"timestamp"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// permanentError stops the event listener instead of reconnecting, e.g. when
+// the host has no event stream.
+type permanentError struct {
+	error
+}
+
+// ListenMapEvents follows the map's event stream and updates the register as
+// systems and connections are added and removed. It reconnects with a backoff
+// and reconciles the register with a full update after every reconnect, since
+// events may have been missed in between. It returns when the context is done
+// or when the stream can't be used at all.
+func (s *SystemRegister) ListenMapEvents(ctx context.Context) error {
+	backoff := common.NewBackoff(time.Second, 2*time.Minute)
+	lastEventID := ""
+	connected := false
+
+	for {
+		err := s.streamMapEvents(ctx, &lastEventID, func() {
+			backoff.Reset()
+			if connected {
+				if _, err := s.Update(ctx); err != nil {
+					slog.Warn("failed to reconcile systems after reconnect", "map", s.m.Name, "error", err)
+				}
+			}
+			connected = true
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		var perm permanentError
+		if errors.As(err, &perm) {
+			slog.Error("map event stream is not available, falling back to polling", "map", s.m.Name, "error", perm.error)
+			return perm.error
+		}
+
+		wait := backoff.Next()
+		slog.Warn("map event stream disconnected", "map", s.m.Name, "error", err, "retry_in", wait.String())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+func mapEventsURL(cfg config.Wanderer, lastEventID string) string {
+	q := url.Values{}
+	q.Set("events", strings.Join([]string{EventAddSystem, EventDeletedSystem, EventConnectionAdded, EventConnectionRemoved}, ","))
+	if lastEventID != "" {
+		q.Set("last_event_id", lastEventID)
+	}
+
+	return fmt.Sprintf("%s/api/maps/%s/events/stream?%s", cfg.Host, url.PathEscape(cfg.Slug), q.Encode())
+}
+
+// streamMapEvents reads the stream until it ends or goes quiet. onConnect is
+// called once the server accepted the request.
+func (s *SystemRegister) streamMapEvents(ctx context.Context, lastEventID *string, onConnect func()) error {
+	sctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(sctx, http.MethodGet, mapEventsURL(s.m.Wanderer, *lastEventID), nil)
+	if err != nil {
+		return permanentError{err}
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", s.m.Token))
+	req.Header.Add("Accept", "text/event-stream")
+	req.Header.Add("User-Agent", fmt.Sprintf("%s/%s:%s", config.Get().AdminName, config.Get().AppName, config.Get().Version))
+	if *lastEventID != "" {
+		req.Header.Add("Last-Event-ID", *lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("failed to close response body", "error", err)
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		return permanentError{fmt.Errorf("unexpected status code from map event stream: %d", resp.StatusCode)}
+	default:
+		return fmt.Errorf("unexpected status code from map event stream: %d", resp.StatusCode)
+	}
+
+	slog.Info("connected to map event stream", "map", s.m.Name, "slug", s.m.Slug)
+	onConnect()
+
+	idle := time.AfterFunc(eventStreamIdle, cancel)
+	defer idle.Stop()
+
+	reader := bufio.NewReader(resp.Body)
+	var id, name string
+	data := make([]string, 0)
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return errors.New("map event stream closed")
+			}
+			return err
+		}
+		idle.Reset(eventStreamIdle)
+
+		line = strings.TrimRight(line, "\r\n")
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+
+		switch {
+		case line == "":
+			if len(data) > 0 {
+				if eventID := s.handleMapEvent(ctx, name, strings.Join(data, "\n")); eventID != "" {
+					*lastEventID = eventID
+				} else if id != "" {
+					*lastEventID = id
+				}
+			}
+			id, name = "", ""
+			data = data[:0]
+		case field == "":
+			// comment, used for keepalives
+		case field == "id":
+			id = value
+		case field == "event":
+			name = value
+		case field == "data":
+			data = append(data, value)
+		}
+	}
+}
+
+// handleMapEvent applies a single event to the register and returns its ID.
+func (s *SystemRegister) handleMapEvent(ctx context.Context, name, data string) string {
+	var event MapEvent
+	if err := json.Unmarshal([]byte(data), &event); err != nil {
+		slog.Warn("failed to decode map event", "event", name, "error", err)
+		return ""
+	}
+	if event.Type == "" {
+		event.Type = name
+	}
+
+	switch event.Type {
+	case EventAddSystem:
+		var sys System
+		if err := json.Unmarshal(event.Payload, &sys); err != nil {
+			slog.Warn("failed to decode added system", "id", event.ID, "error", err)
+			break
+		}
+		if s.AddSystem(ctx, sys) {
+			slog.Info("system added to the chain", "map", s.m.Name, "system_name", sys.Name, "system_id", sys.SolarSystemID)
+		}
+	case EventDeletedSystem:
+		var sys System
+		if err := json.Unmarshal(event.Payload, &sys); err != nil {
+			slog.Warn("failed to decode deleted system", "id", event.ID, "error", err)
+			break
+		}
+		if s.RemoveSystem(sys.SolarSystemID) {
+			slog.Info("system removed from the chain", "map", s.m.Name, "system_name", sys.Name, "system_id", sys.SolarSystemID)
+		}
+	case EventConnectionAdded, EventConnectionRemoved:
+		var c MapConnection
+		if err := json.Unmarshal(event.Payload, &c); err != nil {
+			slog.Warn("failed to decode connection", "id", event.ID, "error", err)
+			break
+		}
+		if event.Type == EventConnectionAdded {
+			s.AddConnection(c)
+		} else {
+			s.RemoveConnection(c)
+		}
+		slog.Debug("chain connections changed", "map", s.m.Name, "type", event.Type, "source", c.Source, "target", c.Target)
+	default:
+		slog.Debug("ignoring map event", "type", event.Type, "id", event.ID)
+	}
+
+	return event.ID
+}
@@ -0,0 +1,125 @@
+package systems
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/backend"
+	"git.sr.ht/~barveyhirdman/chainkills/backend/memory"
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRegister(cfg config.Wanderer, opts ...Option) *SystemRegister {
+	return NewRegister(config.Map{Name: cfg.Slug, Wanderer: cfg}, opts...)
+}
+
+// keepWormholes is a system filter that only applies only_wh_kills, without
+// the lists kept in the backend.
+func keepWormholes(mapFilters config.SystemFilters) systemFilter {
+	return systemFilter{whClasses: wormholeClasses(), mapFilters: mapFilters}
+}
+
+func systemIDs(s *SystemRegister) []int {
+	ids := make([]int, 0)
+	for _, sys := range s.Systems() {
+		ids = append(ids, sys.SolarSystemID)
+	}
+
+	return ids
+}
+
+func TestListenMapEvents(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	var (
+		connects    atomic.Int32
+		lastEventID atomic.Value
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/maps/test/events/stream", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		if connects.Add(1) > 1 {
+			lastEventID.Store(r.Header.Get("Last-Event-ID"))
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, ": keepalive\n\n")
+		fmt.Fprint(w, "id: 1\nevent: add_system\ndata: {\"id\":\"1\",\"type\":\"add_system\",\"payload\":{\"solar_system_id\":31001880,\"name\":\"J152820\"}}\n\n")
+		fmt.Fprint(w, "id: 2\nevent: add_system\ndata: {\"id\":\"2\",\"type\":\"add_system\",\"payload\":{\"solar_system_id\":31000355,\"name\":\"J164417\"}}\n\n")
+		fmt.Fprint(w, "id: 3\nevent: deleted_system\ndata: {\"id\":\"3\",\"type\":\"deleted_system\",\"payload\":{\"solar_system_id\":31001880}}\n\n")
+	})
+	mux.HandleFunc("/api/map/systems", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"name":"J164417","solar_system_id":31000355},{"name":"J100001","solar_system_id":31000006}]}`)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	cache, err := memory.New()
+	require.NoError(t, err)
+	backend.SetBackend(cache)
+	defer backend.SetBackend(nil)
+
+	reg := newTestRegister(config.Wanderer{Host: srv.URL, Slug: "test", Token: "token"}, withSystemFilter(keepWormholes))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	// the server only closes once the stream is done
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- reg.ListenMapEvents(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return connects.Load() > 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal(t, "3", lastEventID.Load())
+	require.Eventually(t, func() bool {
+		return len(reg.Systems()) == 2
+	}, time.Second, 10*time.Millisecond)
+	require.ElementsMatch(t, []int{31000355, 31000006}, systemIDs(reg))
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestListenMapEventsUnavailable(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	reg := newTestRegister(config.Wanderer{Host: srv.URL, Slug: "test"})
+	require.Error(t, reg.ListenMapEvents(context.Background()))
+}
+
+func TestHandleMapEvent(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	reg := newTestRegister(config.Wanderer{})
+
+	require.Equal(t, "a", reg.handleMapEvent(context.Background(), "", `{"id":"a","type":"add_system","payload":{"solar_system_id":31001880,"name":"J152820"}}`))
+	require.Equal(t, []int{31001880}, systemIDs(reg))
+
+	// adding the same system twice doesn't duplicate it
+	reg.handleMapEvent(context.Background(), "add_system", `{"id":"b","payload":{"solar_system_id":31001880,"name":"J152820"}}`)
+	require.Equal(t, []int{31001880}, systemIDs(reg))
+
+	require.Equal(t, "", reg.handleMapEvent(context.Background(), "add_system", `not json`))
+
+	reg.handleMapEvent(context.Background(), "", `{"id":"c","type":"deleted_system","payload":{"solar_system_id":31001880}}`)
+	require.Empty(t, systemIDs(reg))
+}
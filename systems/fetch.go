@@ -8,10 +8,10 @@ import (
 	"log/slog"
 	"maps"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
-	"git.sr.ht/~barveyhirdman/chainkills/backend"
 	"git.sr.ht/~barveyhirdman/chainkills/common"
 	"git.sr.ht/~barveyhirdman/chainkills/config"
 	"go.opentelemetry.io/otel"
@@ -68,11 +68,23 @@ func FetchKillmails(ctx context.Context, systems []System) (map[string]Killmail,
 }
 
 func FetchSystemKillmails(ctx context.Context, systemID string) (map[string]Killmail, error) {
+	return FetchSystemKillmailsSince(ctx, systemID, config.Get().FetchTimeFrame*3600)
+}
+
+// FetchSystemKillmailsSince fetches the killmails of a system from the past
+// timeframe seconds. zKillboard only accepts whole hours, so the timeframe is
+// rounded up accordingly.
+func FetchSystemKillmailsSince(ctx context.Context, systemID string, timeframe int) (map[string]Killmail, error) {
 	sctx, span := otel.Tracer(packageName).Start(ctx, "FetchSystemKillmails")
 	defer span.End()
 
+	if rem := timeframe % 3600; rem != 0 || timeframe == 0 {
+		timeframe += 3600 - rem
+	}
+
 	span.SetAttributes(
 		attribute.String("system", systemID),
+		attribute.Int("timeframe", timeframe),
 	)
 
 	logger := slog.Default().With(
@@ -83,7 +95,6 @@ func FetchSystemKillmails(ctx context.Context, systemID string) (map[string]Kill
 	var killmails []Killmail
 
 	page := 1
-	timeframe := config.Get().FetchTimeFrame * 3600
 
 	for {
 		kms, err := fetchSystemKillmailsPage(logger, span, systemID, timeframe, page)
@@ -101,14 +112,8 @@ func FetchSystemKillmails(ctx context.Context, systemID string) (map[string]Kill
 		page++
 	}
 
-	cache, err := backend.Backend()
-	if err != nil {
-		logger.Error("failed to get cache instance", "error", err)
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		return nil, err
-	}
-
+	// killmails are checked against and added to the cache when they are
+	// published, marking them here would make publish drop them
 	kms := make(map[string]Killmail)
 
 	for i := range killmails {
@@ -119,19 +124,6 @@ func FetchSystemKillmails(ctx context.Context, systemID string) (map[string]Kill
 		km := killmails[i]
 		id := fmt.Sprintf("%d", km.KillmailID)
 
-		if config.Get().Redict.Cache {
-			exists, err := cache.KillmailExists(sctx, id)
-			if err != nil {
-				span.RecordError(err)
-				span.SetStatus(codes.Error, err.Error())
-				logger.Error("failed to check id in cache", "error", err)
-				continue
-			} else if exists {
-				logger.Info("key already exists in cache", "id", id)
-				continue
-			}
-		}
-
 		km.Zkill.URL = fmt.Sprintf("https://zkillboard.com/kill/%d/", km.KillmailID)
 
 		esiKM, err := GetEsiKillmail(sctx, km.KillmailID, km.Zkill.Hash)
@@ -149,6 +141,8 @@ func FetchSystemKillmails(ctx context.Context, systemID string) (map[string]Kill
 			km.Attackers = append(km.Attackers, attacker)
 		}
 
+		// the zKillboard API only has the zkb block
+		km.SolarSystemID = esiKM.SolarSystemID
 		km.Victim = esiKM.Victim
 		km.OriginalTimestamp = esiKM.OriginalTimestamp
 
@@ -162,13 +156,6 @@ func FetchSystemKillmails(ctx context.Context, systemID string) (map[string]Kill
 		)
 
 		kms[id] = km
-
-		if config.Get().Redict.Cache {
-			if err := cache.AddKillmail(sctx, id); err != nil {
-				span.RecordError(err)
-				logger.Error("failed to add item to cache", "id", id, "error", err)
-			}
-		}
 	}
 
 	span.AddEvent("finished fetching killmails in system", trace.WithAttributes(
@@ -189,7 +176,7 @@ func GetEsiKillmail(ctx context.Context, id uint64, hash string) (Killmail, erro
 		"span_id", span.SpanContext().SpanID().String(),
 	)
 
-	url := fmt.Sprintf("https://esi.evetech.net/latest/killmails/%d/%s/?datasource=tranquility", id, hash)
+	url := fmt.Sprintf("%s/killmails/%d/%s/?datasource=tranquility", config.Get().ESI.BaseURL, id, hash)
 	logger.Debug("fetching killmail", "id", id, "hash", hash, "url", url)
 	span.AddEvent("fetching killmail", trace.WithAttributes(
 		attribute.Int64("killmail_id", int64(id)),
@@ -229,11 +216,11 @@ func GetEsiKillmail(ctx context.Context, id uint64, hash string) (Killmail, erro
 
 func fetchSystemKillmailsPage(logger *slog.Logger, span trace.Span, systemID string, timeframe, page int) ([]Killmail, error) {
 	var killmails []Killmail
-	url := fmt.Sprintf("https://zkillboard.com/api/systemID/%s/pastSeconds/%d/page/%d/", systemID, timeframe, page)
+	url := fmt.Sprintf("%s/systemID/%s/pastSeconds/%d/page/%d/", strings.TrimRight(config.Get().Zkillboard.APIURL, "/"), systemID, timeframe, page)
 	logger.Info("fetching killmails", "system", systemID, "url", url)
 	span.AddEvent("fetching killmails for system", trace.WithAttributes(
 		attribute.String("system", systemID),
-		attribute.Int("timeframe", config.Get().FetchTimeFrame),
+		attribute.Int("timeframe", timeframe),
 		attribute.Int("page", page),
 		attribute.String("url", url),
 	))
@@ -0,0 +1,102 @@
+package systems
+
+import (
+	"context"
+	"log/slog"
+
+	"git.sr.ht/~barveyhirdman/chainkills/common"
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+)
+
+// filter reports whether a killmail should be posted. It's shared by every
+// source, so each check logs why a killmail was dropped.
+func filter(ctx context.Context, km Killmail) bool {
+	if len(Registers().Containing(km.SolarSystemID)) == 0 {
+		slog.Debug("filtered out killmail",
+			"reason", "system is not in the chain",
+			"id", km.KillmailID,
+			"system", km.SolarSystemID,
+		)
+		return false
+	}
+
+	for _, label := range km.Zkill.Labels {
+		if common.Contains(config.Get().IgnoreLabels, label) {
+			slog.Debug("filtered out killmail",
+				"reason", "label is on ignore list",
+				"id", km.KillmailID,
+				"label", label,
+			)
+			return false
+		}
+	}
+
+	if !ShipAllowed(ctx, km.Victim.ShipTypeID, shipFilters()) {
+		slog.Debug("filtered out killmail",
+			"reason", "ship is filtered",
+			"id", km.KillmailID,
+			"ship", km.Victim.ShipTypeID,
+		)
+		return false
+	}
+
+	// Kills beyond the limit still pass when they have somewhere else to go,
+	// the low priority channel is picked when routing them
+	if !Registers().JumpsAllowed(km, config.Get().JumpLimit) && !config.Get().Discord.LowPriorityChannel.IsSet() {
+		slog.Debug("filtered out killmail",
+			"reason", "system is too far from home",
+			"id", km.KillmailID,
+			"system", km.SolarSystemID,
+		)
+		return false
+	}
+
+	if !ValueAllowed(km, config.Get().ValueThreshold) {
+		slog.Debug("filtered out killmail",
+			"reason", "value is outside of the threshold",
+			"id", km.KillmailID,
+			"value", km.Zkill.TotalValue,
+		)
+		return false
+	}
+
+	return true
+}
+
+// ValueAllowed reports whether the killmail's total value is within the
+// threshold. Friendly losses pass regardless if the threshold says so.
+func ValueAllowed(km Killmail, threshold config.ValueThreshold) bool {
+	if threshold.AlwaysPostFriendlyLosses && km.Victim.IsFriend() {
+		return true
+	}
+
+	if threshold.MinValue > 0 && km.Zkill.TotalValue < threshold.MinValue {
+		return false
+	}
+
+	if threshold.MaxValue > 0 && km.Zkill.TotalValue > threshold.MaxValue {
+		return false
+	}
+
+	return true
+}
+
+// JumpsAllowed reports whether the killmail happened within the limit's
+// number of jumps from home. Without a home the distance can't be known and
+// every killmail passes; systems not connected to home are beyond any limit.
+func (s *SystemRegister) JumpsAllowed(km Killmail, limit config.JumpLimit) bool {
+	if limit.MaxJumps <= 0 {
+		return true
+	}
+
+	if limit.FriendsIgnoreMaxJumps && km.InvolvesFriends() {
+		return true
+	}
+
+	if _, ok := s.Home(); !ok {
+		return true
+	}
+
+	jumps, ok := s.Jumps(km.SolarSystemID)
+	return ok && jumps <= limit.MaxJumps
+}
@@ -0,0 +1,203 @@
+package systems
+
+import (
+	"context"
+	"testing"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueAllowed(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	cheap := Killmail{Zkill: Zkb{TotalValue: 10_000}}
+	pricey := Killmail{Zkill: Zkb{TotalValue: 5_000_000_000}}
+	friendlyPod := Killmail{
+		Victim: CharacterInfo{CharacterID: 3},
+		Zkill:  Zkb{TotalValue: 10_000},
+	}
+
+	tests := []struct {
+		label     string
+		killmail  Killmail
+		threshold config.ValueThreshold
+		expected  bool
+	}{
+		{
+			label:     "no threshold",
+			killmail:  cheap,
+			threshold: config.ValueThreshold{},
+			expected:  true,
+		},
+		{
+			label:     "below minimum",
+			killmail:  cheap,
+			threshold: config.ValueThreshold{MinValue: 1_000_000},
+			expected:  false,
+		},
+		{
+			label:     "above minimum",
+			killmail:  pricey,
+			threshold: config.ValueThreshold{MinValue: 1_000_000},
+			expected:  true,
+		},
+		{
+			label:     "above maximum",
+			killmail:  pricey,
+			threshold: config.ValueThreshold{MaxValue: 1_000_000_000},
+			expected:  false,
+		},
+		{
+			label:     "friendly loss below minimum",
+			killmail:  friendlyPod,
+			threshold: config.ValueThreshold{MinValue: 1_000_000},
+			expected:  false,
+		},
+		{
+			label:     "friendly loss with override",
+			killmail:  friendlyPod,
+			threshold: config.ValueThreshold{MinValue: 1_000_000, AlwaysPostFriendlyLosses: true},
+			expected:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			require.Equal(t, tt.expected, ValueAllowed(tt.killmail, tt.threshold))
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestJumpsAllowed(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	reg := newTestRegister(config.Wanderer{})
+	reg.chain = newChain(31001880, []MapConnection{
+		{Source: 31001880, Target: 31000355},
+		{Source: 31000355, Target: 30000142},
+	})
+
+	home := Killmail{SolarSystemID: 31001880}
+	far := Killmail{SolarSystemID: 30000142}
+	disconnected := Killmail{SolarSystemID: 31000006}
+	friendlyKill := Killmail{
+		SolarSystemID: 30000142,
+		Attackers:     []CharacterInfo{{CorporationID: 2}},
+	}
+
+	tests := []struct {
+		label    string
+		register *SystemRegister
+		killmail Killmail
+		limit    config.JumpLimit
+		expected bool
+	}{
+		{
+			label:    "no limit",
+			register: reg,
+			killmail: far,
+			limit:    config.JumpLimit{},
+			expected: true,
+		},
+		{
+			label:    "home",
+			register: reg,
+			killmail: home,
+			limit:    config.JumpLimit{MaxJumps: 1},
+			expected: true,
+		},
+		{
+			label:    "within limit",
+			register: reg,
+			killmail: far,
+			limit:    config.JumpLimit{MaxJumps: 2},
+			expected: true,
+		},
+		{
+			label:    "beyond limit",
+			register: reg,
+			killmail: far,
+			limit:    config.JumpLimit{MaxJumps: 1},
+			expected: false,
+		},
+		{
+			label:    "not connected to home",
+			register: reg,
+			killmail: disconnected,
+			limit:    config.JumpLimit{MaxJumps: 5},
+			expected: false,
+		},
+		{
+			label:    "no home",
+			register: newTestRegister(config.Wanderer{}),
+			killmail: far,
+			limit:    config.JumpLimit{MaxJumps: 1},
+			expected: true,
+		},
+		{
+			label:    "friendly kill beyond limit",
+			register: reg,
+			killmail: friendlyKill,
+			limit:    config.JumpLimit{MaxJumps: 1},
+			expected: false,
+		},
+		{
+			label:    "friendly kill with override",
+			register: reg,
+			killmail: friendlyKill,
+			limit:    config.JumpLimit{MaxJumps: 1, FriendsIgnoreMaxJumps: true},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.register.JumpsAllowed(tt.killmail, tt.limit))
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestFilterLowPriority(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	reg := newTestRegister(config.Wanderer{})
+	reg.systems = []System{
+		{Name: "J152820", SolarSystemID: 31001880},
+		{Name: "J164417", SolarSystemID: 31000355},
+		{Name: "J100001", SolarSystemID: 31000006},
+	}
+	reg.chain = newChain(31001880, []MapConnection{
+		{Source: 31001880, Target: 31000355},
+		{Source: 31000355, Target: 31000006},
+	})
+	registers = NewRegisterSet(reg)
+
+	config.Get().JumpLimit = config.JumpLimit{MaxJumps: 1}
+	defer func() { config.Get().JumpLimit = config.JumpLimit{} }()
+
+	tests := []struct {
+		label    string
+		low      config.Channel
+		expected bool
+	}{
+		{label: "no low priority channel", low: config.Channel{}, expected: false},
+		{label: "low priority channel", low: config.Channel{ID: "low"}, expected: true},
+		{label: "low priority webhook", low: config.Channel{Webhook: "https://discord.com/api/webhooks/1/token"}, expected: true},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			config.Get().Discord.LowPriorityChannel = tt.low
+			defer func() { config.Get().Discord.LowPriorityChannel = config.Channel{} }()
+
+			require.Equal(t, tt.expected, filter(context.Background(), Killmail{KillmailID: 1, SolarSystemID: 31000006}))
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
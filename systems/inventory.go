@@ -0,0 +1,98 @@
+package systems
+
+import (
+	"strconv"
+	"strings"
+)
+
+type CachedType struct {
+	TypeID   int
+	TypeName string
+	GroupID  int
+}
+
+type CachedGroup struct {
+	GroupID    int
+	GroupName  string
+	CategoryID int
+}
+
+type CachedCategory struct {
+	CategoryID   int
+	CategoryName string
+}
+
+func GetType(typeID int) (CachedType, bool) {
+	t, ok := cachedTypes[typeID]
+	return t, ok
+}
+
+func GetGroup(groupID int) (CachedGroup, bool) {
+	group, ok := cachedGroups[groupID]
+	return group, ok
+}
+
+func GetCategory(categoryID int) (CachedCategory, bool) {
+	category, ok := cachedCategories[categoryID]
+	return category, ok
+}
+
+// Group returns the group the type belongs to.
+func (t CachedType) Group() (CachedGroup, bool) {
+	return GetGroup(t.GroupID)
+}
+
+// Category returns the category the type belongs to.
+func (t CachedType) Category() (CachedCategory, bool) {
+	group, ok := t.Group()
+	if !ok {
+		return CachedCategory{}, false
+	}
+
+	return GetCategory(group.CategoryID)
+}
+
+// Inventory kinds that can be looked up by name or ID.
+const (
+	KindType     = "type"
+	KindGroup    = "group"
+	KindCategory = "category"
+)
+
+// LookupInventory finds a type, group or category by its ID or its name,
+// ignoring case. IDs that aren't in the static data are still returned, only
+// without a name.
+func LookupInventory(kind, value string) (int, string, bool) {
+	value = strings.TrimSpace(value)
+	id, err := strconv.Atoi(value)
+	byID := err == nil
+
+	switch kind {
+	case KindType:
+		for _, t := range cachedTypes {
+			if (byID && t.TypeID == id) || strings.EqualFold(t.TypeName, value) {
+				return t.TypeID, t.TypeName, true
+			}
+		}
+	case KindGroup:
+		for _, group := range cachedGroups {
+			if (byID && group.GroupID == id) || strings.EqualFold(group.GroupName, value) {
+				return group.GroupID, group.GroupName, true
+			}
+		}
+	case KindCategory:
+		for _, category := range cachedCategories {
+			if (byID && category.CategoryID == id) || strings.EqualFold(category.CategoryName, value) {
+				return category.CategoryID, category.CategoryName, true
+			}
+		}
+	default:
+		return 0, "", false
+	}
+
+	if byID {
+		return id, "", true
+	}
+
+	return 0, "", false
+}
@@ -1,13 +1,10 @@
 package systems
 
 import (
-	"log/slog"
 	"time"
 
 	"git.sr.ht/~barveyhirdman/chainkills/common"
 	"git.sr.ht/~barveyhirdman/chainkills/config"
-	"github.com/bwmarrin/discordgo"
-	"github.com/julianshen/og"
 )
 
 type Killmail struct {
@@ -16,17 +13,39 @@ type Killmail struct {
 	Victim            CharacterInfo   `json:"victim"`
 	OriginalTimestamp time.Time       `json:"killmail_time"`
 	SolarSystemID     int             `json:"solar_system_id"`
-	Zkill             struct {
-		URL  string `json:"url"`
-		Hash string `json:"hash"`
-		NPC  bool   `json:"npc"`
-	} `json:"zkb"`
+	Zkill             Zkb             `json:"zkb"`
+}
+
+type Zkb struct {
+	URL            string   `json:"url"`
+	Hash           string   `json:"hash"`
+	NPC            bool     `json:"npc"`
+	Solo           bool     `json:"solo"`
+	Awox           bool     `json:"awox"`
+	LocationID     uint64   `json:"locationID"`
+	Points         int      `json:"points"`
+	TotalValue     float64  `json:"totalValue"`
+	FittedValue    float64  `json:"fittedValue"`
+	DroppedValue   float64  `json:"droppedValue"`
+	DestroyedValue float64  `json:"destroyedValue"`
+	Labels         []string `json:"labels"`
+}
+
+// HasLabel reports whether zKillboard tagged the killmail with the label,
+// e.g. "pvp", "solo" or "loc:w-space".
+func (z Zkb) HasLabel(label string) bool {
+	return common.Contains(z.Labels, label)
 }
 
 type CharacterInfo struct {
 	CharacterID   uint64 `json:"character_id"`
 	CorporationID uint64 `json:"corporation_id"`
 	AllianceID    uint64 `json:"alliance_id"`
+	ShipTypeID    uint64 `json:"ship_type_id"`
+	WeaponTypeID  uint64 `json:"weapon_type_id"`
+	DamageDone    int    `json:"damage_done"`
+	DamageTaken   int    `json:"damage_taken"`
+	FinalBlow     bool   `json:"final_blow"`
 }
 
 func (c CharacterInfo) IsFriend() bool {
@@ -61,32 +80,29 @@ func (k *Killmail) Color() int {
 	return ColorWhatever
 }
 
-func (k *Killmail) Embed() (*discordgo.MessageEmbed, error) {
-	url := k.Zkill.URL
-	slog.Debug("preparing embed", "url", url)
+// InvolvesFriends reports whether the victim or any of the attackers is a
+// friend.
+func (k *Killmail) InvolvesFriends() bool {
+	if k.Victim.IsFriend() {
+		return true
+	}
 
-	siteData, err := og.GetPageInfoFromUrl(url)
-	if err != nil {
-		return nil, err
+	for _, attacker := range k.Attackers {
+		if attacker.IsFriend() {
+			return true
+		}
 	}
 
-	embed := &discordgo.MessageEmbed{
-		Type:        discordgo.EmbedTypeLink,
-		URL:         url,
-		Description: siteData.Description,
-		Title:       siteData.Title,
-		Color:       k.Color(),
-		Provider: &discordgo.MessageEmbedProvider{
-			URL:  "https://zkillboard.com",
-			Name: siteData.SiteName,
-		},
-		Thumbnail: &discordgo.MessageEmbedThumbnail{
-			URL:    siteData.Images[0].Url,
-			Width:  int(siteData.Images[0].Width),
-			Height: int(siteData.Images[0].Height),
-		},
+	return false
+}
+
+// FinalBlow returns the attacker who landed the final blow.
+func (k *Killmail) FinalBlow() (CharacterInfo, bool) {
+	for _, attacker := range k.Attackers {
+		if attacker.FinalBlow {
+			return attacker, true
+		}
 	}
 
-	slog.Info("prepared embed", "embed", embed)
-	return embed, nil
+	return CharacterInfo{}, false
 }
@@ -1,6 +1,7 @@
 package systems
 
 import (
+	"encoding/json"
 	"testing"
 
 	"git.sr.ht/~barveyhirdman/chainkills/config"
@@ -151,3 +152,38 @@ func TestKillmailColor(t *testing.T) {
 		t.Run(tt.label, tf)
 	}
 }
+
+func TestDecodeZkb(t *testing.T) {
+	msg := `{
+		"killmail_id": 1,
+		"solar_system_id": 31000005,
+		"zkb": {
+			"locationID": 40000001,
+			"hash": "abc",
+			"fittedValue": 10.5,
+			"droppedValue": 2,
+			"destroyedValue": 8.5,
+			"totalValue": 12.5,
+			"points": 3,
+			"npc": false,
+			"solo": true,
+			"awox": false,
+			"labels": ["cat:6", "solo", "pvp", "loc:w-space"],
+			"url": "https://zkillboard.com/kill/1/"
+		}
+	}`
+
+	var km Killmail
+	require.NoError(t, json.Unmarshal([]byte(msg), &km))
+
+	require.Equal(t, uint64(40000001), km.Zkill.LocationID)
+	require.Equal(t, 10.5, km.Zkill.FittedValue)
+	require.Equal(t, 2.0, km.Zkill.DroppedValue)
+	require.Equal(t, 8.5, km.Zkill.DestroyedValue)
+	require.Equal(t, 12.5, km.Zkill.TotalValue)
+	require.Equal(t, 3, km.Zkill.Points)
+	require.True(t, km.Zkill.Solo)
+	require.False(t, km.Zkill.Awox)
+	require.True(t, km.Zkill.HasLabel("loc:w-space"))
+	require.False(t, km.Zkill.HasLabel("padding"))
+}
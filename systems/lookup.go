@@ -0,0 +1,205 @@
+package systems
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// UniverseResolver looks up what the static data lacks: the security status
+// and constellation of systems, constellations by ID or name, and inventory
+// types added to the game since the static data was built.
+type UniverseResolver interface {
+	System(ctx context.Context, systemID int) (CachedSystem, error)
+	Constellation(ctx context.Context, constellationID int) (CachedConstellation, error)
+	// FindConstellation returns false if there is no constellation by the name
+	FindConstellation(ctx context.Context, name string) (CachedConstellation, bool, error)
+	Type(ctx context.Context, typeID int) (CachedType, error)
+	Group(ctx context.Context, groupID int) (CachedGroup, error)
+	Category(ctx context.Context, categoryID int) (CachedCategory, error)
+}
+
+// Lookups are kept for the life of the process, the universe doesn't change
+// while it runs and the number of systems is bounded.
+var (
+	universeMx            = &sync.Mutex{}
+	universe              UniverseResolver
+	locatedSystems        = map[int]CachedSystem{}
+	locatedConstellations = map[int]CachedConstellation{}
+	locatedTypes          = map[int]CachedType{}
+	locatedGroups         = map[int]CachedGroup{}
+	locatedCategories     = map[int]CachedCategory{}
+)
+
+// SetUniverseResolver sets the resolver used to fill in the static data and
+// drops what the previous one looked up.
+func SetUniverseResolver(r UniverseResolver) {
+	universeMx.Lock()
+	defer universeMx.Unlock()
+
+	universe = r
+	locatedSystems = map[int]CachedSystem{}
+	locatedConstellations = map[int]CachedConstellation{}
+	locatedTypes = map[int]CachedType{}
+	locatedGroups = map[int]CachedGroup{}
+	locatedCategories = map[int]CachedCategory{}
+}
+
+func getUniverseResolver() UniverseResolver {
+	universeMx.Lock()
+	defer universeMx.Unlock()
+
+	return universe
+}
+
+// LookupSystem returns the static data of the system, with the security
+// status and constellation looked up if the static data has none. Without
+// a resolver, or if the lookup fails, it's the static data as is.
+func LookupSystem(ctx context.Context, systemID int) (CachedSystem, bool) {
+	system, ok := GetSystem(systemID)
+	if ok && system.ConstellationID != 0 {
+		return system, true
+	}
+
+	r := getUniverseResolver()
+	if r == nil {
+		return system, ok
+	}
+
+	universeMx.Lock()
+	located, found := locatedSystems[systemID]
+	universeMx.Unlock()
+	if found {
+		return located, true
+	}
+
+	info, err := r.System(ctx, systemID)
+	if err != nil {
+		slog.Warn("failed to look up system", "system_id", systemID, "error", err)
+		return system, ok
+	}
+
+	// the static data knows the wormhole class, ESI doesn't
+	if ok {
+		system.ConstellationID = info.ConstellationID
+		system.Security = info.Security
+	} else {
+		system = info
+	}
+
+	universeMx.Lock()
+	locatedSystems[systemID] = system
+	universeMx.Unlock()
+
+	return system, true
+}
+
+// LookupConstellation returns the constellation from the static data or
+// looks it up.
+func LookupConstellation(ctx context.Context, constellationID int) (CachedConstellation, bool) {
+	return lookup(ctx, "constellation", constellationID, GetConstellation, locatedConstellations, func(r UniverseResolver) func(context.Context, int) (CachedConstellation, error) {
+		return r.Constellation
+	})
+}
+
+// LookupType returns the inventory type from the static data or looks it up.
+func LookupType(ctx context.Context, typeID int) (CachedType, bool) {
+	return lookup(ctx, "type", typeID, GetType, locatedTypes, func(r UniverseResolver) func(context.Context, int) (CachedType, error) {
+		return r.Type
+	})
+}
+
+// LookupGroup returns the inventory group from the static data or looks it
+// up.
+func LookupGroup(ctx context.Context, groupID int) (CachedGroup, bool) {
+	return lookup(ctx, "group", groupID, GetGroup, locatedGroups, func(r UniverseResolver) func(context.Context, int) (CachedGroup, error) {
+		return r.Group
+	})
+}
+
+// LookupCategory returns the inventory category from the static data or
+// looks it up.
+func LookupCategory(ctx context.Context, categoryID int) (CachedCategory, bool) {
+	return lookup(ctx, "category", categoryID, GetCategory, locatedCategories, func(r UniverseResolver) func(context.Context, int) (CachedCategory, error) {
+		return r.Category
+	})
+}
+
+// lookup returns the entry from the static data, from earlier lookups or
+// from the resolver, in that order. located is read and written under
+// universeMx.
+func lookup[T any](
+	ctx context.Context,
+	kind string,
+	id int,
+	static func(int) (T, bool),
+	located map[int]T,
+	fetch func(UniverseResolver) func(context.Context, int) (T, error),
+) (T, bool) {
+	var zero T
+	if entry, ok := static(id); ok {
+		return entry, true
+	}
+
+	r := getUniverseResolver()
+	if r == nil || id == 0 {
+		return zero, false
+	}
+
+	universeMx.Lock()
+	entry, found := located[id]
+	universeMx.Unlock()
+	if found {
+		return entry, true
+	}
+
+	entry, err := fetch(r)(ctx, id)
+	if err != nil {
+		slog.Warn("failed to look up "+kind, "id", id, "error", err)
+		return zero, false
+	}
+
+	universeMx.Lock()
+	located[id] = entry
+	universeMx.Unlock()
+
+	return entry, true
+}
+
+// LookupConstellationByName looks up a constellation by its name, ignoring
+// case, in the static data first.
+func LookupConstellationByName(ctx context.Context, name string) (CachedConstellation, bool) {
+	if constellation, ok := GetConstellationByName(name); ok {
+		return constellation, true
+	}
+
+	r := getUniverseResolver()
+	if r == nil {
+		return CachedConstellation{}, false
+	}
+
+	universeMx.Lock()
+	for _, constellation := range locatedConstellations {
+		if strings.EqualFold(constellation.ConstellationName, name) {
+			universeMx.Unlock()
+			return constellation, true
+		}
+	}
+	universeMx.Unlock()
+
+	constellation, ok, err := r.FindConstellation(ctx, name)
+	if err != nil {
+		slog.Warn("failed to look up constellation", "name", name, "error", err)
+		return CachedConstellation{}, false
+	}
+	if !ok {
+		return CachedConstellation{}, false
+	}
+
+	universeMx.Lock()
+	locatedConstellations[constellation.ConstellationID] = constellation
+	universeMx.Unlock()
+
+	return constellation, true
+}
@@ -0,0 +1,130 @@
+package systems
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUniverse knows a highsec, a lowsec and a nullsec system and their
+// constellations, and a sovereignty hub, like ESI would return them.
+type fakeUniverse struct{}
+
+var (
+	fakeSystems = map[int]CachedSystem{
+		30000142: {SystemID: 30000142, SystemName: "Jita", RegionID: 10000002, ConstellationID: 20000020, Security: 0.9459},
+		30002537: {SystemID: 30002537, SystemName: "Amamake", RegionID: 10000030, ConstellationID: 20000372, Security: 0.3612},
+		30001161: {SystemID: 30001161, SystemName: "HED-GP", RegionID: 10000014, ConstellationID: 20000169, Security: -0.2136},
+	}
+	fakeConstellations = map[int]CachedConstellation{
+		20000020: {ConstellationID: 20000020, ConstellationName: "Kimotoro", RegionID: 10000002},
+		20000372: {ConstellationID: 20000372, ConstellationName: "Hed", RegionID: 10000030},
+		20000169: {ConstellationID: 20000169, ConstellationName: "XPJ1-6", RegionID: 10000014},
+	}
+	fakeTypes = map[int]CachedType{
+		32458: {TypeID: 32458, TypeName: "Infrastructure Hub", GroupID: 1012},
+	}
+	fakeGroups = map[int]CachedGroup{
+		1012: {GroupID: 1012, GroupName: "Infrastructure Hubs", CategoryID: 40},
+	}
+)
+
+func (fakeUniverse) System(_ context.Context, id int) (CachedSystem, error) {
+	if system, ok := fakeSystems[id]; ok {
+		return system, nil
+	}
+	return CachedSystem{}, errors.New("not found")
+}
+
+func (fakeUniverse) Constellation(_ context.Context, id int) (CachedConstellation, error) {
+	if constellation, ok := fakeConstellations[id]; ok {
+		return constellation, nil
+	}
+	return CachedConstellation{}, errors.New("not found")
+}
+
+func (fakeUniverse) FindConstellation(_ context.Context, name string) (CachedConstellation, bool, error) {
+	for _, constellation := range fakeConstellations {
+		if strings.EqualFold(constellation.ConstellationName, name) {
+			return constellation, true, nil
+		}
+	}
+	return CachedConstellation{}, false, nil
+}
+
+func (fakeUniverse) Type(_ context.Context, id int) (CachedType, error) {
+	if t, ok := fakeTypes[id]; ok {
+		return t, nil
+	}
+	return CachedType{}, errors.New("not found")
+}
+
+func (fakeUniverse) Group(_ context.Context, id int) (CachedGroup, error) {
+	if group, ok := fakeGroups[id]; ok {
+		return group, nil
+	}
+	return CachedGroup{}, errors.New("not found")
+}
+
+func (fakeUniverse) Category(_ context.Context, _ int) (CachedCategory, error) {
+	return CachedCategory{}, errors.New("not found")
+}
+
+func TestLookupSystem(t *testing.T) {
+	SetUniverseResolver(fakeUniverse{})
+	defer SetUniverseResolver(nil)
+
+	tests := []struct {
+		label string
+		id    int
+		band  SecurityBand
+		class WormholeClass
+		name  string
+	}{
+		{label: "highsec", id: 30000142, band: BandHighsec, class: ClassHighsec, name: "Jita 0.9"},
+		{label: "lowsec", id: 30002537, band: BandLowsec, class: ClassLowsec, name: "Amamake 0.4"},
+		{label: "nullsec", id: 30001161, band: BandNullsec, class: ClassNullsec, name: "HED-GP -0.2"},
+		{label: "wormhole keeps its class", id: 31001880, band: BandWormhole, class: ClassC5, name: "J152820 C5"},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			system, ok := LookupSystem(context.Background(), tt.id)
+			require.True(t, ok)
+			require.Equal(t, tt.band, system.Band())
+			require.Equal(t, tt.class, system.Class())
+			require.Equal(t, tt.name, systemLabel(system))
+		}
+
+		t.Run(tt.label, tf)
+	}
+
+	_, ok := LookupSystem(context.Background(), 1)
+	require.False(t, ok)
+}
+
+func TestLookupSystemWithoutResolver(t *testing.T) {
+	system, ok := LookupSystem(context.Background(), 30000142)
+	require.True(t, ok)
+	require.Equal(t, BandUnknown, system.Band())
+}
+
+func TestLookupConstellation(t *testing.T) {
+	SetUniverseResolver(fakeUniverse{})
+	defer SetUniverseResolver(nil)
+
+	constellation, ok := LookupConstellationByName(context.Background(), "kimotoro")
+	require.True(t, ok)
+	require.Equal(t, 20000020, constellation.ConstellationID)
+	require.Equal(t, 10000002, constellation.RegionID)
+
+	constellation, ok = LookupConstellation(context.Background(), 20000372)
+	require.True(t, ok)
+	require.Equal(t, "Hed", constellation.ConstellationName)
+
+	_, ok = LookupConstellationByName(context.Background(), "Nowhere")
+	require.False(t, ok)
+}
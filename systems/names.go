@@ -0,0 +1,114 @@
+package systems
+
+import (
+	"context"
+	"sync"
+)
+
+// NameResolver turns EVE IDs (characters, corporations, alliances, types,
+// systems, regions) into names. IDs it can't resolve are left out of the
+// result.
+type NameResolver interface {
+	Names(ctx context.Context, ids []uint64) map[uint64]string
+}
+
+type noopResolver struct{}
+
+func (noopResolver) Names(context.Context, []uint64) map[uint64]string {
+	return map[uint64]string{}
+}
+
+var (
+	resolverMx              = &sync.Mutex{}
+	resolver   NameResolver = noopResolver{}
+)
+
+// SetNameResolver sets the resolver that names killmail embeds, webhook
+// payloads and command replies. Logs keep the raw IDs.
+func SetNameResolver(r NameResolver) {
+	resolverMx.Lock()
+	defer resolverMx.Unlock()
+
+	resolver = r
+}
+
+func getNameResolver() NameResolver {
+	resolverMx.Lock()
+	defer resolverMx.Unlock()
+
+	return resolver
+}
+
+// ResolveNames looks up names through the configured resolver. Types,
+// systems, constellations and regions in the static data are named without
+// asking the resolver.
+func ResolveNames(ctx context.Context, ids ...uint64) map[uint64]string {
+	names := make(map[uint64]string, len(ids))
+	remaining := make([]uint64, 0, len(ids))
+
+	for _, id := range ids {
+		if name, ok := staticName(id); ok {
+			names[id] = name
+			continue
+		}
+		remaining = append(remaining, id)
+	}
+
+	if len(remaining) == 0 {
+		return names
+	}
+
+	for id, name := range getNameResolver().Names(ctx, remaining) {
+		names[id] = name
+	}
+
+	return names
+}
+
+// staticName looks the ID up in the static data. EVE IDs are allocated in
+// ranges per kind, so they can't collide.
+func staticName(id uint64) (string, bool) {
+	if t, ok := GetType(int(id)); ok {
+		return t.TypeName, true
+	}
+	if system, ok := GetSystem(int(id)); ok {
+		return system.SystemName, true
+	}
+	if constellation, ok := GetConstellation(int(id)); ok {
+		return constellation.ConstellationName, true
+	}
+	if region, ok := GetRegion(int(id)); ok {
+		return region.RegionName, true
+	}
+
+	return "", false
+}
+
+// entityIDs returns every ID on the killmail that has a name.
+func (k *Killmail) entityIDs() []uint64 {
+	seen := make(map[uint64]struct{})
+	ids := make([]uint64, 0)
+
+	add := func(list ...uint64) {
+		for _, id := range list {
+			if id == 0 {
+				continue
+			}
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+
+	add(k.Victim.CharacterID, k.Victim.CorporationID, k.Victim.AllianceID, k.Victim.ShipTypeID)
+	if fb, ok := k.FinalBlow(); ok {
+		add(fb.CharacterID, fb.CorporationID, fb.AllianceID, fb.ShipTypeID)
+	}
+	if system, ok := GetSystem(k.SolarSystemID); ok {
+		add(uint64(system.RegionID))
+	}
+
+	return ids
+}
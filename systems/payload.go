@@ -0,0 +1,76 @@
+package systems
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// PayloadVersion is the version of the Payload schema. It's raised whenever a
+// field is renamed or removed, new fields don't change it.
+const PayloadVersion = 1
+
+// Payload is the killmail as other tools receive it: the zKillboard killmail
+// along with what chainkills knows about it.
+type Payload struct {
+	Version     int               `json:"version"`
+	SentAt      time.Time         `json:"sent_at"` // Set when the payload is posted
+	Killmail    Killmail          `json:"killmail"`
+	System      *PayloadSystem    `json:"system,omitempty"`
+	Maps        []PayloadMap      `json:"maps"`
+	Involvement string            `json:"involvement"`
+	Names       map[string]string `json:"names"` // IDs on the killmail, keyed as strings
+}
+
+type PayloadSystem struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Band   string `json:"band,omitempty"`
+	Class  string `json:"class,omitempty"`
+	Region string `json:"region,omitempty"`
+}
+
+// PayloadMap is a map the system is on, jumps are left out when the map has
+// no home or no route to the system.
+type PayloadMap struct {
+	Name  string `json:"name"`
+	Jumps *int   `json:"jumps,omitempty"`
+}
+
+// Payload resolves the names on the killmail and looks the system up in the
+// static data and on the maps.
+func (k *Killmail) Payload(ctx context.Context) Payload {
+	resolved := ResolveNames(ctx, k.entityIDs()...)
+	names := make(map[string]string, len(resolved))
+	for id, name := range resolved {
+		names[strconv.FormatUint(id, 10)] = name
+	}
+
+	payload := Payload{
+		Version:     PayloadVersion,
+		Killmail:    *k,
+		Maps:        make([]PayloadMap, 0),
+		Involvement: k.Involvement(),
+		Names:       names,
+	}
+
+	if system, ok := LookupSystem(ctx, k.SolarSystemID); ok {
+		payload.System = &PayloadSystem{
+			ID:     system.SystemID,
+			Name:   system.SystemName,
+			Band:   string(system.Band()),
+			Class:  system.WormholeClass.String(),
+			Region: resolved[uint64(system.RegionID)],
+		}
+	}
+
+	for _, reg := range Registers().Containing(k.SolarSystemID) {
+		m := PayloadMap{Name: reg.Map().Name}
+		if jumps, ok := reg.Jumps(k.SolarSystemID); ok {
+			m.Jumps = &jumps
+		}
+		payload.Maps = append(payload.Maps, m)
+	}
+
+	return payload
+}
@@ -0,0 +1,53 @@
+package systems
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayload(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	registers = newTestMaps()
+
+	km := Killmail{
+		KillmailID:    123,
+		SolarSystemID: 31000355,
+		Victim:        CharacterInfo{CharacterID: 3, ShipTypeID: 587},
+		Attackers:     []CharacterInfo{{CharacterID: 4, ShipTypeID: 670, FinalBlow: true}},
+		Zkill:         Zkb{TotalValue: 1_000_000},
+	}
+
+	payload := km.Payload(context.Background())
+	require.Equal(t, PayloadVersion, payload.Version)
+	require.Equal(t, km.KillmailID, payload.Killmail.KillmailID)
+	require.Equal(t, InvolvementFriendlyLoss, payload.Involvement)
+
+	require.NotNil(t, payload.System)
+	require.Equal(t, "J164417", payload.System.Name)
+	require.Equal(t, "wormhole", payload.System.Band)
+	require.Equal(t, "C2", payload.System.Class)
+	require.NotEmpty(t, payload.System.Region)
+
+	require.Len(t, payload.Maps, 2)
+	require.Equal(t, "corp", payload.Maps[0].Name)
+	require.Equal(t, 1, *payload.Maps[0].Jumps)
+
+	require.Equal(t, "Rifter", payload.Names["587"])
+	require.Equal(t, "Capsule", payload.Names["670"])
+
+	// systems outside the static data and the maps still have a payload
+	km.SolarSystemID = 1
+	payload = km.Payload(context.Background())
+	require.Nil(t, payload.System)
+	require.Empty(t, payload.Maps)
+
+	raw, err := json.Marshal(payload)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"maps":[]`)
+	require.NotContains(t, string(raw), `"system"`)
+}
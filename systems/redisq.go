@@ -0,0 +1,169 @@
+package systems
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/google/uuid"
+)
+
+// redisqLastPoll is when RedisQ last answered a poll, in Unix nanoseconds.
+var redisqLastPoll atomic.Int64
+
+// redisqHealthy is false once RedisQ didn't answer for three request
+// timeouts. Empty answers count, quiet periods are normal.
+func redisqHealthy() bool {
+	last := time.Unix(0, redisqLastPoll.Load())
+	return time.Since(last) < 3*redisqTimeout(config.Get().Zkillboard.RedisQ)
+}
+
+// redisqTimeout is the request timeout. The server holds the request for ttw
+// seconds, so leave some headroom on top.
+func redisqTimeout(cfg config.RedisQ) time.Duration {
+	return time.Duration(cfg.TTW)*time.Second + 30*time.Second
+}
+
+type redisqPackage struct {
+	Package *struct {
+		KillID   uint64   `json:"killID"`
+		Killmail Killmail `json:"killmail"`
+		Zkill    Zkb      `json:"zkb"`
+	} `json:"package"`
+}
+
+// StartRedisQListener long-polls the zKillboard RedisQ endpoint and sends
+// every killmail that passes the filters to the outbox. It returns when stop
+// is closed or when the endpoint keeps failing.
+func StartRedisQListener(outbox chan Killmail, stop chan struct{}, errchan chan error) error {
+	cfg := config.Get().Zkillboard.RedisQ
+
+	queueID := cfg.QueueID
+	if queueID == "" {
+		queueID = uuid.NewString()
+		slog.Warn("no redisq queue id configured, using a random one", "queue_id", queueID)
+	}
+
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		slog.Error("failed to parse redisq url", "url", cfg.URL, "error", err)
+		return err
+	}
+	q := u.Query()
+	q.Set("queueID", queueID)
+	q.Set("ttw", strconv.Itoa(cfg.TTW))
+	u.RawQuery = q.Encode()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-stop:
+			slog.Info("stopping redisq listener")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	client := http.Client{
+		Timeout: redisqTimeout(cfg),
+	}
+
+	slog.Info("starting redisq listener", "url", u.String())
+	redisqLastPoll.Store(time.Now().UnixNano())
+
+	errorCount := 0
+	for {
+		killmail, ok, err := pollRedisQ(ctx, &client, u.String())
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			errchan <- err
+			slog.Warn("error polling redisq", "error", err)
+			errorCount++
+			if errorCount > 5 {
+				slog.Error("too many errors, exiting")
+				return err
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(time.Duration(errorCount) * time.Second):
+			}
+			continue
+		}
+
+		errorCount = 0
+		redisqLastPoll.Store(time.Now().UnixNano())
+		watermark.touch(ctx)
+
+		if !ok {
+			continue
+		}
+
+		publish(ctx, outbox, killmail)
+	}
+}
+
+// pollRedisQ fetches a single package from RedisQ. The returned bool is false
+// if the queue was empty.
+func pollRedisQ(ctx context.Context, client *http.Client, endpoint string) (Killmail, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return Killmail{}, false, err
+	}
+	req.Header.Set("User-Agent", fmt.Sprintf("%s/%s:%s %s", config.Get().AdminName, config.Get().AppName, config.Get().Version, config.Get().AdminEmail))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Killmail{}, false, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("failed to close response body", "error", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return Killmail{}, false, fmt.Errorf("unexpected status code from redisq: %d", resp.StatusCode)
+	}
+
+	var pkg redisqPackage
+	if err := json.NewDecoder(resp.Body).Decode(&pkg); err != nil {
+		return Killmail{}, false, err
+	}
+
+	if pkg.Package == nil {
+		return Killmail{}, false, nil
+	}
+
+	killmail := pkg.Package.Killmail
+	killmail.Zkill = pkg.Package.Zkill
+
+	// RedisQ may only send the zkb block, in which case the killmail itself
+	// has to come from ESI
+	if killmail.KillmailID == 0 {
+		esiKM, err := GetEsiKillmail(ctx, pkg.Package.KillID, killmail.Zkill.Hash)
+		if err != nil {
+			return Killmail{}, false, err
+		}
+		esiKM.Zkill = killmail.Zkill
+		killmail = esiKM
+	}
+
+	if killmail.Zkill.URL == "" {
+		killmail.Zkill.URL = fmt.Sprintf("https://zkillboard.com/kill/%d/", killmail.KillmailID)
+	}
+
+	return killmail, true, nil
+}
@@ -0,0 +1,86 @@
+package systems
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisQListener(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	reg := newTestRegister(config.Wanderer{})
+	reg.systems = []System{{Name: "J123456", SolarSystemID: 31000001}}
+	registers = NewRegisterSet(reg)
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "test-queue", r.URL.Query().Get("queueID"))
+
+		switch calls.Add(1) {
+		case 1:
+			fmt.Fprint(w, `{"package":{"killID":1,"killmail":{"killmail_id":1,"solar_system_id":31000001,"killmail_time":"2025-01-01T00:00:00Z"},"zkb":{"hash":"abc","npc":false}}}`)
+		case 2:
+			fmt.Fprint(w, `{"package":{"killID":2,"killmail":{"killmail_id":2,"solar_system_id":30000142},"zkb":{"hash":"def"}}}`)
+		case 3:
+			fmt.Fprint(w, `{"package":{"killID":3,"killmail":{"killmail_id":3,"solar_system_id":31000001},"zkb":{"hash":"ghi","npc":true}}}`)
+		default:
+			fmt.Fprint(w, `{"package":null}`)
+		}
+	}))
+	defer srv.Close()
+
+	config.Get().Zkillboard.RedisQ = config.RedisQ{
+		URL:     srv.URL,
+		QueueID: "test-queue",
+		TTW:     1,
+	}
+
+	outbox := make(chan Killmail)
+	stop := make(chan struct{})
+	errchan := make(chan error, 10)
+	done := make(chan error)
+
+	go func() {
+		done <- StartRedisQListener(outbox, stop, errchan)
+	}()
+
+	select {
+	case km := <-outbox:
+		require.Equal(t, uint64(1), km.KillmailID)
+		require.Equal(t, "abc", km.Zkill.Hash)
+		require.Equal(t, "https://zkillboard.com/kill/1/", km.Zkill.URL)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for killmail")
+	}
+
+	require.Eventually(t, func() bool { return calls.Load() > 3 }, 5*time.Second, 10*time.Millisecond)
+	require.True(t, redisqHealthy())
+
+	close(stop)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("listener did not stop")
+	}
+
+	require.Empty(t, outbox)
+}
+
+func TestRedisQHealthy(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+	config.Get().Zkillboard.RedisQ.TTW = 10
+
+	redisqLastPoll.Store(time.Now().Add(-time.Minute).UnixNano())
+	require.True(t, redisqHealthy())
+
+	redisqLastPoll.Store(time.Now().Add(-3 * time.Minute).UnixNano())
+	require.False(t, redisqHealthy())
+}
@@ -0,0 +1,225 @@
+package systems
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+)
+
+var registers *RegisterSet
+
+// RegisterSet holds the registers of every configured map. Killmails are
+// received for all of their systems combined and routed by map afterwards.
+type RegisterSet struct {
+	mx *sync.Mutex
+
+	registers []*SystemRegister
+}
+
+func NewRegisterSet(registers ...*SystemRegister) *RegisterSet {
+	return &RegisterSet{
+		mx:        &sync.Mutex{},
+		registers: registers,
+	}
+}
+
+// Registers returns the registers of the maps in the config, creating them
+// on first use.
+func Registers() *RegisterSet {
+	if registers == nil {
+		set := NewRegisterSet()
+		for _, m := range config.Get().MapList() {
+			set.registers = append(set.registers, NewRegister(m))
+		}
+
+		registers = set
+	}
+
+	return registers
+}
+
+// All returns every register in the order of the config.
+func (r *RegisterSet) All() []*SystemRegister {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	return r.registers
+}
+
+// Systems returns the systems of every map, each system once.
+func (r *RegisterSet) Systems() []System {
+	seen := make(map[int]struct{})
+	systems := make([]System, 0)
+
+	for _, reg := range r.All() {
+		for _, sys := range reg.Systems() {
+			if _, ok := seen[sys.SolarSystemID]; ok {
+				continue
+			}
+			seen[sys.SolarSystemID] = struct{}{}
+			systems = append(systems, sys)
+		}
+	}
+
+	return systems
+}
+
+// Containing returns the registers of the maps the system is on.
+func (r *RegisterSet) Containing(systemID int) []*SystemRegister {
+	found := make([]*SystemRegister, 0)
+	for _, reg := range r.All() {
+		if reg.Contains(systemID) {
+			found = append(found, reg)
+		}
+	}
+
+	return found
+}
+
+// Jumps returns the shortest distance from the system to the home of any map
+// it is on.
+func (r *RegisterSet) Jumps(systemID int) (int, bool) {
+	shortest, found := 0, false
+	for _, reg := range r.Containing(systemID) {
+		if jumps, ok := reg.Jumps(systemID); ok && (!found || jumps < shortest) {
+			shortest, found = jumps, true
+		}
+	}
+
+	return shortest, found
+}
+
+// JumpsAllowed reports whether the killmail is within the limit on any of the
+// maps its system is on.
+func (r *RegisterSet) JumpsAllowed(km Killmail, limit config.JumpLimit) bool {
+	if limit.MaxJumps <= 0 {
+		return true
+	}
+
+	for _, reg := range r.Containing(km.SolarSystemID) {
+		if reg.JumpsAllowed(km, limit) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Channels returns the channels that take the killmail, from every map its
+// system is on. A channel shared by several maps is returned once.
+func (r *RegisterSet) Channels(km Killmail) []config.Channel {
+	seen := make(map[string]struct{})
+	channels := make([]config.Channel, 0)
+
+	for _, reg := range r.Containing(km.SolarSystemID) {
+		for _, c := range reg.Channels(km) {
+			key := newDestination(c, "").Key()
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			channels = append(channels, c)
+		}
+	}
+
+	return channels
+}
+
+// Channels returns the map's channels that take the killmail. Kills beyond
+// the global jump limit only go to the low priority channel.
+func (s *SystemRegister) Channels(km Killmail) []config.Channel {
+	channels := s.m.Channels
+	if !s.JumpsAllowed(km, config.Get().JumpLimit) {
+		slog.Debug("routing killmail to low priority channel",
+			"reason", "system is too far from home",
+			"map", s.m.Name,
+			"id", km.KillmailID,
+			"system", km.SolarSystemID,
+		)
+		channels = []config.Channel{config.Get().Discord.LowPriorityChannel}
+	}
+
+	valid := make([]config.Channel, 0, len(channels))
+	for _, c := range channels {
+		if !c.IsSet() {
+			continue
+		}
+
+		if !ValueAllowed(km, c.ValueThreshold) {
+			slog.Debug("skipping channel",
+				"reason", "value is outside of the channel's threshold",
+				"channel", c.ID,
+				"id", km.KillmailID,
+				"value", km.Zkill.TotalValue,
+			)
+			continue
+		}
+
+		if !s.JumpsAllowed(km, c.JumpLimit) {
+			slog.Debug("skipping channel",
+				"reason", "system is beyond the channel's jump limit",
+				"map", s.m.Name,
+				"channel", c.ID,
+				"id", km.KillmailID,
+				"system", km.SolarSystemID,
+			)
+			continue
+		}
+
+		valid = append(valid, c)
+	}
+
+	return valid
+}
+
+// Fetch sends the killmails of the systems of every map to out.
+func (r *RegisterSet) Fetch(ctx context.Context, out chan Killmail) error {
+	return fetchSystems(ctx, r.Systems(), out)
+}
+
+// Watch returns a channel that receives the combined system list every time
+// any map changes. Only the latest list is kept if the receiver falls behind.
+// The returned function stops the watch.
+func (r *RegisterSet) Watch() (<-chan []System, func()) {
+	ch := make(chan []System, 1)
+	done := make(chan struct{})
+	wg := &sync.WaitGroup{}
+
+	unwatchers := make([]func(), 0)
+	for _, reg := range r.All() {
+		changes, unwatch := reg.Watch()
+		unwatchers = append(unwatchers, unwatch)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				case <-changes:
+				}
+
+				systems := r.Systems()
+				select {
+				case <-ch:
+				default:
+				}
+				select {
+				case ch <- systems:
+				default:
+				}
+			}
+		}()
+	}
+
+	return ch, func() {
+		for _, unwatch := range unwatchers {
+			unwatch()
+		}
+		close(done)
+		wg.Wait()
+	}
+}
@@ -0,0 +1,150 @@
+package systems
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMaps() *RegisterSet {
+	corp := NewRegister(config.Map{
+		Name:     "corp",
+		Channels: []config.Channel{{ID: "corp"}, {ID: "shared"}},
+	})
+	corp.systems = []System{
+		{Name: "J152820", SolarSystemID: 31001880},
+		{Name: "J164417", SolarSystemID: 31000355},
+		{Name: "J100001", SolarSystemID: 31000006},
+	}
+	corp.chain = newChain(31001880, []MapConnection{{Source: 31001880, Target: 31000355}})
+
+	staging := NewRegister(config.Map{
+		Name: "staging",
+		Channels: []config.Channel{
+			{ID: "staging", ValueThreshold: config.ValueThreshold{MinValue: 1_000_000}},
+			{ID: "shared"},
+		},
+	})
+	staging.systems = []System{
+		{Name: "J164417", SolarSystemID: 31000355},
+		{Name: "Jita", SolarSystemID: 30000142},
+	}
+	staging.chain = newChain(30000142, []MapConnection{{Source: 30000142, Target: 31000355}})
+
+	return NewRegisterSet(corp, staging)
+}
+
+func channelIDs(channels []config.Channel) []string {
+	ids := make([]string, 0, len(channels))
+	for _, c := range channels {
+		ids = append(ids, c.ID)
+	}
+
+	return ids
+}
+
+func TestRegisterSetSystems(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	set := newTestMaps()
+
+	ids := make([]int, 0)
+	for _, sys := range set.Systems() {
+		ids = append(ids, sys.SolarSystemID)
+	}
+	require.Equal(t, []int{31001880, 31000355, 31000006, 30000142}, ids)
+
+	require.Len(t, set.Containing(31000355), 2)
+	require.Len(t, set.Containing(30000142), 1)
+	require.Empty(t, set.Containing(1))
+
+	jumps, ok := set.Jumps(30000142)
+	require.True(t, ok)
+	require.Equal(t, 0, jumps)
+}
+
+func TestRegisterSetChannels(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	set := newTestMaps()
+
+	tests := []struct {
+		label    string
+		killmail Killmail
+		limit    config.JumpLimit
+		low      string
+		expected []string
+	}{
+		{
+			label:    "one map",
+			killmail: Killmail{SolarSystemID: 31001880},
+			expected: []string{"corp", "shared"},
+		},
+		{
+			label:    "shared system",
+			killmail: Killmail{SolarSystemID: 31000355, Zkill: Zkb{TotalValue: 5_000_000}},
+			expected: []string{"corp", "shared", "staging"},
+		},
+		{
+			label:    "channel threshold",
+			killmail: Killmail{SolarSystemID: 31000355},
+			expected: []string{"corp", "shared"},
+		},
+		{
+			label:    "not on any map",
+			killmail: Killmail{SolarSystemID: 1},
+			expected: []string{},
+		},
+		{
+			label:    "within jump limit",
+			killmail: Killmail{SolarSystemID: 31001880},
+			limit:    config.JumpLimit{MaxJumps: 1},
+			low:      "low",
+			expected: []string{"corp", "shared"},
+		},
+		{
+			label:    "beyond jump limit",
+			killmail: Killmail{SolarSystemID: 31000006},
+			limit:    config.JumpLimit{MaxJumps: 1},
+			expected: []string{},
+		},
+		{
+			label:    "beyond jump limit with low priority channel",
+			killmail: Killmail{SolarSystemID: 31000006},
+			limit:    config.JumpLimit{MaxJumps: 1},
+			low:      "low",
+			expected: []string{"low"},
+		},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			config.Get().JumpLimit = tt.limit
+			config.Get().Discord.LowPriorityChannel = config.Channel{ID: tt.low}
+
+			require.Equal(t, tt.expected, channelIDs(set.Channels(tt.killmail)))
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestRegisterSetWatch(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	set := newTestMaps()
+	changes, unwatch := set.Watch()
+	defer unwatch()
+
+	require.True(t, set.All()[1].AddSystem(context.Background(), System{Name: "J152820", SolarSystemID: 31001880}))
+
+	select {
+	case systems := <-changes:
+		require.Len(t, systems, 4)
+	case <-time.After(time.Second):
+		require.FailNow(t, "no change received")
+	}
+}
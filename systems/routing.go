@@ -0,0 +1,189 @@
+package systems
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+)
+
+const (
+	RoutingFirst = "first"
+	RoutingAll   = "all"
+
+	InvolvementFriendlyLoss = "friendly_loss"
+	InvolvementFriendlyKill = "friendly_kill"
+	InvolvementNeutral      = "neutral"
+)
+
+// Destination is a channel or webhook a killmail is sent to, along with the
+// text that goes with the embed.
+type Destination struct {
+	Platform  string `json:"platform,omitempty"`
+	ChannelID string `json:"channel_id,omitempty"`
+	Webhook   string `json:"webhook,omitempty"`
+	Mention   string `json:"mention,omitempty"`
+}
+
+func newDestination(c config.Channel, mention string) Destination {
+	return Destination{Platform: c.Platform, ChannelID: c.ID, Webhook: c.Webhook, Mention: mention}
+}
+
+// Key identifies the destination when removing duplicates and queueing.
+func (d Destination) Key() string {
+	if d.Webhook != "" {
+		return d.Webhook
+	}
+
+	return d.Platform + ":" + d.ChannelID
+}
+
+// String keeps webhook URLs, which contain the webhook's token, out of the
+// logs.
+func (d Destination) String() string {
+	label := d.ChannelID
+	if d.Webhook != "" {
+		label = strings.TrimSpace("webhook " + d.ChannelID)
+	}
+	if d.Platform != "" {
+		label = fmt.Sprintf("%s %s", d.Platform, label)
+	}
+
+	return label
+}
+
+func (d Destination) LogValue() slog.Value {
+	return slog.StringValue(d.String())
+}
+
+// Involvement returns how friends are involved in the killmail. A friendly
+// victim makes it a loss even if friends are on the attackers too.
+func (k *Killmail) Involvement() string {
+	if k.Victim.IsFriend() {
+		return InvolvementFriendlyLoss
+	}
+
+	if k.InvolvesFriends() {
+		return InvolvementFriendlyKill
+	}
+
+	return InvolvementNeutral
+}
+
+// Destinations returns where the killmail is sent. With routing rules those
+// are the channels of the first matching rule, or of every matching rule in
+// all mode. Without rules they are the channels of the maps its system is on.
+// The global jump limit applies to rules as well: a killmail beyond it that
+// matches a rule only goes to the low priority channel, without the rule's
+// mention.
+func (r *RegisterSet) Destinations(ctx context.Context, km Killmail) []Destination {
+	routing := config.Get().Routing
+	destinations := make([]Destination, 0)
+
+	if len(routing.Rules) == 0 {
+		for _, c := range r.Channels(km) {
+			destinations = append(destinations, newDestination(c, ""))
+		}
+		return destinations
+	}
+
+	farAway := !r.JumpsAllowed(km, config.Get().JumpLimit)
+
+	seen := make(map[string]struct{})
+	for _, rule := range routing.Rules {
+		if !r.matches(ctx, km, rule.Match) {
+			continue
+		}
+
+		slog.Debug("killmail matched rule",
+			"rule", rule.Name,
+			"id", km.KillmailID,
+		)
+
+		if farAway {
+			slog.Debug("routing killmail to low priority channel",
+				"reason", "system is too far from home",
+				"rule", rule.Name,
+				"id", km.KillmailID,
+				"system", km.SolarSystemID,
+			)
+
+			low := config.Get().Discord.LowPriorityChannel
+			if !low.IsSet() || !ValueAllowed(km, low.ValueThreshold) {
+				return destinations
+			}
+			return append(destinations, newDestination(low, ""))
+		}
+
+		for _, c := range rule.Channels {
+			if !ValueAllowed(km, c.ValueThreshold) || !r.JumpsAllowed(km, c.JumpLimit) {
+				continue
+			}
+
+			d := newDestination(c, rule.Mention)
+			if _, ok := seen[d.Key()]; ok {
+				continue
+			}
+			seen[d.Key()] = struct{}{}
+			destinations = append(destinations, d)
+		}
+
+		if routing.Mode != RoutingAll {
+			break
+		}
+	}
+
+	return destinations
+}
+
+// matches reports whether the killmail meets every condition of the rule.
+func (r *RegisterSet) matches(ctx context.Context, km Killmail, match config.RuleMatch) bool {
+	if len(match.Involvement) > 0 && !containsFold(match.Involvement, km.Involvement()) {
+		return false
+	}
+
+	if !ValueAllowed(km, config.ValueThreshold{MinValue: match.MinValue, MaxValue: match.MaxValue}) {
+		return false
+	}
+
+	if len(match.ShipGroups) > 0 {
+		t, _ := LookupType(ctx, int(km.Victim.ShipTypeID))
+		group, _ := LookupGroup(ctx, t.GroupID)
+		if !matchesIDOrName(match.ShipGroups, group.GroupID, group.GroupName) {
+			return false
+		}
+	}
+
+	if len(match.SystemClasses) > 0 {
+		system, _ := LookupSystem(ctx, km.SolarSystemID)
+		if !matchesClass(match.SystemClasses, system.Class()) {
+			return false
+		}
+	}
+
+	if len(match.Maps) == 0 && match.MaxJumps <= 0 {
+		return true
+	}
+
+	// the jumps are counted on the maps the rule is limited to
+	for _, reg := range r.Containing(km.SolarSystemID) {
+		if len(match.Maps) > 0 && !containsFold(match.Maps, reg.Map().Name) {
+			continue
+		}
+
+		if reg.JumpsAllowed(km, config.JumpLimit{MaxJumps: match.MaxJumps}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsFold(list []string, value string) bool {
+	return slices.ContainsFunc(list, func(entry string) bool {
+		return strings.EqualFold(strings.TrimSpace(entry), value)
+	})
+}
@@ -0,0 +1,248 @@
+package systems
+
+import (
+	"context"
+	"testing"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvolvement(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	loss := Killmail{
+		Victim:    CharacterInfo{CorporationID: 2},
+		Attackers: []CharacterInfo{{AllianceID: 1}},
+	}
+	kill := Killmail{
+		Victim:    CharacterInfo{CorporationID: 20},
+		Attackers: []CharacterInfo{{CorporationID: 10}, {CharacterID: 3}},
+	}
+	neutral := Killmail{
+		Victim:    CharacterInfo{CorporationID: 20},
+		Attackers: []CharacterInfo{{CorporationID: 10}},
+	}
+
+	require.Equal(t, InvolvementFriendlyLoss, loss.Involvement())
+	require.Equal(t, InvolvementFriendlyKill, kill.Involvement())
+	require.Equal(t, InvolvementNeutral, neutral.Involvement())
+}
+
+func TestDestinations(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	set := newTestMaps()
+
+	rules := []config.Rule{
+		{
+			Name:     "losses",
+			Match:    config.RuleMatch{Involvement: []string{InvolvementFriendlyLoss}},
+			Channels: []config.Channel{{ID: "losses"}},
+			Mention:  "@here",
+		},
+		{
+			Name:     "kills",
+			Match:    config.RuleMatch{Involvement: []string{"Friendly_Kill"}},
+			Channels: []config.Channel{{ID: "kills"}},
+		},
+		{
+			Name:     "intel",
+			Match:    config.RuleMatch{MinValue: 100_000_000},
+			Channels: []config.Channel{{ID: "intel"}, {ID: "kills"}},
+		},
+	}
+
+	lossIn := func(system int, value float64) Killmail {
+		return Killmail{
+			SolarSystemID: system,
+			Victim:        CharacterInfo{CharacterID: 3, ShipTypeID: 587},
+			Zkill:         Zkb{TotalValue: value},
+		}
+	}
+	killIn := func(system int, value float64) Killmail {
+		return Killmail{
+			SolarSystemID: system,
+			Victim:        CharacterInfo{CharacterID: 30, ShipTypeID: 670},
+			Attackers:     []CharacterInfo{{AllianceID: 1}},
+			Zkill:         Zkb{TotalValue: value},
+		}
+	}
+	neutralIn := func(system int, value float64) Killmail {
+		return Killmail{
+			SolarSystemID: system,
+			Victim:        CharacterInfo{CharacterID: 30, ShipTypeID: 587},
+			Zkill:         Zkb{TotalValue: value},
+		}
+	}
+
+	SetUniverseResolver(fakeUniverse{})
+	defer SetUniverseResolver(nil)
+
+	tests := []struct {
+		label    string
+		routing  config.Routing
+		limit    config.JumpLimit
+		low      config.Channel
+		killmail Killmail
+		expected []Destination
+	}{
+		{
+			label:    "no rules uses the map channels",
+			killmail: neutralIn(31001880, 0),
+			expected: []Destination{{ChannelID: "corp"}, {ChannelID: "shared"}},
+		},
+		{
+			label:    "friendly loss",
+			routing:  config.Routing{Rules: rules},
+			killmail: lossIn(31001880, 500_000_000),
+			expected: []Destination{{ChannelID: "losses", Mention: "@here"}},
+		},
+		{
+			label:    "cheap friendly loss",
+			routing:  config.Routing{Rules: rules},
+			killmail: lossIn(31001880, 0),
+			expected: []Destination{{ChannelID: "losses", Mention: "@here"}},
+		},
+		{
+			label:    "friendly kill",
+			routing:  config.Routing{Rules: rules},
+			killmail: killIn(31001880, 500_000_000),
+			expected: []Destination{{ChannelID: "kills"}},
+		},
+		{
+			label:    "expensive neutral",
+			routing:  config.Routing{Rules: rules},
+			killmail: neutralIn(31001880, 500_000_000),
+			expected: []Destination{{ChannelID: "intel"}, {ChannelID: "kills"}},
+		},
+		{
+			label:    "cheap neutral",
+			routing:  config.Routing{Rules: rules},
+			killmail: neutralIn(31001880, 1_000_000),
+			expected: []Destination{},
+		},
+		{
+			label:    "fan out",
+			routing:  config.Routing{Mode: RoutingAll, Rules: rules},
+			killmail: killIn(31001880, 500_000_000),
+			expected: []Destination{{ChannelID: "kills"}, {ChannelID: "intel"}},
+		},
+		{
+			label: "webhooks",
+			routing: config.Routing{Mode: RoutingAll, Rules: []config.Rule{
+				{Channels: []config.Channel{{ID: "allies", Webhook: "https://discord.example/api/webhooks/1/a"}}},
+				{Channels: []config.Channel{{Webhook: "https://discord.example/api/webhooks/1/a"}, {ID: "intel"}}},
+			}},
+			killmail: neutralIn(31001880, 0),
+			expected: []Destination{
+				{ChannelID: "allies", Webhook: "https://discord.example/api/webhooks/1/a"},
+				{ChannelID: "intel"},
+			},
+		},
+		{
+			label: "ship group",
+			routing: config.Routing{Rules: []config.Rule{
+				{Match: config.RuleMatch{ShipGroups: []string{"capsule"}}, Channels: []config.Channel{{ID: "pods"}}},
+			}},
+			killmail: killIn(31001880, 0),
+			expected: []Destination{{ChannelID: "pods"}},
+		},
+		{
+			label: "system class",
+			routing: config.Routing{Rules: []config.Rule{
+				{Match: config.RuleMatch{SystemClasses: []string{"C2"}}, Channels: []config.Channel{{ID: "c2"}}},
+				{Match: config.RuleMatch{SystemClasses: []string{"C5"}}, Channels: []config.Channel{{ID: "c5"}}},
+			}},
+			killmail: neutralIn(31001880, 0),
+			expected: []Destination{{ChannelID: "c5"}},
+		},
+		{
+			label: "k-space system class",
+			routing: config.Routing{Rules: []config.Rule{
+				{Match: config.RuleMatch{SystemClasses: []string{"Lowsec", "Nullsec"}}, Channels: []config.Channel{{ID: "lowsec"}}},
+				{Match: config.RuleMatch{SystemClasses: []string{"Highsec"}}, Channels: []config.Channel{{ID: "highsec"}}},
+			}},
+			killmail: neutralIn(30000142, 0),
+			expected: []Destination{{ChannelID: "highsec"}},
+		},
+		{
+			label: "map",
+			routing: config.Routing{Rules: []config.Rule{
+				{Match: config.RuleMatch{Maps: []string{"corp"}}, Channels: []config.Channel{{ID: "corp"}}},
+				{Match: config.RuleMatch{Maps: []string{"staging"}}, Channels: []config.Channel{{ID: "staging"}}},
+			}},
+			killmail: neutralIn(30000142, 0),
+			expected: []Destination{{ChannelID: "staging"}},
+		},
+		{
+			label: "jumps",
+			routing: config.Routing{Rules: []config.Rule{
+				{Match: config.RuleMatch{MaxJumps: 1}, Channels: []config.Channel{{ID: "close"}}},
+				{Channels: []config.Channel{{ID: "far"}}},
+			}},
+			killmail: neutralIn(31000006, 0),
+			expected: []Destination{{ChannelID: "far"}},
+		},
+		{
+			label: "jumps on the map of the rule",
+			routing: config.Routing{Rules: []config.Rule{
+				{Match: config.RuleMatch{Maps: []string{"staging"}, MaxJumps: 1}, Channels: []config.Channel{{ID: "staging"}}},
+			}},
+			killmail: neutralIn(31000355, 0),
+			expected: []Destination{{ChannelID: "staging"}},
+		},
+		{
+			label: "beyond the jump limit goes to the low priority channel",
+			routing: config.Routing{Mode: RoutingAll, Rules: []config.Rule{
+				{Channels: []config.Channel{{ID: "intel"}}, Mention: "@here"},
+				{Channels: []config.Channel{{ID: "kills"}}},
+			}},
+			limit:    config.JumpLimit{MaxJumps: 2},
+			low:      config.Channel{Webhook: "https://discord.example/api/webhooks/2/b"},
+			killmail: neutralIn(31000006, 0),
+			expected: []Destination{{Webhook: "https://discord.example/api/webhooks/2/b"}},
+		},
+		{
+			label: "beyond the jump limit without a low priority channel",
+			routing: config.Routing{Rules: []config.Rule{
+				{Channels: []config.Channel{{ID: "intel"}}},
+			}},
+			limit:    config.JumpLimit{MaxJumps: 2},
+			killmail: neutralIn(31000006, 0),
+			expected: []Destination{},
+		},
+		{
+			label: "within the jump limit",
+			routing: config.Routing{Rules: []config.Rule{
+				{Channels: []config.Channel{{ID: "intel"}}},
+			}},
+			limit:    config.JumpLimit{MaxJumps: 2},
+			low:      config.Channel{ID: "low"},
+			killmail: neutralIn(31000355, 0),
+			expected: []Destination{{ChannelID: "intel"}},
+		},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			config.Get().Routing = tt.routing
+			config.Get().JumpLimit = tt.limit
+			config.Get().Discord.LowPriorityChannel = tt.low
+			defer func() {
+				config.Get().JumpLimit = config.JumpLimit{}
+				config.Get().Discord.LowPriorityChannel = config.Channel{}
+			}()
+
+			require.Equal(t, tt.expected, set.Destinations(context.Background(), tt.killmail))
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestDestinationString(t *testing.T) {
+	require.Equal(t, "123", Destination{ChannelID: "123"}.String())
+	require.Equal(t, "webhook allies", Destination{ChannelID: "allies", Webhook: "https://discord.example/api/webhooks/1/a"}.String())
+	require.Equal(t, "webhook", Destination{Webhook: "https://discord.example/api/webhooks/1/a"}.String())
+}
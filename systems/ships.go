@@ -0,0 +1,110 @@
+package systems
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"git.sr.ht/~barveyhirdman/chainkills/backend"
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+)
+
+const (
+	FilterInclude = "include"
+	FilterExclude = "exclude"
+)
+
+// ShipFilterName returns the name of the backend filter list for a mode and
+// inventory kind, e.g. ship_exclude_group.
+func ShipFilterName(mode, kind string) string {
+	return fmt.Sprintf("ship_%s_%s", mode, kind)
+}
+
+// shipFilters merges the configured ship filters with the ones added through
+// slash commands.
+func shipFilters() config.ShipFilters {
+	filters := config.Get().ShipFilters
+
+	b, err := backend.Backend()
+	if err != nil {
+		slog.Warn("failed to get backend", "error", err)
+		return filters
+	}
+
+	mergeFilters(b, map[string]*[]string{
+		ShipFilterName(FilterInclude, KindType):     &filters.IncludeTypes,
+		ShipFilterName(FilterExclude, KindType):     &filters.ExcludeTypes,
+		ShipFilterName(FilterInclude, KindGroup):    &filters.IncludeGroups,
+		ShipFilterName(FilterExclude, KindGroup):    &filters.ExcludeGroups,
+		ShipFilterName(FilterInclude, KindCategory): &filters.IncludeCategories,
+		ShipFilterName(FilterExclude, KindCategory): &filters.ExcludeCategories,
+	})
+
+	return filters
+}
+
+// mergeFilters appends the entries of each backend filter list to the list it
+// maps to. Lists that can't be read are left as they are.
+func mergeFilters(b backend.Engine, lists map[string]*[]string) {
+	for name, list := range lists {
+		values, err := b.GetFilter(context.Background(), name)
+		if err != nil {
+			slog.Warn("failed to get filter from backend", "filter", name, "error", err)
+			continue
+		}
+		*list = slices.Concat(*list, values)
+	}
+}
+
+// ShipAllowed reports whether a ship type passes the filters. Types, groups
+// and categories missing from the static data are looked up, types that
+// can't be looked up can only be matched by their type ID.
+func ShipAllowed(ctx context.Context, typeID uint64, filters config.ShipFilters) bool {
+	if len(filters.IncludeTypes) == 0 && len(filters.IncludeGroups) == 0 && len(filters.IncludeCategories) == 0 &&
+		len(filters.ExcludeTypes) == 0 && len(filters.ExcludeGroups) == 0 && len(filters.ExcludeCategories) == 0 {
+		return true
+	}
+
+	t, _ := LookupType(ctx, int(typeID))
+	group, _ := LookupGroup(ctx, t.GroupID)
+	category, _ := LookupCategory(ctx, group.CategoryID)
+
+	matchType := func(list []string) bool {
+		return matchesIDOrName(list, int(typeID), t.TypeName)
+	}
+	matchGroup := func(list []string) bool {
+		return matchesIDOrName(list, group.GroupID, group.GroupName)
+	}
+	matchCategory := func(list []string) bool {
+		return matchesIDOrName(list, category.CategoryID, category.CategoryName)
+	}
+
+	if matchType(filters.ExcludeTypes) || matchGroup(filters.ExcludeGroups) || matchCategory(filters.ExcludeCategories) {
+		return false
+	}
+
+	if len(filters.IncludeTypes) == 0 && len(filters.IncludeGroups) == 0 && len(filters.IncludeCategories) == 0 {
+		return true
+	}
+
+	return matchType(filters.IncludeTypes) || matchGroup(filters.IncludeGroups) || matchCategory(filters.IncludeCategories)
+}
+
+// matchesIDOrName reports whether any entry of the list is the ID or the
+// name, ignoring case.
+func matchesIDOrName(list []string, id int, name string) bool {
+	for _, entry := range list {
+		entry = strings.TrimSpace(entry)
+		if id > 0 && entry == strconv.Itoa(id) {
+			return true
+		}
+		if name != "" && strings.EqualFold(entry, name) {
+			return true
+		}
+	}
+
+	return false
+}
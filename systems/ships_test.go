@@ -0,0 +1,169 @@
+package systems
+
+import (
+	"context"
+	"testing"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShipAllowed(t *testing.T) {
+	SetUniverseResolver(fakeUniverse{})
+	defer SetUniverseResolver(nil)
+
+	const (
+		capsule   = 670
+		rifter    = 587
+		astrahus  = 35832
+		depot     = 33474
+		zirnitra  = 52907
+		ihub      = 32458
+		unknownID = 999999
+	)
+
+	tests := []struct {
+		label    string
+		typeID   uint64
+		filters  config.ShipFilters
+		expected bool
+	}{
+		{
+			label:    "no filters",
+			typeID:   capsule,
+			filters:  config.ShipFilters{},
+			expected: true,
+		},
+		{
+			label:    "excluded group by name",
+			typeID:   capsule,
+			filters:  config.ShipFilters{ExcludeGroups: []string{"capsule"}},
+			expected: false,
+		},
+		{
+			label:    "excluded group by id",
+			typeID:   capsule,
+			filters:  config.ShipFilters{ExcludeGroups: []string{"29"}},
+			expected: false,
+		},
+		{
+			label:    "other group excluded",
+			typeID:   rifter,
+			filters:  config.ShipFilters{ExcludeGroups: []string{"Capsule"}},
+			expected: true,
+		},
+		{
+			label:    "excluded category",
+			typeID:   depot,
+			filters:  config.ShipFilters{ExcludeCategories: []string{"Deployable"}},
+			expected: false,
+		},
+		{
+			label:    "included category",
+			typeID:   astrahus,
+			filters:  config.ShipFilters{IncludeCategories: []string{"Structure"}},
+			expected: true,
+		},
+		{
+			label:    "not included",
+			typeID:   rifter,
+			filters:  config.ShipFilters{IncludeCategories: []string{"Structure"}},
+			expected: false,
+		},
+		{
+			label:    "included type by name",
+			typeID:   rifter,
+			filters:  config.ShipFilters{IncludeTypes: []string{"Rifter"}, IncludeCategories: []string{"Structure"}},
+			expected: true,
+		},
+		{
+			label:  "exclude wins over include",
+			typeID: astrahus,
+			filters: config.ShipFilters{
+				IncludeCategories: []string{"Structure"},
+				ExcludeTypes:      []string{"Astrahus"},
+			},
+			expected: false,
+		},
+		{
+			label:    "precursor dreadnought",
+			typeID:   zirnitra,
+			filters:  config.ShipFilters{ExcludeGroups: []string{"Dreadnought"}},
+			expected: false,
+		},
+		{
+			label:    "looked up type in a static category",
+			typeID:   ihub,
+			filters:  config.ShipFilters{ExcludeCategories: []string{"Sovereignty Structures"}},
+			expected: false,
+		},
+		{
+			label:    "looked up group",
+			typeID:   ihub,
+			filters:  config.ShipFilters{IncludeGroups: []string{"Infrastructure Hubs"}},
+			expected: true,
+		},
+		{
+			label:    "unknown type by id",
+			typeID:   unknownID,
+			filters:  config.ShipFilters{ExcludeTypes: []string{"999999"}},
+			expected: false,
+		},
+		{
+			label:    "unknown type with include",
+			typeID:   unknownID,
+			filters:  config.ShipFilters{IncludeGroups: []string{"Frigate"}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			require.Equal(t, tt.expected, ShipAllowed(context.Background(), tt.typeID, tt.filters))
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestLookupInventory(t *testing.T) {
+	tests := []struct {
+		label string
+		kind  string
+		value string
+		id    int
+		name  string
+		found bool
+	}{
+		{label: "type by name", kind: KindType, value: "rifter", id: 587, name: "Rifter", found: true},
+		{label: "type by id", kind: KindType, value: "670", id: 670, name: "Capsule", found: true},
+		{label: "group by name", kind: KindGroup, value: "Mobile Depot", id: 1246, name: "Mobile Depot", found: true},
+		{label: "category by name", kind: KindCategory, value: "structure", id: 65, name: "Structure", found: true},
+		{label: "unknown id", kind: KindGroup, value: "123456", id: 123456, found: true},
+		{label: "unknown name", kind: KindGroup, value: "Spaceship", found: false},
+		{label: "unknown kind", kind: "region", value: "1", found: false},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			id, name, found := LookupInventory(tt.kind, tt.value)
+			require.Equal(t, tt.found, found)
+			require.Equal(t, tt.id, id)
+			require.Equal(t, tt.name, name)
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestInventoryIntegrity(t *testing.T) {
+	for id, tp := range cachedTypes {
+		require.Equal(t, id, tp.TypeID)
+
+		group, ok := tp.Group()
+		require.True(t, ok, "type %d has unknown group %d", id, tp.GroupID)
+
+		_, ok = GetCategory(group.CategoryID)
+		require.True(t, ok, "group %d has unknown category %d", group.GroupID, group.CategoryID)
+	}
+}
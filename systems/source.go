@@ -0,0 +1,535 @@
+package systems
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+)
+
+const (
+	SourceWebsocket = "websocket"
+	SourceRedisQ    = "redisq"
+	SourcePoll      = "poll"
+
+	ModeAll      = "all"
+	ModeFailover = "failover"
+)
+
+// Source is a stream of killmails.
+type Source interface {
+	Name() string
+	// Start sends killmails to the outbox and blocks until the context is
+	// cancelled, Stop is called or the source fails.
+	Start(ctx context.Context, outbox chan Killmail) error
+	Stop()
+	Health() Health
+}
+
+type Health struct {
+	Running     bool
+	Healthy     bool
+	LastMessage time.Time
+	LastError   error
+}
+
+// NewSource creates a source by its configured name.
+func NewSource(name string) (Source, error) {
+	switch name {
+	case SourceWebsocket:
+		source := NewListenerSource(SourceWebsocket, StartListener)
+		source.healthy = func() bool {
+			if c := activeConnection.Load(); c != nil {
+				return c.Healthy()
+			}
+			return true
+		}
+		return source, nil
+	case SourceRedisQ:
+		source := NewListenerSource(SourceRedisQ, StartRedisQListener)
+		source.healthy = redisqHealthy
+		return source, nil
+	case SourcePoll:
+		interval := time.Duration(config.Get().Zkillboard.PollInterval) * time.Second
+		return NewPollSource(Registers(), interval), nil
+	}
+
+	return nil, fmt.Errorf("unknown source: %s", name)
+}
+
+// ListenerFunc is the signature shared by StartListener and
+// StartRedisQListener.
+type ListenerFunc func(outbox chan Killmail, stop chan struct{}, errchan chan error) error
+
+type ListenerSource struct {
+	mx *sync.Mutex
+
+	name     string
+	listener ListenerFunc
+	healthy  func() bool
+	cancel   context.CancelFunc
+	health   Health
+}
+
+func NewListenerSource(name string, listener ListenerFunc) *ListenerSource {
+	return &ListenerSource{
+		mx:       &sync.Mutex{},
+		name:     name,
+		listener: listener,
+	}
+}
+
+func (l *ListenerSource) Name() string {
+	return l.name
+}
+
+func (l *ListenerSource) Start(ctx context.Context, outbox chan Killmail) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	l.mx.Lock()
+	if l.health.Running {
+		l.mx.Unlock()
+		return fmt.Errorf("source %s is already running", l.name)
+	}
+	l.cancel = cancel
+	l.health.Running = true
+	l.health.Healthy = true
+	l.mx.Unlock()
+
+	stop := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		close(stop)
+	}()
+
+	relay := make(chan Killmail)
+	errchan := make(chan error)
+	quit := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-quit:
+				return
+			case km := <-relay:
+				l.mx.Lock()
+				l.health.LastMessage = time.Now()
+				l.mx.Unlock()
+				outbox <- km
+			case err := <-errchan:
+				l.mx.Lock()
+				l.health.LastError = err
+				l.mx.Unlock()
+			}
+		}
+	}()
+
+	if since := watermark.get(ctx); !since.IsZero() && config.Get().Zkillboard.Backfill.Enabled {
+		go func() {
+			if _, err := Backfill(ctx, since, relay); err != nil {
+				slog.Error("failed to backfill killmails", "source", l.name, "error", err)
+			}
+		}()
+	}
+
+	err := l.listener(relay, stop, errchan)
+	close(quit)
+	<-done
+
+	l.mx.Lock()
+	l.health.Running = false
+	l.health.Healthy = false
+	if err != nil {
+		l.health.LastError = err
+	}
+	l.mx.Unlock()
+
+	return err
+}
+
+func (l *ListenerSource) Stop() {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if l.cancel != nil {
+		l.cancel()
+	}
+}
+
+func (l *ListenerSource) Health() Health {
+	l.mx.Lock()
+	health := l.health
+	l.mx.Unlock()
+
+	if health.Running && l.healthy != nil {
+		health.Healthy = l.healthy()
+	}
+
+	return health
+}
+
+// Fetcher sends the killmails of its systems to out.
+type Fetcher interface {
+	Fetch(ctx context.Context, out chan Killmail) error
+}
+
+// PollSource periodically fetches the killmails of every system in the
+// register from the zKillboard API. It's unhealthy once a few polls in a row
+// failed.
+type PollSource struct {
+	mx *sync.Mutex
+
+	register Fetcher
+	interval time.Duration
+	cancel   context.CancelFunc
+	health   Health
+	// when the last fetch succeeded, or the source started
+	lastFetch time.Time
+}
+
+func NewPollSource(register Fetcher, interval time.Duration) *PollSource {
+	return &PollSource{
+		mx:       &sync.Mutex{},
+		register: register,
+		interval: interval,
+	}
+}
+
+func (p *PollSource) Name() string {
+	return SourcePoll
+}
+
+func (p *PollSource) Start(ctx context.Context, outbox chan Killmail) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	p.mx.Lock()
+	if p.health.Running {
+		p.mx.Unlock()
+		return fmt.Errorf("source %s is already running", SourcePoll)
+	}
+	p.cancel = cancel
+	p.health.Running = true
+	p.health.Healthy = true
+	p.lastFetch = time.Now()
+	p.mx.Unlock()
+
+	defer func() {
+		p.mx.Lock()
+		p.health.Running = false
+		p.health.Healthy = false
+		p.mx.Unlock()
+	}()
+
+	slog.Info("starting poll source", "interval", p.interval.String())
+
+	tick := time.NewTicker(p.interval)
+	defer tick.Stop()
+
+	for {
+		relay := make(chan Killmail)
+		go func() {
+			defer close(relay)
+			err := p.register.Fetch(ctx, relay)
+			if err != nil {
+				slog.Error("failed to poll killmails", "error", err)
+			}
+
+			p.mx.Lock()
+			if err != nil {
+				p.health.LastError = err
+			} else {
+				p.lastFetch = time.Now()
+			}
+			p.mx.Unlock()
+		}()
+
+		for km := range relay {
+			if !publish(ctx, outbox, km) {
+				continue
+			}
+
+			p.mx.Lock()
+			p.health.LastMessage = time.Now()
+			p.mx.Unlock()
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("stopping poll source")
+			return nil
+		case <-tick.C:
+		}
+	}
+}
+
+func (p *PollSource) Stop() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.cancel != nil {
+		p.cancel()
+	}
+}
+
+// Health reports the source as unhealthy once no fetch succeeded for three
+// intervals.
+func (p *PollSource) Health() Health {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	health := p.health
+	if health.Running {
+		health.Healthy = time.Since(p.lastFetch) < 3*p.interval
+	}
+
+	return health
+}
+
+// Multiplexer runs several sources either all at once or one at a time with
+// failover, and drops killmails that were already delivered by another
+// source.
+type Multiplexer struct {
+	mx *sync.Mutex
+
+	mode    string
+	sources []Source
+	retry   time.Duration
+	ttl     time.Duration
+	// how often seen killmails older than ttl are dropped
+	sweep  time.Duration
+	cancel context.CancelFunc
+	seen   map[uint64]time.Time
+}
+
+func NewMultiplexer(mode string, sources ...Source) *Multiplexer {
+	return &Multiplexer{
+		mx:      &sync.Mutex{},
+		mode:    mode,
+		sources: sources,
+		retry:   5 * time.Second,
+		ttl:     2 * time.Hour,
+		sweep:   10 * time.Minute,
+		seen:    make(map[uint64]time.Time),
+	}
+}
+
+func (m *Multiplexer) Name() string {
+	return "multiplexer"
+}
+
+func (m *Multiplexer) Start(ctx context.Context, outbox chan Killmail) error {
+	if len(m.sources) == 0 {
+		return fmt.Errorf("no sources configured")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	m.mx.Lock()
+	m.cancel = cancel
+	m.mx.Unlock()
+
+	go m.expire(ctx)
+
+	inbox := make(chan Killmail)
+	forwarded := make(chan struct{})
+
+	go func() {
+		defer close(forwarded)
+		for km := range inbox {
+			if km.KillmailID == 0 || m.duplicate(km.KillmailID) {
+				slog.Debug("filtered out killmail", "reason", "duplicate", "id", km.KillmailID)
+				continue
+			}
+
+			select {
+			case outbox <- km:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	wg := &sync.WaitGroup{}
+	switch m.mode {
+	case ModeFailover:
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.runFailover(ctx, inbox)
+		}()
+	default:
+		for _, src := range m.sources {
+			wg.Add(1)
+			go func(s Source) {
+				defer wg.Done()
+				m.run(ctx, s, inbox)
+			}(src)
+		}
+	}
+
+	wg.Wait()
+	close(inbox)
+	<-forwarded
+
+	return nil
+}
+
+func (m *Multiplexer) Stop() {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
+
+// Health reports healthy as long as any of the sources is.
+func (m *Multiplexer) Health() Health {
+	var health Health
+	for _, src := range m.sources {
+		h := src.Health()
+		health.Running = health.Running || h.Running
+		health.Healthy = health.Healthy || h.Healthy
+		if h.LastMessage.After(health.LastMessage) {
+			health.LastMessage = h.LastMessage
+		}
+		if h.LastError != nil {
+			health.LastError = h.LastError
+		}
+	}
+
+	return health
+}
+
+// Sources returns the health of every source by name.
+func (m *Multiplexer) Sources() map[string]Health {
+	sources := make(map[string]Health, len(m.sources))
+	for _, src := range m.sources {
+		sources[src.Name()] = src.Health()
+	}
+
+	return sources
+}
+
+// wait sleeps for the retry interval and reports whether the multiplexer is
+// still running afterwards.
+func (m *Multiplexer) wait(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(m.retry):
+		return true
+	}
+}
+
+func (m *Multiplexer) run(ctx context.Context, src Source, inbox chan Killmail) {
+	for ctx.Err() == nil {
+		if err := src.Start(ctx, inbox); err != nil {
+			slog.Error("source failed", "source", src.Name(), "error", err)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		slog.Warn("source stopped, restarting", "source", src.Name(), "sleep", m.retry.String())
+		if !m.wait(ctx) {
+			return
+		}
+	}
+}
+
+func (m *Multiplexer) runFailover(ctx context.Context, inbox chan Killmail) {
+	active := 0
+	for ctx.Err() == nil {
+		src := m.sources[active]
+		slog.Info("starting source", "source", src.Name())
+
+		done := make(chan struct{})
+		go m.watch(src, done)
+
+		if err := src.Start(ctx, inbox); err != nil {
+			slog.Error("source failed", "source", src.Name(), "error", err)
+		}
+		close(done)
+		if ctx.Err() != nil {
+			return
+		}
+
+		active = (active + 1) % len(m.sources)
+		slog.Warn("failing over to next source",
+			"from", src.Name(),
+			"to", m.sources[active].Name(),
+			"sleep", m.retry.String(),
+		)
+		if !m.wait(ctx) {
+			return
+		}
+	}
+}
+
+// watch stops the source once it reports itself as unhealthy so that
+// runFailover can move on to the next one.
+func (m *Multiplexer) watch(src Source, done chan struct{}) {
+	tick := time.NewTicker(m.retry)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-tick.C:
+			if h := src.Health(); h.Running && !h.Healthy {
+				slog.Warn("source is unhealthy, stopping it", "source", src.Name(), "last_error", h.LastError)
+				src.Stop()
+				return
+			}
+		}
+	}
+}
+
+func (m *Multiplexer) duplicate(id uint64) bool {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if _, ok := m.seen[id]; ok {
+		return true
+	}
+
+	m.seen[id] = time.Now()
+	return false
+}
+
+// expire drops the seen killmails older than the ttl on every sweep until
+// ctx is done.
+func (m *Multiplexer) expire(ctx context.Context) {
+	tick := time.NewTicker(m.sweep)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-tick.C:
+			m.forget(now.Add(-1 * m.ttl))
+		}
+	}
+}
+
+// forget drops the killmails seen before the cutoff.
+func (m *Multiplexer) forget(cutoff time.Time) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	for id, added := range m.seen {
+		if added.Before(cutoff) {
+			delete(m.seen, id)
+		}
+	}
+}
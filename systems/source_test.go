@@ -0,0 +1,244 @@
+package systems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/backend"
+	"git.sr.ht/~barveyhirdman/chainkills/backend/memory"
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSource struct {
+	mx *sync.Mutex
+
+	name      string
+	killmails []uint64
+	err       error
+	starts    int
+}
+
+func newFakeSource(name string, err error, ids ...uint64) *fakeSource {
+	return &fakeSource{
+		mx:        &sync.Mutex{},
+		name:      name,
+		killmails: ids,
+		err:       err,
+	}
+}
+
+func (f *fakeSource) Name() string { return f.name }
+
+func (f *fakeSource) Start(ctx context.Context, outbox chan Killmail) error {
+	f.mx.Lock()
+	f.starts++
+	f.mx.Unlock()
+
+	for _, id := range f.killmails {
+		outbox <- Killmail{KillmailID: id}
+	}
+
+	if f.err != nil {
+		return f.err
+	}
+
+	<-ctx.Done()
+	return nil
+}
+
+func (f *fakeSource) Stop() {}
+
+func (f *fakeSource) Health() Health { return Health{} }
+
+func (f *fakeSource) startCount() int {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	return f.starts
+}
+
+func collect(t *testing.T, outbox chan Killmail, count int) []uint64 {
+	t.Helper()
+
+	ids := make([]uint64, 0, count)
+	for len(ids) < count {
+		select {
+		case km := <-outbox:
+			ids = append(ids, km.KillmailID)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out after receiving %d killmails", len(ids))
+		}
+	}
+
+	return ids
+}
+
+func TestMultiplexerDeduplicates(t *testing.T) {
+	mux := NewMultiplexer(ModeAll,
+		newFakeSource("a", nil, 1, 2, 3),
+		newFakeSource("b", nil, 2, 3, 4),
+	)
+
+	outbox := make(chan Killmail)
+	done := make(chan error)
+	go func() {
+		done <- mux.Start(context.Background(), outbox)
+	}()
+
+	ids := collect(t, outbox, 4)
+	require.ElementsMatch(t, []uint64{1, 2, 3, 4}, ids)
+
+	select {
+	case km := <-outbox:
+		t.Fatalf("unexpected killmail %d", km.KillmailID)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	mux.Stop()
+	require.NoError(t, <-done)
+}
+
+func TestMultiplexerFailover(t *testing.T) {
+	primary := newFakeSource("primary", errors.New("connection lost"), 1)
+	secondary := newFakeSource("secondary", nil, 1, 2)
+
+	mux := NewMultiplexer(ModeFailover, primary, secondary)
+	mux.retry = 10 * time.Millisecond
+
+	outbox := make(chan Killmail)
+	done := make(chan error)
+	go func() {
+		done <- mux.Start(context.Background(), outbox)
+	}()
+
+	ids := collect(t, outbox, 2)
+	require.Equal(t, []uint64{1, 2}, ids)
+	require.Equal(t, 1, primary.startCount())
+	require.Equal(t, 1, secondary.startCount())
+
+	mux.Stop()
+	require.NoError(t, <-done)
+}
+
+type fakeFetcher struct {
+	err error
+}
+
+func (f fakeFetcher) Fetch(_ context.Context, _ chan Killmail) error {
+	return f.err
+}
+
+func TestPollSourceHealth(t *testing.T) {
+	tests := []struct {
+		label   string
+		err     error
+		healthy bool
+	}{
+		{label: "fetches succeed", healthy: true},
+		{label: "fetches fail", err: errors.New("zkillboard is down"), healthy: false},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			src := NewPollSource(fakeFetcher{err: tt.err}, 20*time.Millisecond)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error)
+			go func() {
+				done <- src.Start(ctx, make(chan Killmail))
+			}()
+
+			require.Eventually(t, func() bool { return src.Health().Running }, time.Second, time.Millisecond)
+			time.Sleep(100 * time.Millisecond)
+			require.Equal(t, tt.healthy, src.Health().Healthy)
+
+			cancel()
+			require.NoError(t, <-done)
+			require.False(t, src.Health().Healthy)
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestMultiplexerForget(t *testing.T) {
+	mux := NewMultiplexer(ModeAll)
+
+	require.False(t, mux.duplicate(1))
+	require.True(t, mux.duplicate(1))
+
+	mux.forget(time.Now().Add(-time.Minute))
+	require.True(t, mux.duplicate(1))
+
+	mux.forget(time.Now().Add(time.Minute))
+	require.False(t, mux.duplicate(1))
+}
+
+// newZkillAPI serves the kills of the chain system 31000001 from the
+// zKillboard API and the ESI killmails behind them. zKillboard only lists
+// IDs and hashes, the system comes from ESI.
+func newZkillAPI(t *testing.T) string {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/api/systemID/31000001/") && strings.HasSuffix(r.URL.Path, "/page/1/"):
+			fmt.Fprint(w, `[{"killmail_id":1,"zkb":{"hash":"abc","totalValue":1000}}]`)
+		case strings.HasPrefix(r.URL.Path, "/api/systemID/"):
+			fmt.Fprint(w, `[]`)
+		case r.URL.Path == "/killmails/1/abc/":
+			fmt.Fprintf(w, `{"killmail_id":1,"solar_system_id":31000001,"killmail_time":%q,"victim":{"ship_type_id":587}}`,
+				time.Now().UTC().Format(time.RFC3339))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+	config.Get().Zkillboard.APIURL = srv.URL + "/api"
+	config.Get().ESI.BaseURL = srv.URL
+
+	cache, err := memory.New()
+	require.NoError(t, err)
+	backend.SetBackend(cache)
+	t.Cleanup(func() { backend.SetBackend(nil) })
+
+	reg := newTestRegister(config.Wanderer{})
+	reg.systems = []System{{Name: "J123456", SolarSystemID: 31000001}}
+	registers = NewRegisterSet(reg)
+
+	return srv.URL
+}
+
+func TestPollSourceDelivers(t *testing.T) {
+	newZkillAPI(t)
+
+	src := NewPollSource(Registers(), time.Hour)
+	outbox := make(chan Killmail)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- src.Start(ctx, outbox)
+	}()
+
+	select {
+	case km := <-outbox:
+		require.Equal(t, uint64(1), km.KillmailID)
+		require.Equal(t, 31000001, km.SolarSystemID)
+		require.Equal(t, uint64(587), km.Victim.ShipTypeID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for killmail")
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -1,25 +1,127 @@
-package systems
+// Static universe data, curated by hand from the system list chainkills
+// shipped with. Systems have their region and the wormhole class of their
+// region, constellations and security status are missing and are looked up
+// through the UniverseResolver instead. Regenerate this file from a full
+// export with make static SDE=path/to/dump.
 
-type CachedSystem struct {
-	RegionID   int
-	SystemID   int
-	SystemName string
-}
+package systems
 
-func GetSystem(systemID int) (CachedSystem, bool) {
-	system, ok := cachedSystems[systemID]
-	return system, ok
+var cachedRegions = map[int]CachedRegion{
+	10000001: {RegionID: 10000001, RegionName: "Derelik"},
+	10000002: {RegionID: 10000002, RegionName: "The Forge"},
+	10000003: {RegionID: 10000003, RegionName: "Vale of the Silent"},
+	10000004: {RegionID: 10000004, RegionName: "UUA-F4"},
+	10000005: {RegionID: 10000005, RegionName: "Detorid"},
+	10000006: {RegionID: 10000006, RegionName: "Wicked Creek"},
+	10000007: {RegionID: 10000007, RegionName: "Cache"},
+	10000008: {RegionID: 10000008, RegionName: "Scalding Pass"},
+	10000009: {RegionID: 10000009, RegionName: "Insmother"},
+	10000010: {RegionID: 10000010, RegionName: "Tribute"},
+	10000011: {RegionID: 10000011, RegionName: "Great Wildlands"},
+	10000012: {RegionID: 10000012, RegionName: "Curse"},
+	10000013: {RegionID: 10000013, RegionName: "Malpais"},
+	10000014: {RegionID: 10000014, RegionName: "Catch"},
+	10000015: {RegionID: 10000015, RegionName: "Venal"},
+	10000016: {RegionID: 10000016, RegionName: "Lonetrek"},
+	10000017: {RegionID: 10000017, RegionName: "J7HZ-F"},
+	10000018: {RegionID: 10000018, RegionName: "The Spire"},
+	10000019: {RegionID: 10000019, RegionName: "A821-A"},
+	10000020: {RegionID: 10000020, RegionName: "Tash-Murkon"},
+	10000021: {RegionID: 10000021, RegionName: "Outer Passage"},
+	10000022: {RegionID: 10000022, RegionName: "Stain"},
+	10000023: {RegionID: 10000023, RegionName: "Pure Blind"},
+	10000025: {RegionID: 10000025, RegionName: "Immensea"},
+	10000027: {RegionID: 10000027, RegionName: "Etherium Reach"},
+	10000028: {RegionID: 10000028, RegionName: "Molden Heath"},
+	10000029: {RegionID: 10000029, RegionName: "Geminate"},
+	10000030: {RegionID: 10000030, RegionName: "Heimatar"},
+	10000031: {RegionID: 10000031, RegionName: "Impass"},
+	10000032: {RegionID: 10000032, RegionName: "Sinq Laison"},
+	10000033: {RegionID: 10000033, RegionName: "The Citadel"},
+	10000034: {RegionID: 10000034, RegionName: "The Kalevala Expanse"},
+	10000035: {RegionID: 10000035, RegionName: "Deklein"},
+	10000036: {RegionID: 10000036, RegionName: "Devoid"},
+	10000037: {RegionID: 10000037, RegionName: "Everyshore"},
+	10000038: {RegionID: 10000038, RegionName: "The Bleak Lands"},
+	10000039: {RegionID: 10000039, RegionName: "Esoteria"},
+	10000040: {RegionID: 10000040, RegionName: "Oasa"},
+	10000041: {RegionID: 10000041, RegionName: "Syndicate"},
+	10000042: {RegionID: 10000042, RegionName: "Metropolis"},
+	10000043: {RegionID: 10000043, RegionName: "Domain"},
+	10000044: {RegionID: 10000044, RegionName: "Solitude"},
+	10000045: {RegionID: 10000045, RegionName: "Tenal"},
+	10000046: {RegionID: 10000046, RegionName: "Fade"},
+	10000047: {RegionID: 10000047, RegionName: "Providence"},
+	10000048: {RegionID: 10000048, RegionName: "Placid"},
+	10000049: {RegionID: 10000049, RegionName: "Khanid"},
+	10000050: {RegionID: 10000050, RegionName: "Querious"},
+	10000051: {RegionID: 10000051, RegionName: "Cloud Ring"},
+	10000052: {RegionID: 10000052, RegionName: "Kador"},
+	10000053: {RegionID: 10000053, RegionName: "Cobalt Edge"},
+	10000054: {RegionID: 10000054, RegionName: "Aridia"},
+	10000055: {RegionID: 10000055, RegionName: "Branch"},
+	10000056: {RegionID: 10000056, RegionName: "Feythabolis"},
+	10000057: {RegionID: 10000057, RegionName: "Outer Ring"},
+	10000058: {RegionID: 10000058, RegionName: "Fountain"},
+	10000059: {RegionID: 10000059, RegionName: "Paragon Soul"},
+	10000060: {RegionID: 10000060, RegionName: "Delve"},
+	10000061: {RegionID: 10000061, RegionName: "Tenerifis"},
+	10000062: {RegionID: 10000062, RegionName: "Omist"},
+	10000063: {RegionID: 10000063, RegionName: "Period Basis"},
+	10000064: {RegionID: 10000064, RegionName: "Essence"},
+	10000065: {RegionID: 10000065, RegionName: "Kor-Azor"},
+	10000066: {RegionID: 10000066, RegionName: "Perrigen Falls"},
+	10000067: {RegionID: 10000067, RegionName: "Genesis"},
+	10000068: {RegionID: 10000068, RegionName: "Verge Vendor"},
+	10000069: {RegionID: 10000069, RegionName: "Black Rise"},
+	10000070: {RegionID: 10000070, RegionName: "Pochven"},
+	10001000: {RegionID: 10001000, RegionName: "Yasna Zakh"},
+	11000001: {RegionID: 11000001, RegionName: "A-R00001"},
+	11000002: {RegionID: 11000002, RegionName: "A-R00002"},
+	11000003: {RegionID: 11000003, RegionName: "A-R00003"},
+	11000004: {RegionID: 11000004, RegionName: "B-R00004"},
+	11000005: {RegionID: 11000005, RegionName: "B-R00005"},
+	11000006: {RegionID: 11000006, RegionName: "B-R00006"},
+	11000007: {RegionID: 11000007, RegionName: "B-R00007"},
+	11000008: {RegionID: 11000008, RegionName: "B-R00008"},
+	11000009: {RegionID: 11000009, RegionName: "C-R00009"},
+	11000010: {RegionID: 11000010, RegionName: "C-R00010"},
+	11000011: {RegionID: 11000011, RegionName: "C-R00011"},
+	11000012: {RegionID: 11000012, RegionName: "C-R00012"},
+	11000013: {RegionID: 11000013, RegionName: "C-R00013"},
+	11000014: {RegionID: 11000014, RegionName: "C-R00014"},
+	11000015: {RegionID: 11000015, RegionName: "C-R00015"},
+	11000016: {RegionID: 11000016, RegionName: "D-R00016"},
+	11000017: {RegionID: 11000017, RegionName: "D-R00017"},
+	11000018: {RegionID: 11000018, RegionName: "D-R00018"},
+	11000019: {RegionID: 11000019, RegionName: "D-R00019"},
+	11000020: {RegionID: 11000020, RegionName: "D-R00020"},
+	11000021: {RegionID: 11000021, RegionName: "D-R00021"},
+	11000022: {RegionID: 11000022, RegionName: "D-R00022"},
+	11000023: {RegionID: 11000023, RegionName: "D-R00023"},
+	11000024: {RegionID: 11000024, RegionName: "E-R00024"},
+	11000025: {RegionID: 11000025, RegionName: "E-R00025"},
+	11000026: {RegionID: 11000026, RegionName: "E-R00026"},
+	11000027: {RegionID: 11000027, RegionName: "E-R00027"},
+	11000028: {RegionID: 11000028, RegionName: "E-R00028"},
+	11000029: {RegionID: 11000029, RegionName: "E-R00029"},
+	11000030: {RegionID: 11000030, RegionName: "F-R00030"},
+	11000031: {RegionID: 11000031, RegionName: "G-R00031"},
+	11000032: {RegionID: 11000032, RegionName: "H-R00032"},
+	11000033: {RegionID: 11000033, RegionName: "K-R00033"},
+	12000001: {RegionID: 12000001, RegionName: "ADR01"},
+	12000002: {RegionID: 12000002, RegionName: "ADR02"},
+	12000003: {RegionID: 12000003, RegionName: "ADR03"},
+	12000004: {RegionID: 12000004, RegionName: "ADR04"},
+	12000005: {RegionID: 12000005, RegionName: "ADR05"},
+	14000001: {RegionID: 14000001, RegionName: "VR-01"},
+	14000002: {RegionID: 14000002, RegionName: "VR-02"},
+	14000003: {RegionID: 14000003, RegionName: "VR-03"},
+	14000004: {RegionID: 14000004, RegionName: "VR-04"},
+	14000005: {RegionID: 14000005, RegionName: "VR-05"},
 }
 
-func GetSystemByName(systemName string) (CachedSystem, bool) {
-	for _, system := range cachedSystems {
-		if system.SystemName == systemName {
-			return system, true
-		}
-	}
-
-	return CachedSystem{}, false
-}
+var cachedConstellations = map[int]CachedConstellation{}
 
 var cachedSystems = map[int]CachedSystem{
 	30000001: {RegionID: 10000001, SystemID: 30000001, SystemName: "Tanoo"},
@@ -42,7 +144,7 @@ var cachedSystems = map[int]CachedSystem{
 	30000018: {RegionID: 10000001, SystemID: 30000018, SystemName: "Kazna"},
 	30000019: {RegionID: 10000001, SystemID: 30000019, SystemName: "Podion"},
 	30000020: {RegionID: 10000001, SystemID: 30000020, SystemName: "Lilmad"},
-	30000021: {RegionID: 10000070, SystemID: 30000021, SystemName: "Kuharah"},
+	30000021: {RegionID: 10000070, SystemID: 30000021, SystemName: "Kuharah", WormholeClass: 25},
 	30000022: {RegionID: 10000001, SystemID: 30000022, SystemName: "Jayneleb"},
 	30000023: {RegionID: 10000001, SystemID: 30000023, SystemName: "Fovihi"},
 	30000024: {RegionID: 10000001, SystemID: 30000024, SystemName: "Kiereend"},
@@ -178,7 +280,7 @@ var cachedSystems = map[int]CachedSystem{
 	30000154: {RegionID: 10000002, SystemID: 30000154, SystemName: "Liekuri"},
 	30000155: {RegionID: 10000002, SystemID: 30000155, SystemName: "Obanen"},
 	30000156: {RegionID: 10000002, SystemID: 30000156, SystemName: "Josameto"},
-	30000157: {RegionID: 10000070, SystemID: 30000157, SystemName: "Otela"},
+	30000157: {RegionID: 10000070, SystemID: 30000157, SystemName: "Otela", WormholeClass: 25},
 	30000158: {RegionID: 10000002, SystemID: 30000158, SystemName: "Olo"},
 	30000159: {RegionID: 10000002, SystemID: 30000159, SystemName: "Ikami"},
 	30000160: {RegionID: 10000002, SystemID: 30000160, SystemName: "Reisen"},
@@ -213,7 +315,7 @@ var cachedSystems = map[int]CachedSystem{
 	30000189: {RegionID: 10000002, SystemID: 30000189, SystemName: "Kiainti"},
 	30000190: {RegionID: 10000002, SystemID: 30000190, SystemName: "Vasala"},
 	30000191: {RegionID: 10000002, SystemID: 30000191, SystemName: "Walvalin"},
-	30000192: {RegionID: 10000070, SystemID: 30000192, SystemName: "Otanuomi"},
+	30000192: {RegionID: 10000070, SystemID: 30000192, SystemName: "Otanuomi", WormholeClass: 25},
 	30000193: {RegionID: 10000002, SystemID: 30000193, SystemName: "Vouskiaho"},
 	30000194: {RegionID: 10000002, SystemID: 30000194, SystemName: "Otsela"},
 	30000195: {RegionID: 10000002, SystemID: 30000195, SystemName: "Tasti"},
@@ -227,7 +329,7 @@ var cachedSystems = map[int]CachedSystem{
 	30000203: {RegionID: 10000002, SystemID: 30000203, SystemName: "Eruka"},
 	30000204: {RegionID: 10000002, SystemID: 30000204, SystemName: "Ohkunen"},
 	30000205: {RegionID: 10000002, SystemID: 30000205, SystemName: "Obe"},
-	30000206: {RegionID: 10000070, SystemID: 30000206, SystemName: "Wirashoda"},
+	30000206: {RegionID: 10000070, SystemID: 30000206, SystemName: "Wirashoda", WormholeClass: 25},
 	30000207: {RegionID: 10000002, SystemID: 30000207, SystemName: "Osaa"},
 	30000208: {RegionID: 10000003, SystemID: 30000208, SystemName: "LZ-6SU"},
 	30000209: {RegionID: 10000003, SystemID: 30000209, SystemName: "MC6O-F"},
@@ -1393,7 +1495,7 @@ var cachedSystems = map[int]CachedSystem{
 	30001369: {RegionID: 10000016, SystemID: 30001369, SystemName: "Sotrentaira"},
 	30001370: {RegionID: 10000016, SystemID: 30001370, SystemName: "Ouranienen"},
 	30001371: {RegionID: 10000016, SystemID: 30001371, SystemName: "Erenta"},
-	30001372: {RegionID: 10000070, SystemID: 30001372, SystemName: "Kino"},
+	30001372: {RegionID: 10000070, SystemID: 30001372, SystemName: "Kino", WormholeClass: 25},
 	30001373: {RegionID: 10000016, SystemID: 30001373, SystemName: "Raussinen"},
 	30001374: {RegionID: 10000016, SystemID: 30001374, SystemName: "Iidoken"},
 	30001375: {RegionID: 10000016, SystemID: 30001375, SystemName: "Tsuguwa"},
@@ -1402,7 +1504,7 @@ var cachedSystems = map[int]CachedSystem{
 	30001378: {RegionID: 10000016, SystemID: 30001378, SystemName: "Ekura"},
 	30001379: {RegionID: 10000016, SystemID: 30001379, SystemName: "Tunttaras"},
 	30001380: {RegionID: 10000016, SystemID: 30001380, SystemName: "Vellaine"},
-	30001381: {RegionID: 10000070, SystemID: 30001381, SystemName: "Arvasaras"},
+	30001381: {RegionID: 10000070, SystemID: 30001381, SystemName: "Arvasaras", WormholeClass: 25},
 	30001382: {RegionID: 10000016, SystemID: 30001382, SystemName: "Akonoinen"},
 	30001383: {RegionID: 10000016, SystemID: 30001383, SystemName: "Vaajaita"},
 	30001384: {RegionID: 10000016, SystemID: 30001384, SystemName: "Autaris"},
@@ -1434,7 +1536,7 @@ var cachedSystems = map[int]CachedSystem{
 	30001410: {RegionID: 10000016, SystemID: 30001410, SystemName: "Kirras"},
 	30001411: {RegionID: 10000016, SystemID: 30001411, SystemName: "Autama"},
 	30001412: {RegionID: 10000016, SystemID: 30001412, SystemName: "Tsukuras"},
-	30001413: {RegionID: 10000070, SystemID: 30001413, SystemName: "Nani"},
+	30001413: {RegionID: 10000070, SystemID: 30001413, SystemName: "Nani", WormholeClass: 25},
 	30001414: {RegionID: 10000016, SystemID: 30001414, SystemName: "Ajanen"},
 	30001415: {RegionID: 10000016, SystemID: 30001415, SystemName: "Kuoka"},
 	30001416: {RegionID: 10000016, SystemID: 30001416, SystemName: "Liukikka"},
@@ -1466,7 +1568,7 @@ var cachedSystems = map[int]CachedSystem{
 	30001442: {RegionID: 10000016, SystemID: 30001442, SystemName: "Saranen"},
 	30001443: {RegionID: 10000016, SystemID: 30001443, SystemName: "Vuorrassi"},
 	30001444: {RegionID: 10000016, SystemID: 30001444, SystemName: "Oimmo"},
-	30001445: {RegionID: 10000070, SystemID: 30001445, SystemName: "Nalvula"},
+	30001445: {RegionID: 10000070, SystemID: 30001445, SystemName: "Nalvula", WormholeClass: 25},
 	30001446: {RegionID: 10000016, SystemID: 30001446, SystemName: "Otsasai"},
 	30001447: {RegionID: 10000016, SystemID: 30001447, SystemName: "Taisy"},
 	30001448: {RegionID: 10000016, SystemID: 30001448, SystemName: "Hakonen"},
@@ -2100,7 +2202,7 @@ var cachedSystems = map[int]CachedSystem{
 	30002076: {RegionID: 10000042, SystemID: 30002076, SystemName: "Dudreda"},
 	30002077: {RegionID: 10000042, SystemID: 30002077, SystemName: "Hakisalki"},
 	30002078: {RegionID: 10000042, SystemID: 30002078, SystemName: "Arwa"},
-	30002079: {RegionID: 10000070, SystemID: 30002079, SystemName: "Krirald"},
+	30002079: {RegionID: 10000070, SystemID: 30002079, SystemName: "Krirald", WormholeClass: 25},
 	30002080: {RegionID: 10000042, SystemID: 30002080, SystemName: "Arifsdald"},
 	30002081: {RegionID: 10000042, SystemID: 30002081, SystemName: "Ansen"},
 	30002082: {RegionID: 10000042, SystemID: 30002082, SystemName: "Floseswin"},
@@ -2246,7 +2348,7 @@ var cachedSystems = map[int]CachedSystem{
 	30002222: {RegionID: 10000043, SystemID: 30002222, SystemName: "Airshaz"},
 	30002223: {RegionID: 10000043, SystemID: 30002223, SystemName: "Patzcha"},
 	30002224: {RegionID: 10000043, SystemID: 30002224, SystemName: "Charra"},
-	30002225: {RegionID: 10000070, SystemID: 30002225, SystemName: "Harva"},
+	30002225: {RegionID: 10000070, SystemID: 30002225, SystemName: "Harva", WormholeClass: 25},
 	30002226: {RegionID: 10000043, SystemID: 30002226, SystemName: "Thebeka"},
 	30002227: {RegionID: 10000043, SystemID: 30002227, SystemName: "Rasile"},
 	30002228: {RegionID: 10000043, SystemID: 30002228, SystemName: "Nererut"},
@@ -2432,7 +2534,7 @@ var cachedSystems = map[int]CachedSystem{
 	30002408: {RegionID: 10000028, SystemID: 30002408, SystemName: "Fegomenko"},
 	30002409: {RegionID: 10000028, SystemID: 30002409, SystemName: "Osvetur"},
 	30002410: {RegionID: 10000028, SystemID: 30002410, SystemName: "Mimiror"},
-	30002411: {RegionID: 10000070, SystemID: 30002411, SystemName: "Skarkon"},
+	30002411: {RegionID: 10000070, SystemID: 30002411, SystemName: "Skarkon", WormholeClass: 25},
 	30002412: {RegionID: 10000028, SystemID: 30002412, SystemName: "Ennur"},
 	30002413: {RegionID: 10000028, SystemID: 30002413, SystemName: "Unertek"},
 	30002414: {RegionID: 10000028, SystemID: 30002414, SystemName: "Klingt"},
@@ -2673,7 +2775,7 @@ var cachedSystems = map[int]CachedSystem{
 	30002649: {RegionID: 10000032, SystemID: 30002649, SystemName: "Boillair"},
 	30002650: {RegionID: 10000032, SystemID: 30002650, SystemName: "Ney"},
 	30002651: {RegionID: 10000032, SystemID: 30002651, SystemName: "Fasse"},
-	30002652: {RegionID: 10000070, SystemID: 30002652, SystemName: "Ala"},
+	30002652: {RegionID: 10000070, SystemID: 30002652, SystemName: "Ala", WormholeClass: 25},
 	30002653: {RegionID: 10000032, SystemID: 30002653, SystemName: "Gratesier"},
 	30002654: {RegionID: 10000032, SystemID: 30002654, SystemName: "Schoorasana"},
 	30002655: {RegionID: 10000032, SystemID: 30002655, SystemName: "Vylade"},
@@ -2723,7 +2825,7 @@ var cachedSystems = map[int]CachedSystem{
 	30002699: {RegionID: 10000032, SystemID: 30002699, SystemName: "Brapelille"},
 	30002700: {RegionID: 10000032, SystemID: 30002700, SystemName: "Bawilan"},
 	30002701: {RegionID: 10000032, SystemID: 30002701, SystemName: "Atier"},
-	30002702: {RegionID: 10000070, SystemID: 30002702, SystemName: "Archee"},
+	30002702: {RegionID: 10000070, SystemID: 30002702, SystemName: "Archee", WormholeClass: 25},
 	30002703: {RegionID: 10000032, SystemID: 30002703, SystemName: "Brybier"},
 	30002704: {RegionID: 10000032, SystemID: 30002704, SystemName: "Adrallezoen"},
 	30002705: {RegionID: 10000032, SystemID: 30002705, SystemName: "Croleur"},
@@ -2758,7 +2860,7 @@ var cachedSystems = map[int]CachedSystem{
 	30002734: {RegionID: 10000032, SystemID: 30002734, SystemName: "Fricoure"},
 	30002735: {RegionID: 10000032, SystemID: 30002735, SystemName: "Caretyn"},
 	30002736: {RegionID: 10000032, SystemID: 30002736, SystemName: "Ainaille"},
-	30002737: {RegionID: 10000070, SystemID: 30002737, SystemName: "Konola"},
+	30002737: {RegionID: 10000070, SystemID: 30002737, SystemName: "Konola", WormholeClass: 25},
 	30002738: {RegionID: 10000033, SystemID: 30002738, SystemName: "Inoue"},
 	30002739: {RegionID: 10000033, SystemID: 30002739, SystemName: "Isaziwa"},
 	30002740: {RegionID: 10000033, SystemID: 30002740, SystemName: "Eitu"},
@@ -2791,7 +2893,7 @@ var cachedSystems = map[int]CachedSystem{
 	30002767: {RegionID: 10000033, SystemID: 30002767, SystemName: "Kubinen"},
 	30002768: {RegionID: 10000033, SystemID: 30002768, SystemName: "Uedama"},
 	30002769: {RegionID: 10000033, SystemID: 30002769, SystemName: "Enderailen"},
-	30002770: {RegionID: 10000070, SystemID: 30002770, SystemName: "Tunudan"},
+	30002770: {RegionID: 10000070, SystemID: 30002770, SystemName: "Tunudan", WormholeClass: 25},
 	30002771: {RegionID: 10000033, SystemID: 30002771, SystemName: "Kulelen"},
 	30002772: {RegionID: 10000033, SystemID: 30002772, SystemName: "Rairomon"},
 	30002773: {RegionID: 10000033, SystemID: 30002773, SystemName: "Hogimo"},
@@ -2818,7 +2920,7 @@ var cachedSystems = map[int]CachedSystem{
 	30002794: {RegionID: 10000033, SystemID: 30002794, SystemName: "Yria"},
 	30002795: {RegionID: 10000033, SystemID: 30002795, SystemName: "Oshaima"},
 	30002796: {RegionID: 10000033, SystemID: 30002796, SystemName: "Hysera"},
-	30002797: {RegionID: 10000070, SystemID: 30002797, SystemName: "Kaunokka"},
+	30002797: {RegionID: 10000070, SystemID: 30002797, SystemName: "Kaunokka", WormholeClass: 25},
 	30002798: {RegionID: 10000033, SystemID: 30002798, SystemName: "Venilen"},
 	30002799: {RegionID: 10000033, SystemID: 30002799, SystemName: "Oisio"},
 	30002800: {RegionID: 10000033, SystemID: 30002800, SystemName: "Haatomo"},
@@ -3067,7 +3169,7 @@ var cachedSystems = map[int]CachedSystem{
 	30003043: {RegionID: 10000037, SystemID: 30003043, SystemName: "Uphene"},
 	30003044: {RegionID: 10000037, SystemID: 30003044, SystemName: "Elarel"},
 	30003045: {RegionID: 10000037, SystemID: 30003045, SystemName: "Enedore"},
-	30003046: {RegionID: 10000070, SystemID: 30003046, SystemName: "Angymonne"},
+	30003046: {RegionID: 10000070, SystemID: 30003046, SystemName: "Angymonne", WormholeClass: 25},
 	30003047: {RegionID: 10000037, SystemID: 30003047, SystemName: "Averon"},
 	30003048: {RegionID: 10000037, SystemID: 30003048, SystemName: "Carirgnottin"},
 	30003049: {RegionID: 10000037, SystemID: 30003049, SystemName: "Laic"},
@@ -3516,7 +3618,7 @@ var cachedSystems = map[int]CachedSystem{
 	30003492: {RegionID: 10000043, SystemID: 30003492, SystemName: "Orkashu"},
 	30003493: {RegionID: 10000043, SystemID: 30003493, SystemName: "Youl"},
 	30003494: {RegionID: 10000043, SystemID: 30003494, SystemName: "Ekid"},
-	30003495: {RegionID: 10000070, SystemID: 30003495, SystemName: "Raravoss"},
+	30003495: {RegionID: 10000070, SystemID: 30003495, SystemName: "Raravoss", WormholeClass: 25},
 	30003496: {RegionID: 10000043, SystemID: 30003496, SystemName: "Nakri"},
 	30003497: {RegionID: 10000043, SystemID: 30003497, SystemName: "Zaimeth"},
 	30003498: {RegionID: 10000043, SystemID: 30003498, SystemName: "Sharhelund"},
@@ -3525,7 +3627,7 @@ var cachedSystems = map[int]CachedSystem{
 	30003501: {RegionID: 10000043, SystemID: 30003501, SystemName: "Kudi"},
 	30003502: {RegionID: 10000043, SystemID: 30003502, SystemName: "Bahromab"},
 	30003503: {RegionID: 10000043, SystemID: 30003503, SystemName: "Madirmilire"},
-	30003504: {RegionID: 10000070, SystemID: 30003504, SystemName: "Niarja"},
+	30003504: {RegionID: 10000070, SystemID: 30003504, SystemName: "Niarja", WormholeClass: 25},
 	30003505: {RegionID: 10000043, SystemID: 30003505, SystemName: "Fabum"},
 	30003506: {RegionID: 10000043, SystemID: 30003506, SystemName: "Saana"},
 	30003507: {RegionID: 10000043, SystemID: 30003507, SystemName: "Teshi"},
@@ -5026,7 +5128,7 @@ var cachedSystems = map[int]CachedSystem{
 	30005002: {RegionID: 10000064, SystemID: 30005002, SystemName: "Laurvier"},
 	30005003: {RegionID: 10000064, SystemID: 30005003, SystemName: "Adirain"},
 	30005004: {RegionID: 10000064, SystemID: 30005004, SystemName: "Attyn"},
-	30005005: {RegionID: 10000070, SystemID: 30005005, SystemName: "Ignebaener"},
+	30005005: {RegionID: 10000070, SystemID: 30005005, SystemName: "Ignebaener", WormholeClass: 25},
 	30005006: {RegionID: 10000064, SystemID: 30005006, SystemName: "Aere"},
 	30005007: {RegionID: 10000064, SystemID: 30005007, SystemName: "Lisbaetanne"},
 	30005008: {RegionID: 10000064, SystemID: 30005008, SystemName: "Aeschee"},
@@ -5050,7 +5152,7 @@ var cachedSystems = map[int]CachedSystem{
 	30005026: {RegionID: 10000064, SystemID: 30005026, SystemName: "Cat"},
 	30005027: {RegionID: 10000064, SystemID: 30005027, SystemName: "Ommare"},
 	30005028: {RegionID: 10000064, SystemID: 30005028, SystemName: "Andole"},
-	30005029: {RegionID: 10000070, SystemID: 30005029, SystemName: "Vale"},
+	30005029: {RegionID: 10000070, SystemID: 30005029, SystemName: "Vale", WormholeClass: 25},
 	30005030: {RegionID: 10000065, SystemID: 30005030, SystemName: "Fensi"},
 	30005031: {RegionID: 10000065, SystemID: 30005031, SystemName: "Nebian"},
 	30005032: {RegionID: 10000065, SystemID: 30005032, SystemName: "Khabara"},
@@ -5356,7 +5458,7 @@ var cachedSystems = map[int]CachedSystem{
 	30005332: {RegionID: 10000068, SystemID: 30005332, SystemName: "Osmomonne"},
 	30005333: {RegionID: 10000068, SystemID: 30005333, SystemName: "Stou"},
 	30005334: {RegionID: 10000068, SystemID: 30005334, SystemName: "Tierijev"},
-	30010141: {RegionID: 10000070, SystemID: 30010141, SystemName: "Sakenta"},
+	30010141: {RegionID: 10000070, SystemID: 30010141, SystemName: "Sakenta", WormholeClass: 25},
 	30011392: {RegionID: 10000016, SystemID: 30011392, SystemName: "Jouvulen"},
 	30011407: {RegionID: 10000016, SystemID: 30011407, SystemName: "Akiainavas"},
 	30011672: {RegionID: 10000020, SystemID: 30011672, SystemName: "Kerepa"},
@@ -5368,7 +5470,7 @@ var cachedSystems = map[int]CachedSystem{
 	30014971: {RegionID: 10000064, SystemID: 30014971, SystemName: "Couster"},
 	30015042: {RegionID: 10000052, SystemID: 30015042, SystemName: "Akhwa"},
 	30015305: {RegionID: 10000068, SystemID: 30015305, SystemName: "Adallier"},
-	30020141: {RegionID: 10000070, SystemID: 30020141, SystemName: "Senda"},
+	30020141: {RegionID: 10000070, SystemID: 30020141, SystemName: "Senda", WormholeClass: 25},
 	30021392: {RegionID: 10000016, SystemID: 30021392, SystemName: "Kappas"},
 	30021407: {RegionID: 10000002, SystemID: 30021407, SystemName: "Aokannitoh"},
 	30021672: {RegionID: 10000020, SystemID: 30021672, SystemName: "Pasha"},
@@ -5381,7 +5483,7 @@ var cachedSystems = map[int]CachedSystem{
 	30025042: {RegionID: 10000065, SystemID: 30025042, SystemName: "Annad"},
 	30025305: {RegionID: 10000068, SystemID: 30025305, SystemName: "Channace"},
 	30030141: {RegionID: 10000002, SystemID: 30030141, SystemName: "Uitra"},
-	30031392: {RegionID: 10000070, SystemID: 30031392, SystemName: "Komo"},
+	30031392: {RegionID: 10000070, SystemID: 30031392, SystemName: "Komo", WormholeClass: 25},
 	30031407: {RegionID: 10000016, SystemID: 30031407, SystemName: "Hitanishio"},
 	30031672: {RegionID: 10000020, SystemID: 30031672, SystemName: "Safilbab"},
 	30032505: {RegionID: 10000030, SystemID: 30032505, SystemName: "Todeko"},
@@ -5392,7 +5494,7 @@ var cachedSystems = map[int]CachedSystem{
 	30034971: {RegionID: 10000064, SystemID: 30034971, SystemName: "Henebene"},
 	30035042: {RegionID: 10000065, SystemID: 30035042, SystemName: "Chaktaren"},
 	30035305: {RegionID: 10000068, SystemID: 30035305, SystemName: "Clacille"},
-	30040141: {RegionID: 10000070, SystemID: 30040141, SystemName: "Urhinichi"},
+	30040141: {RegionID: 10000070, SystemID: 30040141, SystemName: "Urhinichi", WormholeClass: 25},
 	30041392: {RegionID: 10000033, SystemID: 30041392, SystemName: "Laah"},
 	30041407: {RegionID: 10000016, SystemID: 30041407, SystemName: "Ichinumi"},
 	30041672: {RegionID: 10000020, SystemID: 30041672, SystemName: "Seitam"},
@@ -5426,8 +5528,8 @@ var cachedSystems = map[int]CachedSystem{
 	30045325: {RegionID: 10000069, SystemID: 30045325, SystemName: "Rohamaa"},
 	30045326: {RegionID: 10000069, SystemID: 30045326, SystemName: "Uuhulanen"},
 	30045327: {RegionID: 10000069, SystemID: 30045327, SystemName: "Tsuruma"},
-	30045328: {RegionID: 10000070, SystemID: 30045328, SystemName: "Ahtila"},
-	30045329: {RegionID: 10000070, SystemID: 30045329, SystemName: "Ichoriya"},
+	30045328: {RegionID: 10000070, SystemID: 30045328, SystemName: "Ahtila", WormholeClass: 25},
+	30045329: {RegionID: 10000070, SystemID: 30045329, SystemName: "Ichoriya", WormholeClass: 25},
 	30045330: {RegionID: 10000069, SystemID: 30045330, SystemName: "Okkamon"},
 	30045331: {RegionID: 10000069, SystemID: 30045331, SystemName: "Vaaralen"},
 	30045332: {RegionID: 10000069, SystemID: 30045332, SystemName: "Asakai"},
@@ -5454,2610 +5556,2610 @@ var cachedSystems = map[int]CachedSystem{
 	30045353: {RegionID: 10000069, SystemID: 30045353, SystemName: "Pynekastoh"},
 	30045354: {RegionID: 10000069, SystemID: 30045354, SystemName: "Reitsato"},
 	30100000: {RegionID: 10001000, SystemID: 30100000, SystemName: "Zarzakh"},
-	31000001: {RegionID: 11000033, SystemID: 31000001, SystemName: "J055520"},
-	31000002: {RegionID: 11000033, SystemID: 31000002, SystemName: "J110145"},
-	31000003: {RegionID: 11000033, SystemID: 31000003, SystemName: "J164710"},
-	31000004: {RegionID: 11000033, SystemID: 31000004, SystemName: "J200727"},
-	31000005: {RegionID: 11000031, SystemID: 31000005, SystemName: "Thera"},
-	31000006: {RegionID: 11000033, SystemID: 31000006, SystemName: "J174618"},
-	31000007: {RegionID: 11000001, SystemID: 31000007, SystemName: "J105443"},
-	31000008: {RegionID: 11000001, SystemID: 31000008, SystemName: "J100744"},
-	31000009: {RegionID: 11000001, SystemID: 31000009, SystemName: "J225046"},
-	31000010: {RegionID: 11000001, SystemID: 31000010, SystemName: "J160837"},
-	31000011: {RegionID: 11000001, SystemID: 31000011, SystemName: "J114700"},
-	31000012: {RegionID: 11000001, SystemID: 31000012, SystemName: "J134914"},
-	31000013: {RegionID: 11000001, SystemID: 31000013, SystemName: "J102655"},
-	31000014: {RegionID: 11000001, SystemID: 31000014, SystemName: "J134312"},
-	31000015: {RegionID: 11000001, SystemID: 31000015, SystemName: "J205818"},
-	31000016: {RegionID: 11000001, SystemID: 31000016, SystemName: "J113434"},
-	31000017: {RegionID: 11000001, SystemID: 31000017, SystemName: "J105711"},
-	31000018: {RegionID: 11000001, SystemID: 31000018, SystemName: "J164218"},
-	31000019: {RegionID: 11000001, SystemID: 31000019, SystemName: "J154535"},
-	31000020: {RegionID: 11000001, SystemID: 31000020, SystemName: "J111301"},
-	31000021: {RegionID: 11000001, SystemID: 31000021, SystemName: "J135038"},
-	31000022: {RegionID: 11000001, SystemID: 31000022, SystemName: "J121358"},
-	31000023: {RegionID: 11000001, SystemID: 31000023, SystemName: "J222914"},
-	31000024: {RegionID: 11000001, SystemID: 31000024, SystemName: "J155429"},
-	31000025: {RegionID: 11000001, SystemID: 31000025, SystemName: "J204640"},
-	31000026: {RegionID: 11000001, SystemID: 31000026, SystemName: "J162604"},
-	31000027: {RegionID: 11000001, SystemID: 31000027, SystemName: "J164807"},
-	31000028: {RegionID: 11000001, SystemID: 31000028, SystemName: "J233317"},
-	31000029: {RegionID: 11000001, SystemID: 31000029, SystemName: "J155023"},
-	31000030: {RegionID: 11000001, SystemID: 31000030, SystemName: "J112628"},
-	31000031: {RegionID: 11000001, SystemID: 31000031, SystemName: "J153001"},
-	31000032: {RegionID: 11000001, SystemID: 31000032, SystemName: "J143204"},
-	31000033: {RegionID: 11000001, SystemID: 31000033, SystemName: "J101729"},
-	31000034: {RegionID: 11000001, SystemID: 31000034, SystemName: "J221203"},
-	31000035: {RegionID: 11000001, SystemID: 31000035, SystemName: "J125428"},
-	31000036: {RegionID: 11000001, SystemID: 31000036, SystemName: "J131854"},
-	31000037: {RegionID: 11000001, SystemID: 31000037, SystemName: "J160534"},
-	31000038: {RegionID: 11000001, SystemID: 31000038, SystemName: "J144855"},
-	31000039: {RegionID: 11000001, SystemID: 31000039, SystemName: "J101453"},
-	31000040: {RegionID: 11000001, SystemID: 31000040, SystemName: "J144913"},
-	31000041: {RegionID: 11000001, SystemID: 31000041, SystemName: "J144530"},
-	31000042: {RegionID: 11000001, SystemID: 31000042, SystemName: "J135411"},
-	31000043: {RegionID: 11000001, SystemID: 31000043, SystemName: "J125713"},
-	31000044: {RegionID: 11000001, SystemID: 31000044, SystemName: "J105521"},
-	31000045: {RegionID: 11000001, SystemID: 31000045, SystemName: "J224324"},
-	31000046: {RegionID: 11000001, SystemID: 31000046, SystemName: "J163203"},
-	31000047: {RegionID: 11000001, SystemID: 31000047, SystemName: "J171818"},
-	31000048: {RegionID: 11000001, SystemID: 31000048, SystemName: "J145337"},
-	31000049: {RegionID: 11000001, SystemID: 31000049, SystemName: "J150131"},
-	31000050: {RegionID: 11000001, SystemID: 31000050, SystemName: "J130842"},
-	31000051: {RegionID: 11000001, SystemID: 31000051, SystemName: "J145406"},
-	31000052: {RegionID: 11000001, SystemID: 31000052, SystemName: "J103404"},
-	31000053: {RegionID: 11000001, SystemID: 31000053, SystemName: "J112124"},
-	31000054: {RegionID: 11000001, SystemID: 31000054, SystemName: "J124926"},
-	31000055: {RegionID: 11000001, SystemID: 31000055, SystemName: "J232605"},
-	31000056: {RegionID: 11000001, SystemID: 31000056, SystemName: "J144938"},
-	31000057: {RegionID: 11000001, SystemID: 31000057, SystemName: "J233828"},
-	31000058: {RegionID: 11000001, SystemID: 31000058, SystemName: "J130714"},
-	31000059: {RegionID: 11000001, SystemID: 31000059, SystemName: "J142701"},
-	31000060: {RegionID: 11000001, SystemID: 31000060, SystemName: "J154226"},
-	31000061: {RegionID: 11000001, SystemID: 31000061, SystemName: "J125843"},
-	31000062: {RegionID: 11000001, SystemID: 31000062, SystemName: "J155459"},
-	31000063: {RegionID: 11000001, SystemID: 31000063, SystemName: "J225555"},
-	31000064: {RegionID: 11000001, SystemID: 31000064, SystemName: "J115549"},
-	31000065: {RegionID: 11000001, SystemID: 31000065, SystemName: "J140741"},
-	31000066: {RegionID: 11000001, SystemID: 31000066, SystemName: "J104140"},
-	31000067: {RegionID: 11000001, SystemID: 31000067, SystemName: "J101757"},
-	31000068: {RegionID: 11000001, SystemID: 31000068, SystemName: "J100250"},
-	31000069: {RegionID: 11000001, SystemID: 31000069, SystemName: "J113721"},
-	31000070: {RegionID: 11000001, SystemID: 31000070, SystemName: "J105039"},
-	31000071: {RegionID: 11000001, SystemID: 31000071, SystemName: "J233550"},
-	31000072: {RegionID: 11000001, SystemID: 31000072, SystemName: "J114033"},
-	31000073: {RegionID: 11000001, SystemID: 31000073, SystemName: "J105936"},
-	31000074: {RegionID: 11000001, SystemID: 31000074, SystemName: "J140121"},
-	31000075: {RegionID: 11000001, SystemID: 31000075, SystemName: "J154029"},
-	31000076: {RegionID: 11000001, SystemID: 31000076, SystemName: "J101336"},
-	31000077: {RegionID: 11000001, SystemID: 31000077, SystemName: "J160039"},
-	31000078: {RegionID: 11000001, SystemID: 31000078, SystemName: "J161257"},
-	31000079: {RegionID: 11000001, SystemID: 31000079, SystemName: "J144303"},
-	31000080: {RegionID: 11000001, SystemID: 31000080, SystemName: "J121131"},
-	31000081: {RegionID: 11000001, SystemID: 31000081, SystemName: "J113653"},
-	31000082: {RegionID: 11000001, SystemID: 31000082, SystemName: "J123454"},
-	31000083: {RegionID: 11000001, SystemID: 31000083, SystemName: "J110750"},
-	31000084: {RegionID: 11000001, SystemID: 31000084, SystemName: "J151106"},
-	31000085: {RegionID: 11000001, SystemID: 31000085, SystemName: "J100040"},
-	31000086: {RegionID: 11000001, SystemID: 31000086, SystemName: "J223733"},
-	31000087: {RegionID: 11000001, SystemID: 31000087, SystemName: "J110431"},
-	31000088: {RegionID: 11000001, SystemID: 31000088, SystemName: "J134446"},
-	31000089: {RegionID: 11000001, SystemID: 31000089, SystemName: "J143202"},
-	31000090: {RegionID: 11000001, SystemID: 31000090, SystemName: "J210436"},
-	31000091: {RegionID: 11000001, SystemID: 31000091, SystemName: "J133030"},
-	31000092: {RegionID: 11000001, SystemID: 31000092, SystemName: "J170645"},
-	31000093: {RegionID: 11000001, SystemID: 31000093, SystemName: "J103151"},
-	31000094: {RegionID: 11000001, SystemID: 31000094, SystemName: "J222206"},
-	31000095: {RegionID: 11000001, SystemID: 31000095, SystemName: "J130602"},
-	31000096: {RegionID: 11000001, SystemID: 31000096, SystemName: "J123708"},
-	31000097: {RegionID: 11000001, SystemID: 31000097, SystemName: "J123831"},
-	31000098: {RegionID: 11000001, SystemID: 31000098, SystemName: "J164927"},
-	31000099: {RegionID: 11000001, SystemID: 31000099, SystemName: "J134143"},
-	31000100: {RegionID: 11000001, SystemID: 31000100, SystemName: "J214534"},
-	31000101: {RegionID: 11000001, SystemID: 31000101, SystemName: "J135250"},
-	31000102: {RegionID: 11000001, SystemID: 31000102, SystemName: "J111707"},
-	31000103: {RegionID: 11000001, SystemID: 31000103, SystemName: "J135245"},
-	31000104: {RegionID: 11000001, SystemID: 31000104, SystemName: "J101408"},
-	31000105: {RegionID: 11000001, SystemID: 31000105, SystemName: "J215117"},
-	31000106: {RegionID: 11000001, SystemID: 31000106, SystemName: "J141019"},
-	31000107: {RegionID: 11000001, SystemID: 31000107, SystemName: "J102206"},
-	31000108: {RegionID: 11000001, SystemID: 31000108, SystemName: "J125903"},
-	31000109: {RegionID: 11000001, SystemID: 31000109, SystemName: "J134939"},
-	31000110: {RegionID: 11000001, SystemID: 31000110, SystemName: "J162641"},
-	31000111: {RegionID: 11000001, SystemID: 31000111, SystemName: "J152537"},
-	31000112: {RegionID: 11000001, SystemID: 31000112, SystemName: "J224442"},
-	31000113: {RegionID: 11000001, SystemID: 31000113, SystemName: "J104138"},
-	31000114: {RegionID: 11000001, SystemID: 31000114, SystemName: "J121516"},
-	31000115: {RegionID: 11000001, SystemID: 31000115, SystemName: "J114540"},
-	31000116: {RegionID: 11000001, SystemID: 31000116, SystemName: "J155013"},
-	31000117: {RegionID: 11000001, SystemID: 31000117, SystemName: "J144450"},
-	31000118: {RegionID: 11000001, SystemID: 31000118, SystemName: "J141239"},
-	31000119: {RegionID: 11000001, SystemID: 31000119, SystemName: "J115545"},
-	31000120: {RegionID: 11000001, SystemID: 31000120, SystemName: "J114905"},
-	31000121: {RegionID: 11000001, SystemID: 31000121, SystemName: "J120522"},
-	31000122: {RegionID: 11000001, SystemID: 31000122, SystemName: "J172907"},
-	31000123: {RegionID: 11000001, SystemID: 31000123, SystemName: "J164550"},
-	31000124: {RegionID: 11000001, SystemID: 31000124, SystemName: "J141812"},
-	31000125: {RegionID: 11000001, SystemID: 31000125, SystemName: "J134637"},
-	31000126: {RegionID: 11000001, SystemID: 31000126, SystemName: "J134132"},
-	31000127: {RegionID: 11000001, SystemID: 31000127, SystemName: "J125011"},
-	31000128: {RegionID: 11000001, SystemID: 31000128, SystemName: "J105942"},
-	31000129: {RegionID: 11000001, SystemID: 31000129, SystemName: "J171653"},
-	31000130: {RegionID: 11000001, SystemID: 31000130, SystemName: "J110213"},
-	31000131: {RegionID: 11000001, SystemID: 31000131, SystemName: "J115048"},
-	31000132: {RegionID: 11000001, SystemID: 31000132, SystemName: "J174405"},
-	31000133: {RegionID: 11000001, SystemID: 31000133, SystemName: "J123111"},
-	31000134: {RegionID: 11000001, SystemID: 31000134, SystemName: "J123047"},
-	31000135: {RegionID: 11000001, SystemID: 31000135, SystemName: "J231541"},
-	31000136: {RegionID: 11000001, SystemID: 31000136, SystemName: "J215900"},
-	31000137: {RegionID: 11000001, SystemID: 31000137, SystemName: "J110651"},
-	31000138: {RegionID: 11000001, SystemID: 31000138, SystemName: "J140602"},
-	31000139: {RegionID: 11000001, SystemID: 31000139, SystemName: "J163804"},
-	31000140: {RegionID: 11000002, SystemID: 31000140, SystemName: "J125209"},
-	31000141: {RegionID: 11000002, SystemID: 31000141, SystemName: "J140831"},
-	31000142: {RegionID: 11000002, SystemID: 31000142, SystemName: "J161811"},
-	31000143: {RegionID: 11000002, SystemID: 31000143, SystemName: "J112913"},
-	31000144: {RegionID: 11000002, SystemID: 31000144, SystemName: "J105632"},
-	31000145: {RegionID: 11000002, SystemID: 31000145, SystemName: "J160710"},
-	31000146: {RegionID: 11000002, SystemID: 31000146, SystemName: "J160835"},
-	31000147: {RegionID: 11000002, SystemID: 31000147, SystemName: "J122659"},
-	31000148: {RegionID: 11000002, SystemID: 31000148, SystemName: "J130931"},
-	31000149: {RegionID: 11000002, SystemID: 31000149, SystemName: "J161115"},
-	31000150: {RegionID: 11000002, SystemID: 31000150, SystemName: "J120734"},
-	31000151: {RegionID: 11000002, SystemID: 31000151, SystemName: "J110906"},
-	31000152: {RegionID: 11000002, SystemID: 31000152, SystemName: "J121418"},
-	31000153: {RegionID: 11000002, SystemID: 31000153, SystemName: "J113820"},
-	31000154: {RegionID: 11000002, SystemID: 31000154, SystemName: "J113506"},
-	31000155: {RegionID: 11000002, SystemID: 31000155, SystemName: "J172240"},
-	31000156: {RegionID: 11000002, SystemID: 31000156, SystemName: "J110101"},
-	31000157: {RegionID: 11000002, SystemID: 31000157, SystemName: "J110108"},
-	31000158: {RegionID: 11000002, SystemID: 31000158, SystemName: "J213555"},
-	31000159: {RegionID: 11000002, SystemID: 31000159, SystemName: "J144704"},
-	31000160: {RegionID: 11000002, SystemID: 31000160, SystemName: "J115815"},
-	31000161: {RegionID: 11000002, SystemID: 31000161, SystemName: "J133653"},
-	31000162: {RegionID: 11000002, SystemID: 31000162, SystemName: "J171700"},
-	31000163: {RegionID: 11000002, SystemID: 31000163, SystemName: "J104628"},
-	31000164: {RegionID: 11000002, SystemID: 31000164, SystemName: "J114546"},
-	31000165: {RegionID: 11000002, SystemID: 31000165, SystemName: "J235419"},
-	31000166: {RegionID: 11000002, SystemID: 31000166, SystemName: "J204503"},
-	31000167: {RegionID: 11000002, SystemID: 31000167, SystemName: "J215417"},
-	31000168: {RegionID: 11000002, SystemID: 31000168, SystemName: "J110545"},
-	31000169: {RegionID: 11000002, SystemID: 31000169, SystemName: "J153536"},
-	31000170: {RegionID: 11000002, SystemID: 31000170, SystemName: "J150407"},
-	31000171: {RegionID: 11000002, SystemID: 31000171, SystemName: "J153530"},
-	31000172: {RegionID: 11000002, SystemID: 31000172, SystemName: "J131232"},
-	31000173: {RegionID: 11000002, SystemID: 31000173, SystemName: "J101020"},
-	31000174: {RegionID: 11000002, SystemID: 31000174, SystemName: "J133613"},
-	31000175: {RegionID: 11000002, SystemID: 31000175, SystemName: "J165901"},
-	31000176: {RegionID: 11000002, SystemID: 31000176, SystemName: "J152006"},
-	31000177: {RegionID: 11000002, SystemID: 31000177, SystemName: "J160345"},
-	31000178: {RegionID: 11000002, SystemID: 31000178, SystemName: "J134330"},
-	31000179: {RegionID: 11000002, SystemID: 31000179, SystemName: "J213342"},
-	31000180: {RegionID: 11000002, SystemID: 31000180, SystemName: "J150745"},
-	31000181: {RegionID: 11000002, SystemID: 31000181, SystemName: "J165056"},
-	31000182: {RegionID: 11000002, SystemID: 31000182, SystemName: "J150827"},
-	31000183: {RegionID: 11000002, SystemID: 31000183, SystemName: "J144632"},
-	31000184: {RegionID: 11000002, SystemID: 31000184, SystemName: "J102630"},
-	31000185: {RegionID: 11000002, SystemID: 31000185, SystemName: "J133335"},
-	31000186: {RegionID: 11000002, SystemID: 31000186, SystemName: "J125925"},
-	31000187: {RegionID: 11000002, SystemID: 31000187, SystemName: "J142617"},
-	31000188: {RegionID: 11000002, SystemID: 31000188, SystemName: "J150818"},
-	31000189: {RegionID: 11000002, SystemID: 31000189, SystemName: "J114420"},
-	31000190: {RegionID: 11000002, SystemID: 31000190, SystemName: "J112250"},
-	31000191: {RegionID: 11000002, SystemID: 31000191, SystemName: "J155935"},
-	31000192: {RegionID: 11000002, SystemID: 31000192, SystemName: "J115738"},
-	31000193: {RegionID: 11000002, SystemID: 31000193, SystemName: "J232715"},
-	31000194: {RegionID: 11000002, SystemID: 31000194, SystemName: "J110051"},
-	31000195: {RegionID: 11000002, SystemID: 31000195, SystemName: "J152928"},
-	31000196: {RegionID: 11000002, SystemID: 31000196, SystemName: "J132216"},
-	31000197: {RegionID: 11000002, SystemID: 31000197, SystemName: "J132758"},
-	31000198: {RegionID: 11000002, SystemID: 31000198, SystemName: "J134145"},
-	31000199: {RegionID: 11000002, SystemID: 31000199, SystemName: "J102849"},
-	31000200: {RegionID: 11000002, SystemID: 31000200, SystemName: "J120335"},
-	31000201: {RegionID: 11000002, SystemID: 31000201, SystemName: "J104439"},
-	31000202: {RegionID: 11000002, SystemID: 31000202, SystemName: "J122717"},
-	31000203: {RegionID: 11000002, SystemID: 31000203, SystemName: "J101817"},
-	31000204: {RegionID: 11000002, SystemID: 31000204, SystemName: "J131551"},
-	31000205: {RegionID: 11000002, SystemID: 31000205, SystemName: "J115200"},
-	31000206: {RegionID: 11000002, SystemID: 31000206, SystemName: "J155029"},
-	31000207: {RegionID: 11000002, SystemID: 31000207, SystemName: "J223703"},
-	31000208: {RegionID: 11000002, SystemID: 31000208, SystemName: "J233359"},
-	31000209: {RegionID: 11000002, SystemID: 31000209, SystemName: "J114107"},
-	31000210: {RegionID: 11000002, SystemID: 31000210, SystemName: "J152014"},
-	31000211: {RegionID: 11000002, SystemID: 31000211, SystemName: "J100211"},
-	31000212: {RegionID: 11000002, SystemID: 31000212, SystemName: "J104103"},
-	31000213: {RegionID: 11000002, SystemID: 31000213, SystemName: "J171019"},
-	31000214: {RegionID: 11000002, SystemID: 31000214, SystemName: "J114313"},
-	31000215: {RegionID: 11000002, SystemID: 31000215, SystemName: "J130542"},
-	31000216: {RegionID: 11000002, SystemID: 31000216, SystemName: "J114719"},
-	31000217: {RegionID: 11000002, SystemID: 31000217, SystemName: "J130322"},
-	31000218: {RegionID: 11000002, SystemID: 31000218, SystemName: "J161524"},
-	31000219: {RegionID: 11000002, SystemID: 31000219, SystemName: "J162858"},
-	31000220: {RegionID: 11000002, SystemID: 31000220, SystemName: "J121158"},
-	31000221: {RegionID: 11000002, SystemID: 31000221, SystemName: "J113050"},
-	31000222: {RegionID: 11000002, SystemID: 31000222, SystemName: "J141514"},
-	31000223: {RegionID: 11000002, SystemID: 31000223, SystemName: "J220654"},
-	31000224: {RegionID: 11000002, SystemID: 31000224, SystemName: "J162118"},
-	31000225: {RegionID: 11000002, SystemID: 31000225, SystemName: "J231004"},
-	31000226: {RegionID: 11000002, SystemID: 31000226, SystemName: "J120621"},
-	31000227: {RegionID: 11000002, SystemID: 31000227, SystemName: "J151601"},
-	31000228: {RegionID: 11000002, SystemID: 31000228, SystemName: "J105837"},
-	31000229: {RegionID: 11000002, SystemID: 31000229, SystemName: "J143133"},
-	31000230: {RegionID: 11000002, SystemID: 31000230, SystemName: "J100422"},
-	31000231: {RegionID: 11000002, SystemID: 31000231, SystemName: "J104335"},
-	31000232: {RegionID: 11000002, SystemID: 31000232, SystemName: "J105700"},
-	31000233: {RegionID: 11000002, SystemID: 31000233, SystemName: "J215431"},
-	31000234: {RegionID: 11000002, SystemID: 31000234, SystemName: "J143628"},
-	31000235: {RegionID: 11000002, SystemID: 31000235, SystemName: "J141150"},
-	31000236: {RegionID: 11000002, SystemID: 31000236, SystemName: "J163743"},
-	31000237: {RegionID: 11000002, SystemID: 31000237, SystemName: "J161509"},
-	31000238: {RegionID: 11000002, SystemID: 31000238, SystemName: "J150629"},
-	31000239: {RegionID: 11000002, SystemID: 31000239, SystemName: "J141017"},
-	31000240: {RegionID: 11000002, SystemID: 31000240, SystemName: "J130045"},
-	31000241: {RegionID: 11000002, SystemID: 31000241, SystemName: "J123748"},
-	31000242: {RegionID: 11000002, SystemID: 31000242, SystemName: "J124051"},
-	31000243: {RegionID: 11000002, SystemID: 31000243, SystemName: "J151141"},
-	31000244: {RegionID: 11000002, SystemID: 31000244, SystemName: "J112129"},
-	31000245: {RegionID: 11000002, SystemID: 31000245, SystemName: "J213653"},
-	31000246: {RegionID: 11000002, SystemID: 31000246, SystemName: "J143649"},
-	31000247: {RegionID: 11000002, SystemID: 31000247, SystemName: "J223855"},
-	31000248: {RegionID: 11000002, SystemID: 31000248, SystemName: "J140019"},
-	31000249: {RegionID: 11000002, SystemID: 31000249, SystemName: "J110605"},
-	31000250: {RegionID: 11000002, SystemID: 31000250, SystemName: "J122843"},
-	31000251: {RegionID: 11000002, SystemID: 31000251, SystemName: "J205004"},
-	31000252: {RegionID: 11000002, SystemID: 31000252, SystemName: "J122114"},
-	31000253: {RegionID: 11000002, SystemID: 31000253, SystemName: "J163533"},
-	31000254: {RegionID: 11000002, SystemID: 31000254, SystemName: "J151518"},
-	31000255: {RegionID: 11000002, SystemID: 31000255, SystemName: "J122712"},
-	31000256: {RegionID: 11000002, SystemID: 31000256, SystemName: "J143002"},
-	31000257: {RegionID: 11000002, SystemID: 31000257, SystemName: "J160307"},
-	31000258: {RegionID: 11000002, SystemID: 31000258, SystemName: "J213932"},
-	31000259: {RegionID: 11000002, SystemID: 31000259, SystemName: "J102414"},
-	31000260: {RegionID: 11000002, SystemID: 31000260, SystemName: "J170949"},
-	31000261: {RegionID: 11000002, SystemID: 31000261, SystemName: "J235001"},
-	31000262: {RegionID: 11000002, SystemID: 31000262, SystemName: "J103320"},
-	31000263: {RegionID: 11000002, SystemID: 31000263, SystemName: "J141038"},
-	31000264: {RegionID: 11000002, SystemID: 31000264, SystemName: "J172003"},
-	31000265: {RegionID: 11000002, SystemID: 31000265, SystemName: "J124215"},
-	31000266: {RegionID: 11000002, SystemID: 31000266, SystemName: "J215754"},
-	31000267: {RegionID: 11000002, SystemID: 31000267, SystemName: "J102837"},
-	31000268: {RegionID: 11000002, SystemID: 31000268, SystemName: "J130155"},
-	31000269: {RegionID: 11000002, SystemID: 31000269, SystemName: "J170544"},
-	31000270: {RegionID: 11000002, SystemID: 31000270, SystemName: "J142649"},
-	31000271: {RegionID: 11000002, SystemID: 31000271, SystemName: "J150539"},
-	31000272: {RegionID: 11000002, SystemID: 31000272, SystemName: "J163156"},
-	31000273: {RegionID: 11000002, SystemID: 31000273, SystemName: "J120338"},
-	31000274: {RegionID: 11000002, SystemID: 31000274, SystemName: "J142941"},
-	31000275: {RegionID: 11000002, SystemID: 31000275, SystemName: "J110126"},
-	31000276: {RegionID: 11000002, SystemID: 31000276, SystemName: "J155504"},
-	31000277: {RegionID: 11000002, SystemID: 31000277, SystemName: "J144228"},
-	31000278: {RegionID: 11000002, SystemID: 31000278, SystemName: "J223650"},
-	31000279: {RegionID: 11000002, SystemID: 31000279, SystemName: "J102734"},
-	31000280: {RegionID: 11000002, SystemID: 31000280, SystemName: "J105232"},
-	31000281: {RegionID: 11000002, SystemID: 31000281, SystemName: "J133128"},
-	31000282: {RegionID: 11000002, SystemID: 31000282, SystemName: "J140135"},
-	31000283: {RegionID: 11000002, SystemID: 31000283, SystemName: "J115216"},
-	31000284: {RegionID: 11000002, SystemID: 31000284, SystemName: "J153311"},
-	31000285: {RegionID: 11000002, SystemID: 31000285, SystemName: "J164756"},
-	31000286: {RegionID: 11000002, SystemID: 31000286, SystemName: "J165216"},
-	31000287: {RegionID: 11000002, SystemID: 31000287, SystemName: "J114914"},
-	31000288: {RegionID: 11000002, SystemID: 31000288, SystemName: "J212504"},
-	31000289: {RegionID: 11000002, SystemID: 31000289, SystemName: "J120256"},
-	31000290: {RegionID: 11000002, SystemID: 31000290, SystemName: "J143421"},
-	31000291: {RegionID: 11000002, SystemID: 31000291, SystemName: "J223026"},
-	31000292: {RegionID: 11000002, SystemID: 31000292, SystemName: "J102918"},
-	31000293: {RegionID: 11000003, SystemID: 31000293, SystemName: "J142136"},
-	31000294: {RegionID: 11000003, SystemID: 31000294, SystemName: "J134540"},
-	31000295: {RegionID: 11000003, SystemID: 31000295, SystemName: "J124451"},
-	31000296: {RegionID: 11000003, SystemID: 31000296, SystemName: "J102749"},
-	31000297: {RegionID: 11000003, SystemID: 31000297, SystemName: "J165741"},
-	31000298: {RegionID: 11000003, SystemID: 31000298, SystemName: "J142119"},
-	31000299: {RegionID: 11000003, SystemID: 31000299, SystemName: "J105642"},
-	31000300: {RegionID: 11000003, SystemID: 31000300, SystemName: "J154354"},
-	31000301: {RegionID: 11000003, SystemID: 31000301, SystemName: "J102005"},
-	31000302: {RegionID: 11000003, SystemID: 31000302, SystemName: "J133052"},
-	31000303: {RegionID: 11000003, SystemID: 31000303, SystemName: "J103341"},
-	31000304: {RegionID: 11000003, SystemID: 31000304, SystemName: "J142114"},
-	31000305: {RegionID: 11000003, SystemID: 31000305, SystemName: "J152353"},
-	31000306: {RegionID: 11000003, SystemID: 31000306, SystemName: "J120431"},
-	31000307: {RegionID: 11000003, SystemID: 31000307, SystemName: "J103547"},
-	31000308: {RegionID: 11000003, SystemID: 31000308, SystemName: "J161107"},
-	31000309: {RegionID: 11000003, SystemID: 31000309, SystemName: "J160334"},
-	31000310: {RegionID: 11000003, SystemID: 31000310, SystemName: "J101845"},
-	31000311: {RegionID: 11000003, SystemID: 31000311, SystemName: "J171805"},
-	31000312: {RegionID: 11000003, SystemID: 31000312, SystemName: "J134610"},
-	31000313: {RegionID: 11000003, SystemID: 31000313, SystemName: "J173842"},
-	31000314: {RegionID: 11000003, SystemID: 31000314, SystemName: "J155203"},
-	31000315: {RegionID: 11000003, SystemID: 31000315, SystemName: "J121935"},
-	31000316: {RegionID: 11000003, SystemID: 31000316, SystemName: "J105013"},
-	31000317: {RegionID: 11000003, SystemID: 31000317, SystemName: "J161846"},
-	31000318: {RegionID: 11000003, SystemID: 31000318, SystemName: "J113632"},
-	31000319: {RegionID: 11000003, SystemID: 31000319, SystemName: "J170106"},
-	31000320: {RegionID: 11000003, SystemID: 31000320, SystemName: "J123828"},
-	31000321: {RegionID: 11000003, SystemID: 31000321, SystemName: "J172147"},
-	31000322: {RegionID: 11000003, SystemID: 31000322, SystemName: "J164759"},
-	31000323: {RegionID: 11000003, SystemID: 31000323, SystemName: "J212957"},
-	31000324: {RegionID: 11000003, SystemID: 31000324, SystemName: "J212338"},
-	31000325: {RegionID: 11000003, SystemID: 31000325, SystemName: "J115823"},
-	31000326: {RegionID: 11000003, SystemID: 31000326, SystemName: "J112744"},
-	31000327: {RegionID: 11000003, SystemID: 31000327, SystemName: "J121941"},
-	31000328: {RegionID: 11000003, SystemID: 31000328, SystemName: "J125650"},
-	31000329: {RegionID: 11000003, SystemID: 31000329, SystemName: "J161344"},
-	31000330: {RegionID: 11000003, SystemID: 31000330, SystemName: "J134833"},
-	31000331: {RegionID: 11000003, SystemID: 31000331, SystemName: "J143517"},
-	31000332: {RegionID: 11000003, SystemID: 31000332, SystemName: "J133245"},
-	31000333: {RegionID: 11000003, SystemID: 31000333, SystemName: "J113918"},
-	31000334: {RegionID: 11000003, SystemID: 31000334, SystemName: "J161644"},
-	31000335: {RegionID: 11000003, SystemID: 31000335, SystemName: "J152257"},
-	31000336: {RegionID: 11000003, SystemID: 31000336, SystemName: "J155833"},
-	31000337: {RegionID: 11000003, SystemID: 31000337, SystemName: "J151332"},
-	31000338: {RegionID: 11000003, SystemID: 31000338, SystemName: "J231306"},
-	31000339: {RegionID: 11000003, SystemID: 31000339, SystemName: "J171312"},
-	31000340: {RegionID: 11000003, SystemID: 31000340, SystemName: "J112406"},
-	31000341: {RegionID: 11000003, SystemID: 31000341, SystemName: "J212904"},
-	31000342: {RegionID: 11000003, SystemID: 31000342, SystemName: "J111811"},
-	31000343: {RegionID: 11000003, SystemID: 31000343, SystemName: "J104201"},
-	31000344: {RegionID: 11000003, SystemID: 31000344, SystemName: "J150216"},
-	31000345: {RegionID: 11000003, SystemID: 31000345, SystemName: "J112934"},
-	31000346: {RegionID: 11000003, SystemID: 31000346, SystemName: "J115808"},
-	31000347: {RegionID: 11000003, SystemID: 31000347, SystemName: "J121915"},
-	31000348: {RegionID: 11000003, SystemID: 31000348, SystemName: "J133259"},
-	31000349: {RegionID: 11000003, SystemID: 31000349, SystemName: "J150325"},
-	31000350: {RegionID: 11000003, SystemID: 31000350, SystemName: "J122224"},
-	31000351: {RegionID: 11000003, SystemID: 31000351, SystemName: "J131702"},
-	31000352: {RegionID: 11000003, SystemID: 31000352, SystemName: "J141807"},
-	31000353: {RegionID: 11000003, SystemID: 31000353, SystemName: "J142327"},
-	31000354: {RegionID: 11000003, SystemID: 31000354, SystemName: "J144822"},
-	31000355: {RegionID: 11000004, SystemID: 31000355, SystemName: "J164417"},
-	31000356: {RegionID: 11000004, SystemID: 31000356, SystemName: "J125227"},
-	31000357: {RegionID: 11000004, SystemID: 31000357, SystemName: "J113057"},
-	31000358: {RegionID: 11000004, SystemID: 31000358, SystemName: "J101524"},
-	31000359: {RegionID: 11000004, SystemID: 31000359, SystemName: "J124508"},
-	31000360: {RegionID: 11000004, SystemID: 31000360, SystemName: "J141156"},
-	31000361: {RegionID: 11000004, SystemID: 31000361, SystemName: "J222822"},
-	31000362: {RegionID: 11000004, SystemID: 31000362, SystemName: "J104253"},
-	31000363: {RegionID: 11000004, SystemID: 31000363, SystemName: "J144153"},
-	31000364: {RegionID: 11000004, SystemID: 31000364, SystemName: "J103406"},
-	31000365: {RegionID: 11000004, SystemID: 31000365, SystemName: "J133210"},
-	31000366: {RegionID: 11000004, SystemID: 31000366, SystemName: "J111740"},
-	31000367: {RegionID: 11000004, SystemID: 31000367, SystemName: "J125956"},
-	31000368: {RegionID: 11000004, SystemID: 31000368, SystemName: "J105951"},
-	31000369: {RegionID: 11000004, SystemID: 31000369, SystemName: "J141857"},
-	31000370: {RegionID: 11000004, SystemID: 31000370, SystemName: "J120726"},
-	31000371: {RegionID: 11000004, SystemID: 31000371, SystemName: "J155737"},
-	31000372: {RegionID: 11000004, SystemID: 31000372, SystemName: "J144605"},
-	31000373: {RegionID: 11000004, SystemID: 31000373, SystemName: "J144218"},
-	31000374: {RegionID: 11000004, SystemID: 31000374, SystemName: "J114835"},
-	31000375: {RegionID: 11000004, SystemID: 31000375, SystemName: "J134006"},
-	31000376: {RegionID: 11000004, SystemID: 31000376, SystemName: "J160941"},
-	31000377: {RegionID: 11000004, SystemID: 31000377, SystemName: "J111557"},
-	31000378: {RegionID: 11000004, SystemID: 31000378, SystemName: "J124219"},
-	31000379: {RegionID: 11000004, SystemID: 31000379, SystemName: "J155620"},
-	31000380: {RegionID: 11000004, SystemID: 31000380, SystemName: "J114346"},
-	31000381: {RegionID: 11000004, SystemID: 31000381, SystemName: "J153532"},
-	31000382: {RegionID: 11000004, SystemID: 31000382, SystemName: "J211936"},
-	31000383: {RegionID: 11000004, SystemID: 31000383, SystemName: "J211036"},
-	31000384: {RegionID: 11000004, SystemID: 31000384, SystemName: "J145805"},
-	31000385: {RegionID: 11000004, SystemID: 31000385, SystemName: "J151548"},
-	31000386: {RegionID: 11000004, SystemID: 31000386, SystemName: "J160412"},
-	31000387: {RegionID: 11000004, SystemID: 31000387, SystemName: "J104008"},
-	31000388: {RegionID: 11000004, SystemID: 31000388, SystemName: "J145715"},
-	31000389: {RegionID: 11000004, SystemID: 31000389, SystemName: "J235321"},
-	31000390: {RegionID: 11000004, SystemID: 31000390, SystemName: "J103339"},
-	31000391: {RegionID: 11000004, SystemID: 31000391, SystemName: "J132009"},
-	31000392: {RegionID: 11000004, SystemID: 31000392, SystemName: "J210548"},
-	31000393: {RegionID: 11000004, SystemID: 31000393, SystemName: "J235408"},
-	31000394: {RegionID: 11000004, SystemID: 31000394, SystemName: "J122821"},
-	31000395: {RegionID: 11000004, SystemID: 31000395, SystemName: "J121925"},
-	31000396: {RegionID: 11000004, SystemID: 31000396, SystemName: "J151047"},
-	31000397: {RegionID: 11000004, SystemID: 31000397, SystemName: "J111011"},
-	31000398: {RegionID: 11000004, SystemID: 31000398, SystemName: "J154212"},
-	31000399: {RegionID: 11000004, SystemID: 31000399, SystemName: "J130256"},
-	31000400: {RegionID: 11000004, SystemID: 31000400, SystemName: "J113230"},
-	31000401: {RegionID: 11000004, SystemID: 31000401, SystemName: "J132557"},
-	31000402: {RegionID: 11000004, SystemID: 31000402, SystemName: "J233534"},
-	31000403: {RegionID: 11000004, SystemID: 31000403, SystemName: "J232801"},
-	31000404: {RegionID: 11000004, SystemID: 31000404, SystemName: "J153627"},
-	31000405: {RegionID: 11000004, SystemID: 31000405, SystemName: "J134800"},
-	31000406: {RegionID: 11000004, SystemID: 31000406, SystemName: "J111508"},
-	31000407: {RegionID: 11000004, SystemID: 31000407, SystemName: "J143639"},
-	31000408: {RegionID: 11000004, SystemID: 31000408, SystemName: "J160753"},
-	31000409: {RegionID: 11000004, SystemID: 31000409, SystemName: "J151231"},
-	31000410: {RegionID: 11000004, SystemID: 31000410, SystemName: "J142547"},
-	31000411: {RegionID: 11000004, SystemID: 31000411, SystemName: "J142306"},
-	31000412: {RegionID: 11000004, SystemID: 31000412, SystemName: "J210422"},
-	31000413: {RegionID: 11000004, SystemID: 31000413, SystemName: "J112137"},
-	31000414: {RegionID: 11000004, SystemID: 31000414, SystemName: "J160715"},
-	31000415: {RegionID: 11000004, SystemID: 31000415, SystemName: "J145931"},
-	31000416: {RegionID: 11000004, SystemID: 31000416, SystemName: "J103217"},
-	31000417: {RegionID: 11000004, SystemID: 31000417, SystemName: "J143505"},
-	31000418: {RegionID: 11000004, SystemID: 31000418, SystemName: "J142533"},
-	31000419: {RegionID: 11000004, SystemID: 31000419, SystemName: "J112146"},
-	31000420: {RegionID: 11000004, SystemID: 31000420, SystemName: "J103839"},
-	31000421: {RegionID: 11000004, SystemID: 31000421, SystemName: "J152433"},
-	31000422: {RegionID: 11000004, SystemID: 31000422, SystemName: "J110408"},
-	31000423: {RegionID: 11000004, SystemID: 31000423, SystemName: "J205546"},
-	31000424: {RegionID: 11000004, SystemID: 31000424, SystemName: "J152801"},
-	31000425: {RegionID: 11000004, SystemID: 31000425, SystemName: "J120308"},
-	31000426: {RegionID: 11000004, SystemID: 31000426, SystemName: "J103948"},
-	31000427: {RegionID: 11000004, SystemID: 31000427, SystemName: "J112820"},
-	31000428: {RegionID: 11000004, SystemID: 31000428, SystemName: "J231837"},
-	31000429: {RegionID: 11000004, SystemID: 31000429, SystemName: "J110043"},
-	31000430: {RegionID: 11000004, SystemID: 31000430, SystemName: "J121454"},
-	31000431: {RegionID: 11000004, SystemID: 31000431, SystemName: "J230959"},
-	31000432: {RegionID: 11000004, SystemID: 31000432, SystemName: "J103408"},
-	31000433: {RegionID: 11000004, SystemID: 31000433, SystemName: "J162720"},
-	31000434: {RegionID: 11000004, SystemID: 31000434, SystemName: "J132418"},
-	31000435: {RegionID: 11000004, SystemID: 31000435, SystemName: "J164235"},
-	31000436: {RegionID: 11000004, SystemID: 31000436, SystemName: "J143429"},
-	31000437: {RegionID: 11000004, SystemID: 31000437, SystemName: "J134654"},
-	31000438: {RegionID: 11000004, SystemID: 31000438, SystemName: "J112801"},
-	31000439: {RegionID: 11000004, SystemID: 31000439, SystemName: "J110656"},
-	31000440: {RegionID: 11000004, SystemID: 31000440, SystemName: "J134951"},
-	31000441: {RegionID: 11000004, SystemID: 31000441, SystemName: "J155307"},
-	31000442: {RegionID: 11000004, SystemID: 31000442, SystemName: "J131204"},
-	31000443: {RegionID: 11000004, SystemID: 31000443, SystemName: "J213737"},
-	31000444: {RegionID: 11000004, SystemID: 31000444, SystemName: "J235117"},
-	31000445: {RegionID: 11000004, SystemID: 31000445, SystemName: "J164938"},
-	31000446: {RegionID: 11000004, SystemID: 31000446, SystemName: "J120310"},
-	31000447: {RegionID: 11000004, SystemID: 31000447, SystemName: "J144838"},
-	31000448: {RegionID: 11000004, SystemID: 31000448, SystemName: "J101307"},
-	31000449: {RegionID: 11000004, SystemID: 31000449, SystemName: "J172354"},
-	31000450: {RegionID: 11000004, SystemID: 31000450, SystemName: "J235447"},
-	31000451: {RegionID: 11000004, SystemID: 31000451, SystemName: "J124023"},
-	31000452: {RegionID: 11000004, SystemID: 31000452, SystemName: "J171424"},
-	31000453: {RegionID: 11000004, SystemID: 31000453, SystemName: "J150921"},
-	31000454: {RegionID: 11000004, SystemID: 31000454, SystemName: "J171518"},
-	31000455: {RegionID: 11000004, SystemID: 31000455, SystemName: "J140012"},
-	31000456: {RegionID: 11000004, SystemID: 31000456, SystemName: "J155616"},
-	31000457: {RegionID: 11000004, SystemID: 31000457, SystemName: "J234557"},
-	31000458: {RegionID: 11000004, SystemID: 31000458, SystemName: "J162700"},
-	31000459: {RegionID: 11000005, SystemID: 31000459, SystemName: "J103716"},
-	31000460: {RegionID: 11000005, SystemID: 31000460, SystemName: "J100640"},
-	31000461: {RegionID: 11000005, SystemID: 31000461, SystemName: "J151250"},
-	31000462: {RegionID: 11000005, SystemID: 31000462, SystemName: "J141332"},
-	31000463: {RegionID: 11000005, SystemID: 31000463, SystemName: "J211915"},
-	31000464: {RegionID: 11000005, SystemID: 31000464, SystemName: "J144546"},
-	31000465: {RegionID: 11000005, SystemID: 31000465, SystemName: "J145759"},
-	31000466: {RegionID: 11000005, SystemID: 31000466, SystemName: "J103422"},
-	31000467: {RegionID: 11000005, SystemID: 31000467, SystemName: "J130222"},
-	31000468: {RegionID: 11000005, SystemID: 31000468, SystemName: "J165357"},
-	31000469: {RegionID: 11000005, SystemID: 31000469, SystemName: "J105321"},
-	31000470: {RegionID: 11000005, SystemID: 31000470, SystemName: "J105803"},
-	31000471: {RegionID: 11000005, SystemID: 31000471, SystemName: "J130209"},
-	31000472: {RegionID: 11000005, SystemID: 31000472, SystemName: "J122832"},
-	31000473: {RegionID: 11000005, SystemID: 31000473, SystemName: "J143359"},
-	31000474: {RegionID: 11000005, SystemID: 31000474, SystemName: "J100447"},
-	31000475: {RegionID: 11000005, SystemID: 31000475, SystemName: "J115651"},
-	31000476: {RegionID: 11000005, SystemID: 31000476, SystemName: "J134323"},
-	31000477: {RegionID: 11000005, SystemID: 31000477, SystemName: "J160014"},
-	31000478: {RegionID: 11000005, SystemID: 31000478, SystemName: "J110823"},
-	31000479: {RegionID: 11000005, SystemID: 31000479, SystemName: "J100246"},
-	31000480: {RegionID: 11000005, SystemID: 31000480, SystemName: "J131221"},
-	31000481: {RegionID: 11000005, SystemID: 31000481, SystemName: "J145155"},
-	31000482: {RegionID: 11000005, SystemID: 31000482, SystemName: "J114405"},
-	31000483: {RegionID: 11000005, SystemID: 31000483, SystemName: "J215537"},
-	31000484: {RegionID: 11000005, SystemID: 31000484, SystemName: "J172551"},
-	31000485: {RegionID: 11000005, SystemID: 31000485, SystemName: "J131107"},
-	31000486: {RegionID: 11000005, SystemID: 31000486, SystemName: "J113108"},
-	31000487: {RegionID: 11000005, SystemID: 31000487, SystemName: "J172556"},
-	31000488: {RegionID: 11000005, SystemID: 31000488, SystemName: "J123450"},
-	31000489: {RegionID: 11000005, SystemID: 31000489, SystemName: "J135809"},
-	31000490: {RegionID: 11000005, SystemID: 31000490, SystemName: "J212319"},
-	31000491: {RegionID: 11000005, SystemID: 31000491, SystemName: "J104210"},
-	31000492: {RegionID: 11000005, SystemID: 31000492, SystemName: "J170038"},
-	31000493: {RegionID: 11000005, SystemID: 31000493, SystemName: "J104115"},
-	31000494: {RegionID: 11000005, SystemID: 31000494, SystemName: "J114758"},
-	31000495: {RegionID: 11000005, SystemID: 31000495, SystemName: "J231517"},
-	31000496: {RegionID: 11000005, SystemID: 31000496, SystemName: "J114055"},
-	31000497: {RegionID: 11000005, SystemID: 31000497, SystemName: "J120619"},
-	31000498: {RegionID: 11000005, SystemID: 31000498, SystemName: "J213058"},
-	31000499: {RegionID: 11000005, SystemID: 31000499, SystemName: "J162430"},
-	31000500: {RegionID: 11000005, SystemID: 31000500, SystemName: "J120928"},
-	31000501: {RegionID: 11000005, SystemID: 31000501, SystemName: "J132918"},
-	31000502: {RegionID: 11000005, SystemID: 31000502, SystemName: "J105203"},
-	31000503: {RegionID: 11000005, SystemID: 31000503, SystemName: "J104328"},
-	31000504: {RegionID: 11000005, SystemID: 31000504, SystemName: "J130854"},
-	31000505: {RegionID: 11000005, SystemID: 31000505, SystemName: "J164130"},
-	31000506: {RegionID: 11000005, SystemID: 31000506, SystemName: "J110316"},
-	31000507: {RegionID: 11000005, SystemID: 31000507, SystemName: "J122331"},
-	31000508: {RegionID: 11000005, SystemID: 31000508, SystemName: "J133419"},
-	31000509: {RegionID: 11000005, SystemID: 31000509, SystemName: "J150137"},
-	31000510: {RegionID: 11000005, SystemID: 31000510, SystemName: "J140112"},
-	31000511: {RegionID: 11000005, SystemID: 31000511, SystemName: "J125721"},
-	31000512: {RegionID: 11000005, SystemID: 31000512, SystemName: "J154515"},
-	31000513: {RegionID: 11000005, SystemID: 31000513, SystemName: "J135703"},
-	31000514: {RegionID: 11000005, SystemID: 31000514, SystemName: "J102347"},
-	31000515: {RegionID: 11000005, SystemID: 31000515, SystemName: "J234942"},
-	31000516: {RegionID: 11000005, SystemID: 31000516, SystemName: "J134618"},
-	31000517: {RegionID: 11000005, SystemID: 31000517, SystemName: "J154858"},
-	31000518: {RegionID: 11000005, SystemID: 31000518, SystemName: "J132052"},
-	31000519: {RegionID: 11000005, SystemID: 31000519, SystemName: "J114046"},
-	31000520: {RegionID: 11000005, SystemID: 31000520, SystemName: "J150859"},
-	31000521: {RegionID: 11000005, SystemID: 31000521, SystemName: "J151615"},
-	31000522: {RegionID: 11000005, SystemID: 31000522, SystemName: "J122246"},
-	31000523: {RegionID: 11000005, SystemID: 31000523, SystemName: "J132559"},
-	31000524: {RegionID: 11000005, SystemID: 31000524, SystemName: "J230207"},
-	31000525: {RegionID: 11000005, SystemID: 31000525, SystemName: "J140308"},
-	31000526: {RegionID: 11000005, SystemID: 31000526, SystemName: "J140642"},
-	31000527: {RegionID: 11000005, SystemID: 31000527, SystemName: "J114306"},
-	31000528: {RegionID: 11000005, SystemID: 31000528, SystemName: "J154606"},
-	31000529: {RegionID: 11000005, SystemID: 31000529, SystemName: "J135910"},
-	31000530: {RegionID: 11000005, SystemID: 31000530, SystemName: "J132024"},
-	31000531: {RegionID: 11000005, SystemID: 31000531, SystemName: "J160311"},
-	31000532: {RegionID: 11000005, SystemID: 31000532, SystemName: "J142239"},
-	31000533: {RegionID: 11000005, SystemID: 31000533, SystemName: "J101149"},
-	31000534: {RegionID: 11000005, SystemID: 31000534, SystemName: "J154538"},
-	31000535: {RegionID: 11000005, SystemID: 31000535, SystemName: "J144426"},
-	31000536: {RegionID: 11000005, SystemID: 31000536, SystemName: "J150341"},
-	31000537: {RegionID: 11000005, SystemID: 31000537, SystemName: "J122124"},
-	31000538: {RegionID: 11000005, SystemID: 31000538, SystemName: "J112614"},
-	31000539: {RegionID: 11000005, SystemID: 31000539, SystemName: "J145316"},
-	31000540: {RegionID: 11000005, SystemID: 31000540, SystemName: "J215736"},
-	31000541: {RegionID: 11000005, SystemID: 31000541, SystemName: "J163138"},
-	31000542: {RegionID: 11000005, SystemID: 31000542, SystemName: "J100033"},
-	31000543: {RegionID: 11000005, SystemID: 31000543, SystemName: "J170552"},
-	31000544: {RegionID: 11000005, SystemID: 31000544, SystemName: "J104820"},
-	31000545: {RegionID: 11000005, SystemID: 31000545, SystemName: "J131240"},
-	31000546: {RegionID: 11000005, SystemID: 31000546, SystemName: "J120442"},
-	31000547: {RegionID: 11000005, SystemID: 31000547, SystemName: "J105000"},
-	31000548: {RegionID: 11000005, SystemID: 31000548, SystemName: "J164507"},
-	31000549: {RegionID: 11000005, SystemID: 31000549, SystemName: "J144956"},
-	31000550: {RegionID: 11000005, SystemID: 31000550, SystemName: "J114712"},
-	31000551: {RegionID: 11000005, SystemID: 31000551, SystemName: "J211908"},
-	31000552: {RegionID: 11000005, SystemID: 31000552, SystemName: "J150700"},
-	31000553: {RegionID: 11000005, SystemID: 31000553, SystemName: "J164931"},
-	31000554: {RegionID: 11000005, SystemID: 31000554, SystemName: "J121412"},
-	31000555: {RegionID: 11000005, SystemID: 31000555, SystemName: "J145211"},
-	31000556: {RegionID: 11000005, SystemID: 31000556, SystemName: "J174449"},
-	31000557: {RegionID: 11000005, SystemID: 31000557, SystemName: "J133015"},
-	31000558: {RegionID: 11000005, SystemID: 31000558, SystemName: "J134534"},
-	31000559: {RegionID: 11000005, SystemID: 31000559, SystemName: "J153229"},
-	31000560: {RegionID: 11000005, SystemID: 31000560, SystemName: "J133557"},
-	31000561: {RegionID: 11000006, SystemID: 31000561, SystemName: "J150807"},
-	31000562: {RegionID: 11000006, SystemID: 31000562, SystemName: "J110413"},
-	31000563: {RegionID: 11000006, SystemID: 31000563, SystemName: "J105352"},
-	31000564: {RegionID: 11000006, SystemID: 31000564, SystemName: "J161246"},
-	31000565: {RegionID: 11000006, SystemID: 31000565, SystemName: "J125927"},
-	31000566: {RegionID: 11000006, SystemID: 31000566, SystemName: "J130650"},
-	31000567: {RegionID: 11000006, SystemID: 31000567, SystemName: "J142826"},
-	31000568: {RegionID: 11000006, SystemID: 31000568, SystemName: "J152737"},
-	31000569: {RegionID: 11000006, SystemID: 31000569, SystemName: "J225316"},
-	31000570: {RegionID: 11000006, SystemID: 31000570, SystemName: "J163146"},
-	31000571: {RegionID: 11000006, SystemID: 31000571, SystemName: "J134107"},
-	31000572: {RegionID: 11000006, SystemID: 31000572, SystemName: "J104850"},
-	31000573: {RegionID: 11000006, SystemID: 31000573, SystemName: "J131124"},
-	31000574: {RegionID: 11000006, SystemID: 31000574, SystemName: "J123435"},
-	31000575: {RegionID: 11000006, SystemID: 31000575, SystemName: "J150853"},
-	31000576: {RegionID: 11000006, SystemID: 31000576, SystemName: "J170558"},
-	31000577: {RegionID: 11000006, SystemID: 31000577, SystemName: "J214238"},
-	31000578: {RegionID: 11000006, SystemID: 31000578, SystemName: "J110538"},
-	31000579: {RegionID: 11000006, SystemID: 31000579, SystemName: "J143336"},
-	31000580: {RegionID: 11000006, SystemID: 31000580, SystemName: "J232044"},
-	31000581: {RegionID: 11000006, SystemID: 31000581, SystemName: "J145225"},
-	31000582: {RegionID: 11000006, SystemID: 31000582, SystemName: "J231210"},
-	31000583: {RegionID: 11000006, SystemID: 31000583, SystemName: "J223853"},
-	31000584: {RegionID: 11000006, SystemID: 31000584, SystemName: "J234542"},
-	31000585: {RegionID: 11000006, SystemID: 31000585, SystemName: "J115911"},
-	31000586: {RegionID: 11000006, SystemID: 31000586, SystemName: "J135155"},
-	31000587: {RegionID: 11000006, SystemID: 31000587, SystemName: "J152825"},
-	31000588: {RegionID: 11000006, SystemID: 31000588, SystemName: "J115334"},
-	31000589: {RegionID: 11000006, SystemID: 31000589, SystemName: "J114749"},
-	31000590: {RegionID: 11000006, SystemID: 31000590, SystemName: "J134730"},
-	31000591: {RegionID: 11000006, SystemID: 31000591, SystemName: "J105544"},
-	31000592: {RegionID: 11000006, SystemID: 31000592, SystemName: "J233129"},
-	31000593: {RegionID: 11000006, SystemID: 31000593, SystemName: "J114528"},
-	31000594: {RegionID: 11000006, SystemID: 31000594, SystemName: "J103351"},
-	31000595: {RegionID: 11000006, SystemID: 31000595, SystemName: "J152322"},
-	31000596: {RegionID: 11000006, SystemID: 31000596, SystemName: "J132617"},
-	31000597: {RegionID: 11000006, SystemID: 31000597, SystemName: "J104517"},
-	31000598: {RegionID: 11000006, SystemID: 31000598, SystemName: "J132401"},
-	31000599: {RegionID: 11000006, SystemID: 31000599, SystemName: "J142918"},
-	31000600: {RegionID: 11000006, SystemID: 31000600, SystemName: "J163902"},
-	31000601: {RegionID: 11000006, SystemID: 31000601, SystemName: "J214843"},
-	31000602: {RegionID: 11000006, SystemID: 31000602, SystemName: "J173322"},
-	31000603: {RegionID: 11000006, SystemID: 31000603, SystemName: "J120252"},
-	31000604: {RegionID: 11000006, SystemID: 31000604, SystemName: "J232305"},
-	31000605: {RegionID: 11000006, SystemID: 31000605, SystemName: "J123907"},
-	31000606: {RegionID: 11000006, SystemID: 31000606, SystemName: "J144057"},
-	31000607: {RegionID: 11000006, SystemID: 31000607, SystemName: "J223320"},
-	31000608: {RegionID: 11000006, SystemID: 31000608, SystemName: "J153247"},
-	31000609: {RegionID: 11000006, SystemID: 31000609, SystemName: "J204623"},
-	31000610: {RegionID: 11000006, SystemID: 31000610, SystemName: "J223348"},
-	31000611: {RegionID: 11000006, SystemID: 31000611, SystemName: "J115734"},
-	31000612: {RegionID: 11000006, SystemID: 31000612, SystemName: "J154735"},
-	31000613: {RegionID: 11000006, SystemID: 31000613, SystemName: "J115314"},
-	31000614: {RegionID: 11000006, SystemID: 31000614, SystemName: "J164816"},
-	31000615: {RegionID: 11000006, SystemID: 31000615, SystemName: "J132712"},
-	31000616: {RegionID: 11000006, SystemID: 31000616, SystemName: "J124530"},
-	31000617: {RegionID: 11000006, SystemID: 31000617, SystemName: "J134949"},
-	31000618: {RegionID: 11000006, SystemID: 31000618, SystemName: "J132458"},
-	31000619: {RegionID: 11000006, SystemID: 31000619, SystemName: "J160927"},
-	31000620: {RegionID: 11000006, SystemID: 31000620, SystemName: "J150836"},
-	31000621: {RegionID: 11000006, SystemID: 31000621, SystemName: "J102222"},
-	31000622: {RegionID: 11000006, SystemID: 31000622, SystemName: "J103144"},
-	31000623: {RegionID: 11000006, SystemID: 31000623, SystemName: "J135629"},
-	31000624: {RegionID: 11000006, SystemID: 31000624, SystemName: "J115530"},
-	31000625: {RegionID: 11000006, SystemID: 31000625, SystemName: "J133111"},
-	31000626: {RegionID: 11000006, SystemID: 31000626, SystemName: "J150904"},
-	31000627: {RegionID: 11000006, SystemID: 31000627, SystemName: "J111846"},
-	31000628: {RegionID: 11000006, SystemID: 31000628, SystemName: "J152950"},
-	31000629: {RegionID: 11000006, SystemID: 31000629, SystemName: "J120704"},
-	31000630: {RegionID: 11000006, SystemID: 31000630, SystemName: "J104718"},
-	31000631: {RegionID: 11000006, SystemID: 31000631, SystemName: "J131808"},
-	31000632: {RegionID: 11000006, SystemID: 31000632, SystemName: "J231644"},
-	31000633: {RegionID: 11000006, SystemID: 31000633, SystemName: "J232147"},
-	31000634: {RegionID: 11000006, SystemID: 31000634, SystemName: "J132048"},
-	31000635: {RegionID: 11000006, SystemID: 31000635, SystemName: "J172350"},
-	31000636: {RegionID: 11000006, SystemID: 31000636, SystemName: "J145619"},
-	31000637: {RegionID: 11000006, SystemID: 31000637, SystemName: "J163446"},
-	31000638: {RegionID: 11000006, SystemID: 31000638, SystemName: "J140816"},
-	31000639: {RegionID: 11000006, SystemID: 31000639, SystemName: "J141015"},
-	31000640: {RegionID: 11000006, SystemID: 31000640, SystemName: "J101346"},
-	31000641: {RegionID: 11000006, SystemID: 31000641, SystemName: "J212238"},
-	31000642: {RegionID: 11000006, SystemID: 31000642, SystemName: "J235852"},
-	31000643: {RegionID: 11000006, SystemID: 31000643, SystemName: "J134349"},
-	31000644: {RegionID: 11000006, SystemID: 31000644, SystemName: "J204221"},
-	31000645: {RegionID: 11000006, SystemID: 31000645, SystemName: "J110910"},
-	31000646: {RegionID: 11000006, SystemID: 31000646, SystemName: "J131753"},
-	31000647: {RegionID: 11000006, SystemID: 31000647, SystemName: "J113221"},
-	31000648: {RegionID: 11000006, SystemID: 31000648, SystemName: "J152941"},
-	31000649: {RegionID: 11000006, SystemID: 31000649, SystemName: "J144727"},
-	31000650: {RegionID: 11000006, SystemID: 31000650, SystemName: "J143320"},
-	31000651: {RegionID: 11000006, SystemID: 31000651, SystemName: "J155551"},
-	31000652: {RegionID: 11000006, SystemID: 31000652, SystemName: "J162516"},
-	31000653: {RegionID: 11000006, SystemID: 31000653, SystemName: "J135827"},
-	31000654: {RegionID: 11000006, SystemID: 31000654, SystemName: "J131706"},
-	31000655: {RegionID: 11000006, SystemID: 31000655, SystemName: "J214854"},
-	31000656: {RegionID: 11000006, SystemID: 31000656, SystemName: "J150109"},
-	31000657: {RegionID: 11000006, SystemID: 31000657, SystemName: "J103512"},
-	31000658: {RegionID: 11000006, SystemID: 31000658, SystemName: "J121658"},
-	31000659: {RegionID: 11000006, SystemID: 31000659, SystemName: "J143706"},
-	31000660: {RegionID: 11000006, SystemID: 31000660, SystemName: "J235924"},
-	31000661: {RegionID: 11000006, SystemID: 31000661, SystemName: "J103854"},
-	31000662: {RegionID: 11000006, SystemID: 31000662, SystemName: "J214725"},
-	31000663: {RegionID: 11000006, SystemID: 31000663, SystemName: "J101415"},
-	31000664: {RegionID: 11000006, SystemID: 31000664, SystemName: "J122837"},
-	31000665: {RegionID: 11000006, SystemID: 31000665, SystemName: "J125727"},
-	31000666: {RegionID: 11000006, SystemID: 31000666, SystemName: "J142847"},
-	31000667: {RegionID: 11000006, SystemID: 31000667, SystemName: "J155035"},
-	31000668: {RegionID: 11000006, SystemID: 31000668, SystemName: "J102504"},
-	31000669: {RegionID: 11000006, SystemID: 31000669, SystemName: "J160305"},
-	31000670: {RegionID: 11000006, SystemID: 31000670, SystemName: "J151503"},
-	31000671: {RegionID: 11000006, SystemID: 31000671, SystemName: "J142838"},
-	31000672: {RegionID: 11000006, SystemID: 31000672, SystemName: "J131034"},
-	31000673: {RegionID: 11000006, SystemID: 31000673, SystemName: "J214901"},
-	31000674: {RegionID: 11000006, SystemID: 31000674, SystemName: "J113323"},
-	31000675: {RegionID: 11000006, SystemID: 31000675, SystemName: "J112956"},
-	31000676: {RegionID: 11000006, SystemID: 31000676, SystemName: "J104802"},
-	31000677: {RegionID: 11000006, SystemID: 31000677, SystemName: "J100808"},
-	31000678: {RegionID: 11000006, SystemID: 31000678, SystemName: "J100509"},
-	31000679: {RegionID: 11000006, SystemID: 31000679, SystemName: "J142055"},
-	31000680: {RegionID: 11000006, SystemID: 31000680, SystemName: "J135141"},
-	31000681: {RegionID: 11000006, SystemID: 31000681, SystemName: "J151902"},
-	31000682: {RegionID: 11000006, SystemID: 31000682, SystemName: "J235759"},
-	31000683: {RegionID: 11000006, SystemID: 31000683, SystemName: "J164613"},
-	31000684: {RegionID: 11000006, SystemID: 31000684, SystemName: "J225805"},
-	31000685: {RegionID: 11000006, SystemID: 31000685, SystemName: "J112916"},
-	31000686: {RegionID: 11000006, SystemID: 31000686, SystemName: "J115347"},
-	31000687: {RegionID: 11000006, SystemID: 31000687, SystemName: "J224217"},
-	31000688: {RegionID: 11000006, SystemID: 31000688, SystemName: "J110555"},
-	31000689: {RegionID: 11000006, SystemID: 31000689, SystemName: "J143455"},
-	31000690: {RegionID: 11000006, SystemID: 31000690, SystemName: "J111141"},
-	31000691: {RegionID: 11000006, SystemID: 31000691, SystemName: "J104606"},
-	31000692: {RegionID: 11000006, SystemID: 31000692, SystemName: "J222830"},
-	31000693: {RegionID: 11000006, SystemID: 31000693, SystemName: "J123249"},
-	31000694: {RegionID: 11000006, SystemID: 31000694, SystemName: "J115310"},
-	31000695: {RegionID: 11000006, SystemID: 31000695, SystemName: "J143702"},
-	31000696: {RegionID: 11000006, SystemID: 31000696, SystemName: "J223538"},
-	31000697: {RegionID: 11000006, SystemID: 31000697, SystemName: "J131618"},
-	31000698: {RegionID: 11000006, SystemID: 31000698, SystemName: "J215944"},
-	31000699: {RegionID: 11000006, SystemID: 31000699, SystemName: "J121952"},
-	31000700: {RegionID: 11000006, SystemID: 31000700, SystemName: "J121928"},
-	31000701: {RegionID: 11000006, SystemID: 31000701, SystemName: "J105439"},
-	31000702: {RegionID: 11000007, SystemID: 31000702, SystemName: "J150627"},
-	31000703: {RegionID: 11000007, SystemID: 31000703, SystemName: "J121745"},
-	31000704: {RegionID: 11000007, SystemID: 31000704, SystemName: "J141438"},
-	31000705: {RegionID: 11000007, SystemID: 31000705, SystemName: "J214929"},
-	31000706: {RegionID: 11000007, SystemID: 31000706, SystemName: "J130709"},
-	31000707: {RegionID: 11000007, SystemID: 31000707, SystemName: "J103924"},
-	31000708: {RegionID: 11000007, SystemID: 31000708, SystemName: "J211000"},
-	31000709: {RegionID: 11000007, SystemID: 31000709, SystemName: "J123555"},
-	31000710: {RegionID: 11000007, SystemID: 31000710, SystemName: "J151125"},
-	31000711: {RegionID: 11000007, SystemID: 31000711, SystemName: "J123412"},
-	31000712: {RegionID: 11000007, SystemID: 31000712, SystemName: "J112309"},
-	31000713: {RegionID: 11000007, SystemID: 31000713, SystemName: "J123850"},
-	31000714: {RegionID: 11000007, SystemID: 31000714, SystemName: "J163923"},
-	31000715: {RegionID: 11000007, SystemID: 31000715, SystemName: "J143546"},
-	31000716: {RegionID: 11000007, SystemID: 31000716, SystemName: "J130832"},
-	31000717: {RegionID: 11000007, SystemID: 31000717, SystemName: "J221337"},
-	31000718: {RegionID: 11000007, SystemID: 31000718, SystemName: "J113449"},
-	31000719: {RegionID: 11000007, SystemID: 31000719, SystemName: "J212607"},
-	31000720: {RegionID: 11000007, SystemID: 31000720, SystemName: "J165157"},
-	31000721: {RegionID: 11000007, SystemID: 31000721, SystemName: "J142937"},
-	31000722: {RegionID: 11000007, SystemID: 31000722, SystemName: "J120455"},
-	31000723: {RegionID: 11000007, SystemID: 31000723, SystemName: "J122638"},
-	31000724: {RegionID: 11000007, SystemID: 31000724, SystemName: "J161411"},
-	31000725: {RegionID: 11000007, SystemID: 31000725, SystemName: "J124028"},
-	31000726: {RegionID: 11000007, SystemID: 31000726, SystemName: "J134735"},
-	31000727: {RegionID: 11000007, SystemID: 31000727, SystemName: "J142845"},
-	31000728: {RegionID: 11000007, SystemID: 31000728, SystemName: "J140750"},
-	31000729: {RegionID: 11000007, SystemID: 31000729, SystemName: "J104515"},
-	31000730: {RegionID: 11000007, SystemID: 31000730, SystemName: "J113039"},
-	31000731: {RegionID: 11000007, SystemID: 31000731, SystemName: "J111159"},
-	31000732: {RegionID: 11000007, SystemID: 31000732, SystemName: "J105342"},
-	31000733: {RegionID: 11000007, SystemID: 31000733, SystemName: "J121728"},
-	31000734: {RegionID: 11000007, SystemID: 31000734, SystemName: "J115700"},
-	31000735: {RegionID: 11000007, SystemID: 31000735, SystemName: "J143234"},
-	31000736: {RegionID: 11000007, SystemID: 31000736, SystemName: "J122524"},
-	31000737: {RegionID: 11000007, SystemID: 31000737, SystemName: "J124007"},
-	31000738: {RegionID: 11000007, SystemID: 31000738, SystemName: "J150533"},
-	31000739: {RegionID: 11000007, SystemID: 31000739, SystemName: "J143933"},
-	31000740: {RegionID: 11000007, SystemID: 31000740, SystemName: "J225128"},
-	31000741: {RegionID: 11000007, SystemID: 31000741, SystemName: "J133632"},
-	31000742: {RegionID: 11000007, SystemID: 31000742, SystemName: "J131744"},
-	31000743: {RegionID: 11000007, SystemID: 31000743, SystemName: "J125923"},
-	31000744: {RegionID: 11000007, SystemID: 31000744, SystemName: "J161138"},
-	31000745: {RegionID: 11000007, SystemID: 31000745, SystemName: "J125824"},
-	31000746: {RegionID: 11000007, SystemID: 31000746, SystemName: "J223511"},
-	31000747: {RegionID: 11000007, SystemID: 31000747, SystemName: "J134401"},
-	31000748: {RegionID: 11000007, SystemID: 31000748, SystemName: "J214739"},
-	31000749: {RegionID: 11000007, SystemID: 31000749, SystemName: "J104321"},
-	31000750: {RegionID: 11000007, SystemID: 31000750, SystemName: "J115327"},
-	31000751: {RegionID: 11000007, SystemID: 31000751, SystemName: "J154937"},
-	31000752: {RegionID: 11000008, SystemID: 31000752, SystemName: "J112505"},
-	31000753: {RegionID: 11000008, SystemID: 31000753, SystemName: "J102739"},
-	31000754: {RegionID: 11000008, SystemID: 31000754, SystemName: "J171309"},
-	31000755: {RegionID: 11000008, SystemID: 31000755, SystemName: "J133417"},
-	31000756: {RegionID: 11000008, SystemID: 31000756, SystemName: "J124749"},
-	31000757: {RegionID: 11000008, SystemID: 31000757, SystemName: "J122137"},
-	31000758: {RegionID: 11000008, SystemID: 31000758, SystemName: "J140353"},
-	31000759: {RegionID: 11000008, SystemID: 31000759, SystemName: "J104029"},
-	31000760: {RegionID: 11000008, SystemID: 31000760, SystemName: "J151348"},
-	31000761: {RegionID: 11000008, SystemID: 31000761, SystemName: "J163522"},
-	31000762: {RegionID: 11000008, SystemID: 31000762, SystemName: "J131315"},
-	31000763: {RegionID: 11000008, SystemID: 31000763, SystemName: "J114008"},
-	31000764: {RegionID: 11000008, SystemID: 31000764, SystemName: "J233839"},
-	31000765: {RegionID: 11000008, SystemID: 31000765, SystemName: "J112558"},
-	31000766: {RegionID: 11000008, SystemID: 31000766, SystemName: "J114308"},
-	31000767: {RegionID: 11000008, SystemID: 31000767, SystemName: "J130125"},
-	31000768: {RegionID: 11000008, SystemID: 31000768, SystemName: "J173213"},
-	31000769: {RegionID: 11000008, SystemID: 31000769, SystemName: "J160126"},
-	31000770: {RegionID: 11000008, SystemID: 31000770, SystemName: "J154813"},
-	31000771: {RegionID: 11000008, SystemID: 31000771, SystemName: "J215151"},
-	31000772: {RegionID: 11000008, SystemID: 31000772, SystemName: "J144739"},
-	31000773: {RegionID: 11000008, SystemID: 31000773, SystemName: "J102602"},
-	31000774: {RegionID: 11000008, SystemID: 31000774, SystemName: "J120924"},
-	31000775: {RegionID: 11000008, SystemID: 31000775, SystemName: "J212906"},
-	31000776: {RegionID: 11000008, SystemID: 31000776, SystemName: "J101719"},
-	31000777: {RegionID: 11000008, SystemID: 31000777, SystemName: "J101824"},
-	31000778: {RegionID: 11000008, SystemID: 31000778, SystemName: "J153003"},
-	31000779: {RegionID: 11000008, SystemID: 31000779, SystemName: "J105123"},
-	31000780: {RegionID: 11000008, SystemID: 31000780, SystemName: "J122635"},
-	31000781: {RegionID: 11000008, SystemID: 31000781, SystemName: "J152624"},
-	31000782: {RegionID: 11000008, SystemID: 31000782, SystemName: "J224145"},
-	31000783: {RegionID: 11000008, SystemID: 31000783, SystemName: "J163318"},
-	31000784: {RegionID: 11000008, SystemID: 31000784, SystemName: "J144004"},
-	31000785: {RegionID: 11000008, SystemID: 31000785, SystemName: "J122931"},
-	31000786: {RegionID: 11000008, SystemID: 31000786, SystemName: "J173245"},
-	31000787: {RegionID: 11000008, SystemID: 31000787, SystemName: "J145246"},
-	31000788: {RegionID: 11000008, SystemID: 31000788, SystemName: "J124526"},
-	31000789: {RegionID: 11000008, SystemID: 31000789, SystemName: "J165648"},
-	31000790: {RegionID: 11000008, SystemID: 31000790, SystemName: "J134405"},
-	31000791: {RegionID: 11000008, SystemID: 31000791, SystemName: "J213111"},
-	31000792: {RegionID: 11000008, SystemID: 31000792, SystemName: "J135422"},
-	31000793: {RegionID: 11000008, SystemID: 31000793, SystemName: "J153030"},
-	31000794: {RegionID: 11000008, SystemID: 31000794, SystemName: "J120634"},
-	31000795: {RegionID: 11000008, SystemID: 31000795, SystemName: "J102209"},
-	31000796: {RegionID: 11000008, SystemID: 31000796, SystemName: "J123540"},
-	31000797: {RegionID: 11000008, SystemID: 31000797, SystemName: "J112715"},
-	31000798: {RegionID: 11000008, SystemID: 31000798, SystemName: "J162159"},
-	31000799: {RegionID: 11000008, SystemID: 31000799, SystemName: "J122118"},
-	31000800: {RegionID: 11000008, SystemID: 31000800, SystemName: "J105244"},
-	31000801: {RegionID: 11000008, SystemID: 31000801, SystemName: "J123958"},
-	31000802: {RegionID: 11000008, SystemID: 31000802, SystemName: "J223552"},
-	31000803: {RegionID: 11000008, SystemID: 31000803, SystemName: "J232359"},
-	31000804: {RegionID: 11000008, SystemID: 31000804, SystemName: "J171539"},
-	31000805: {RegionID: 11000008, SystemID: 31000805, SystemName: "J100102"},
-	31000806: {RegionID: 11000008, SystemID: 31000806, SystemName: "J154249"},
-	31000807: {RegionID: 11000008, SystemID: 31000807, SystemName: "J105311"},
-	31000808: {RegionID: 11000008, SystemID: 31000808, SystemName: "J210536"},
-	31000809: {RegionID: 11000008, SystemID: 31000809, SystemName: "J151248"},
-	31000810: {RegionID: 11000008, SystemID: 31000810, SystemName: "J131852"},
-	31000811: {RegionID: 11000008, SystemID: 31000811, SystemName: "J141611"},
-	31000812: {RegionID: 11000008, SystemID: 31000812, SystemName: "J113723"},
-	31000813: {RegionID: 11000008, SystemID: 31000813, SystemName: "J121323"},
-	31000814: {RegionID: 11000008, SystemID: 31000814, SystemName: "J144422"},
-	31000815: {RegionID: 11000008, SystemID: 31000815, SystemName: "J140722"},
-	31000816: {RegionID: 11000008, SystemID: 31000816, SystemName: "J141637"},
-	31000817: {RegionID: 11000008, SystemID: 31000817, SystemName: "J112617"},
-	31000818: {RegionID: 11000008, SystemID: 31000818, SystemName: "J150754"},
-	31000819: {RegionID: 11000008, SystemID: 31000819, SystemName: "J102946"},
-	31000820: {RegionID: 11000008, SystemID: 31000820, SystemName: "J115234"},
-	31000821: {RegionID: 11000008, SystemID: 31000821, SystemName: "J145145"},
-	31000822: {RegionID: 11000008, SystemID: 31000822, SystemName: "J145426"},
-	31000823: {RegionID: 11000008, SystemID: 31000823, SystemName: "J122732"},
-	31000824: {RegionID: 11000008, SystemID: 31000824, SystemName: "J144203"},
-	31000825: {RegionID: 11000008, SystemID: 31000825, SystemName: "J140843"},
-	31000826: {RegionID: 11000008, SystemID: 31000826, SystemName: "J155631"},
-	31000827: {RegionID: 11000008, SystemID: 31000827, SystemName: "J235525"},
-	31000828: {RegionID: 11000008, SystemID: 31000828, SystemName: "J121749"},
-	31000829: {RegionID: 11000008, SystemID: 31000829, SystemName: "J162518"},
-	31000830: {RegionID: 11000008, SystemID: 31000830, SystemName: "J132012"},
-	31000831: {RegionID: 11000008, SystemID: 31000831, SystemName: "J102038"},
-	31000832: {RegionID: 11000008, SystemID: 31000832, SystemName: "J150234"},
-	31000833: {RegionID: 11000008, SystemID: 31000833, SystemName: "J112520"},
-	31000834: {RegionID: 11000008, SystemID: 31000834, SystemName: "J165412"},
-	31000835: {RegionID: 11000008, SystemID: 31000835, SystemName: "J151102"},
-	31000836: {RegionID: 11000008, SystemID: 31000836, SystemName: "J133049"},
-	31000837: {RegionID: 11000008, SystemID: 31000837, SystemName: "J161628"},
-	31000838: {RegionID: 11000008, SystemID: 31000838, SystemName: "J134306"},
-	31000839: {RegionID: 11000008, SystemID: 31000839, SystemName: "J131842"},
-	31000840: {RegionID: 11000008, SystemID: 31000840, SystemName: "J232329"},
-	31000841: {RegionID: 11000008, SystemID: 31000841, SystemName: "J164921"},
-	31000842: {RegionID: 11000008, SystemID: 31000842, SystemName: "J203814"},
-	31000843: {RegionID: 11000008, SystemID: 31000843, SystemName: "J151311"},
-	31000844: {RegionID: 11000008, SystemID: 31000844, SystemName: "J101650"},
-	31000845: {RegionID: 11000008, SystemID: 31000845, SystemName: "J165006"},
-	31000846: {RegionID: 11000008, SystemID: 31000846, SystemName: "J103533"},
-	31000847: {RegionID: 11000008, SystemID: 31000847, SystemName: "J101441"},
-	31000848: {RegionID: 11000008, SystemID: 31000848, SystemName: "J124253"},
-	31000849: {RegionID: 11000008, SystemID: 31000849, SystemName: "J232200"},
-	31000850: {RegionID: 11000008, SystemID: 31000850, SystemName: "J144326"},
-	31000851: {RegionID: 11000008, SystemID: 31000851, SystemName: "J124449"},
-	31000852: {RegionID: 11000008, SystemID: 31000852, SystemName: "J100642"},
-	31000853: {RegionID: 11000008, SystemID: 31000853, SystemName: "J133150"},
-	31000854: {RegionID: 11000008, SystemID: 31000854, SystemName: "J164126"},
-	31000855: {RegionID: 11000008, SystemID: 31000855, SystemName: "J171142"},
-	31000856: {RegionID: 11000008, SystemID: 31000856, SystemName: "J145634"},
-	31000857: {RegionID: 11000008, SystemID: 31000857, SystemName: "J151431"},
-	31000858: {RegionID: 11000008, SystemID: 31000858, SystemName: "J233555"},
-	31000859: {RegionID: 11000008, SystemID: 31000859, SystemName: "J100651"},
-	31000860: {RegionID: 11000008, SystemID: 31000860, SystemName: "J132103"},
-	31000861: {RegionID: 11000008, SystemID: 31000861, SystemName: "J121720"},
-	31000862: {RegionID: 11000008, SystemID: 31000862, SystemName: "J210355"},
-	31000863: {RegionID: 11000008, SystemID: 31000863, SystemName: "J125029"},
-	31000864: {RegionID: 11000008, SystemID: 31000864, SystemName: "J103619"},
-	31000865: {RegionID: 11000008, SystemID: 31000865, SystemName: "J165014"},
-	31000866: {RegionID: 11000008, SystemID: 31000866, SystemName: "J124611"},
-	31000867: {RegionID: 11000008, SystemID: 31000867, SystemName: "J150418"},
-	31000868: {RegionID: 11000008, SystemID: 31000868, SystemName: "J145440"},
-	31000869: {RegionID: 11000008, SystemID: 31000869, SystemName: "J113223"},
-	31000870: {RegionID: 11000008, SystemID: 31000870, SystemName: "J234915"},
-	31000871: {RegionID: 11000008, SystemID: 31000871, SystemName: "J162042"},
-	31000872: {RegionID: 11000008, SystemID: 31000872, SystemName: "J112019"},
-	31000873: {RegionID: 11000008, SystemID: 31000873, SystemName: "J162332"},
-	31000874: {RegionID: 11000008, SystemID: 31000874, SystemName: "J125350"},
-	31000875: {RegionID: 11000008, SystemID: 31000875, SystemName: "J101710"},
-	31000876: {RegionID: 11000008, SystemID: 31000876, SystemName: "J223029"},
-	31000877: {RegionID: 11000008, SystemID: 31000877, SystemName: "J234810"},
-	31000878: {RegionID: 11000008, SystemID: 31000878, SystemName: "J135230"},
-	31000879: {RegionID: 11000008, SystemID: 31000879, SystemName: "J111038"},
-	31000880: {RegionID: 11000009, SystemID: 31000880, SystemName: "J160650"},
-	31000881: {RegionID: 11000009, SystemID: 31000881, SystemName: "J114342"},
-	31000882: {RegionID: 11000009, SystemID: 31000882, SystemName: "J152031"},
-	31000883: {RegionID: 11000009, SystemID: 31000883, SystemName: "J113629"},
-	31000884: {RegionID: 11000009, SystemID: 31000884, SystemName: "J220301"},
-	31000885: {RegionID: 11000009, SystemID: 31000885, SystemName: "J102057"},
-	31000886: {RegionID: 11000009, SystemID: 31000886, SystemName: "J124753"},
-	31000887: {RegionID: 11000009, SystemID: 31000887, SystemName: "J114225"},
-	31000888: {RegionID: 11000009, SystemID: 31000888, SystemName: "J105002"},
-	31000889: {RegionID: 11000009, SystemID: 31000889, SystemName: "J111218"},
-	31000890: {RegionID: 11000009, SystemID: 31000890, SystemName: "J111634"},
-	31000891: {RegionID: 11000009, SystemID: 31000891, SystemName: "J105007"},
-	31000892: {RegionID: 11000009, SystemID: 31000892, SystemName: "J205027"},
-	31000893: {RegionID: 11000009, SystemID: 31000893, SystemName: "J111355"},
-	31000894: {RegionID: 11000009, SystemID: 31000894, SystemName: "J101012"},
-	31000895: {RegionID: 11000009, SystemID: 31000895, SystemName: "J161338"},
-	31000896: {RegionID: 11000009, SystemID: 31000896, SystemName: "J170002"},
-	31000897: {RegionID: 11000009, SystemID: 31000897, SystemName: "J104654"},
-	31000898: {RegionID: 11000009, SystemID: 31000898, SystemName: "J124329"},
-	31000899: {RegionID: 11000009, SystemID: 31000899, SystemName: "J114648"},
-	31000900: {RegionID: 11000009, SystemID: 31000900, SystemName: "J131228"},
-	31000901: {RegionID: 11000009, SystemID: 31000901, SystemName: "J110448"},
-	31000902: {RegionID: 11000009, SystemID: 31000902, SystemName: "J144621"},
-	31000903: {RegionID: 11000009, SystemID: 31000903, SystemName: "J153104"},
-	31000904: {RegionID: 11000009, SystemID: 31000904, SystemName: "J100932"},
-	31000905: {RegionID: 11000009, SystemID: 31000905, SystemName: "J114048"},
-	31000906: {RegionID: 11000009, SystemID: 31000906, SystemName: "J115706"},
-	31000907: {RegionID: 11000009, SystemID: 31000907, SystemName: "J123230"},
-	31000908: {RegionID: 11000009, SystemID: 31000908, SystemName: "J152218"},
-	31000909: {RegionID: 11000009, SystemID: 31000909, SystemName: "J123303"},
-	31000910: {RegionID: 11000009, SystemID: 31000910, SystemName: "J102856"},
-	31000911: {RegionID: 11000009, SystemID: 31000911, SystemName: "J151900"},
-	31000912: {RegionID: 11000009, SystemID: 31000912, SystemName: "J124646"},
-	31000913: {RegionID: 11000009, SystemID: 31000913, SystemName: "J153528"},
-	31000914: {RegionID: 11000009, SystemID: 31000914, SystemName: "J112850"},
-	31000915: {RegionID: 11000009, SystemID: 31000915, SystemName: "J102257"},
-	31000916: {RegionID: 11000009, SystemID: 31000916, SystemName: "J142414"},
-	31000917: {RegionID: 11000009, SystemID: 31000917, SystemName: "J165803"},
-	31000918: {RegionID: 11000009, SystemID: 31000918, SystemName: "J115918"},
-	31000919: {RegionID: 11000009, SystemID: 31000919, SystemName: "J222104"},
-	31000920: {RegionID: 11000009, SystemID: 31000920, SystemName: "J124203"},
-	31000921: {RegionID: 11000009, SystemID: 31000921, SystemName: "J112608"},
-	31000922: {RegionID: 11000009, SystemID: 31000922, SystemName: "J161657"},
-	31000923: {RegionID: 11000009, SystemID: 31000923, SystemName: "J111805"},
-	31000924: {RegionID: 11000009, SystemID: 31000924, SystemName: "J113451"},
-	31000925: {RegionID: 11000009, SystemID: 31000925, SystemName: "J141007"},
-	31000926: {RegionID: 11000009, SystemID: 31000926, SystemName: "J164034"},
-	31000927: {RegionID: 11000009, SystemID: 31000927, SystemName: "J101750"},
-	31000928: {RegionID: 11000009, SystemID: 31000928, SystemName: "J165001"},
-	31000929: {RegionID: 11000009, SystemID: 31000929, SystemName: "J111619"},
-	31000930: {RegionID: 11000009, SystemID: 31000930, SystemName: "J140521"},
-	31000931: {RegionID: 11000009, SystemID: 31000931, SystemName: "J140752"},
-	31000932: {RegionID: 11000009, SystemID: 31000932, SystemName: "J143447"},
-	31000933: {RegionID: 11000009, SystemID: 31000933, SystemName: "J142018"},
-	31000934: {RegionID: 11000009, SystemID: 31000934, SystemName: "J105102"},
-	31000935: {RegionID: 11000009, SystemID: 31000935, SystemName: "J124630"},
-	31000936: {RegionID: 11000010, SystemID: 31000936, SystemName: "J165302"},
-	31000937: {RegionID: 11000010, SystemID: 31000937, SystemName: "J143916"},
-	31000938: {RegionID: 11000010, SystemID: 31000938, SystemName: "J221414"},
-	31000939: {RegionID: 11000010, SystemID: 31000939, SystemName: "J153903"},
-	31000940: {RegionID: 11000010, SystemID: 31000940, SystemName: "J103955"},
-	31000941: {RegionID: 11000010, SystemID: 31000941, SystemName: "J210952"},
-	31000942: {RegionID: 11000010, SystemID: 31000942, SystemName: "J134102"},
-	31000943: {RegionID: 11000010, SystemID: 31000943, SystemName: "J140050"},
-	31000944: {RegionID: 11000010, SystemID: 31000944, SystemName: "J131757"},
-	31000945: {RegionID: 11000010, SystemID: 31000945, SystemName: "J140341"},
-	31000946: {RegionID: 11000010, SystemID: 31000946, SystemName: "J111856"},
-	31000947: {RegionID: 11000010, SystemID: 31000947, SystemName: "J102407"},
-	31000948: {RegionID: 11000010, SystemID: 31000948, SystemName: "J151341"},
-	31000949: {RegionID: 11000010, SystemID: 31000949, SystemName: "J110706"},
-	31000950: {RegionID: 11000010, SystemID: 31000950, SystemName: "J133651"},
-	31000951: {RegionID: 11000010, SystemID: 31000951, SystemName: "J123452"},
-	31000952: {RegionID: 11000010, SystemID: 31000952, SystemName: "J102143"},
-	31000953: {RegionID: 11000010, SystemID: 31000953, SystemName: "J220832"},
-	31000954: {RegionID: 11000010, SystemID: 31000954, SystemName: "J160847"},
-	31000955: {RegionID: 11000010, SystemID: 31000955, SystemName: "J125949"},
-	31000956: {RegionID: 11000010, SystemID: 31000956, SystemName: "J164250"},
-	31000957: {RegionID: 11000010, SystemID: 31000957, SystemName: "J212329"},
-	31000958: {RegionID: 11000010, SystemID: 31000958, SystemName: "J112905"},
-	31000959: {RegionID: 11000010, SystemID: 31000959, SystemName: "J144447"},
-	31000960: {RegionID: 11000010, SystemID: 31000960, SystemName: "J113530"},
-	31000961: {RegionID: 11000010, SystemID: 31000961, SystemName: "J103604"},
-	31000962: {RegionID: 11000010, SystemID: 31000962, SystemName: "J142038"},
-	31000963: {RegionID: 11000010, SystemID: 31000963, SystemName: "J130403"},
-	31000964: {RegionID: 11000010, SystemID: 31000964, SystemName: "J132525"},
-	31000965: {RegionID: 11000010, SystemID: 31000965, SystemName: "J103653"},
-	31000966: {RegionID: 11000010, SystemID: 31000966, SystemName: "J154642"},
-	31000967: {RegionID: 11000010, SystemID: 31000967, SystemName: "J163526"},
-	31000968: {RegionID: 11000010, SystemID: 31000968, SystemName: "J212713"},
-	31000969: {RegionID: 11000010, SystemID: 31000969, SystemName: "J132635"},
-	31000970: {RegionID: 11000010, SystemID: 31000970, SystemName: "J114330"},
-	31000971: {RegionID: 11000010, SystemID: 31000971, SystemName: "J224926"},
-	31000972: {RegionID: 11000010, SystemID: 31000972, SystemName: "J113619"},
-	31000973: {RegionID: 11000010, SystemID: 31000973, SystemName: "J214654"},
-	31000974: {RegionID: 11000010, SystemID: 31000974, SystemName: "J151429"},
-	31000975: {RegionID: 11000010, SystemID: 31000975, SystemName: "J111214"},
-	31000976: {RegionID: 11000010, SystemID: 31000976, SystemName: "J113420"},
-	31000977: {RegionID: 11000010, SystemID: 31000977, SystemName: "J135526"},
-	31000978: {RegionID: 11000010, SystemID: 31000978, SystemName: "J164116"},
-	31000979: {RegionID: 11000010, SystemID: 31000979, SystemName: "J214227"},
-	31000980: {RegionID: 11000010, SystemID: 31000980, SystemName: "J164342"},
-	31000981: {RegionID: 11000010, SystemID: 31000981, SystemName: "J151538"},
-	31000982: {RegionID: 11000010, SystemID: 31000982, SystemName: "J125938"},
-	31000983: {RegionID: 11000010, SystemID: 31000983, SystemName: "J165806"},
-	31000984: {RegionID: 11000010, SystemID: 31000984, SystemName: "J122803"},
-	31000985: {RegionID: 11000010, SystemID: 31000985, SystemName: "J113243"},
-	31000986: {RegionID: 11000010, SystemID: 31000986, SystemName: "J134022"},
-	31000987: {RegionID: 11000011, SystemID: 31000987, SystemName: "J152143"},
-	31000988: {RegionID: 11000011, SystemID: 31000988, SystemName: "J170717"},
-	31000989: {RegionID: 11000011, SystemID: 31000989, SystemName: "J121452"},
-	31000990: {RegionID: 11000011, SystemID: 31000990, SystemName: "J124126"},
-	31000991: {RegionID: 11000011, SystemID: 31000991, SystemName: "J173230"},
-	31000992: {RegionID: 11000011, SystemID: 31000992, SystemName: "J164457"},
-	31000993: {RegionID: 11000011, SystemID: 31000993, SystemName: "J112944"},
-	31000994: {RegionID: 11000011, SystemID: 31000994, SystemName: "J115253"},
-	31000995: {RegionID: 11000011, SystemID: 31000995, SystemName: "J100118"},
-	31000996: {RegionID: 11000011, SystemID: 31000996, SystemName: "J100620"},
-	31000997: {RegionID: 11000011, SystemID: 31000997, SystemName: "J145916"},
-	31000998: {RegionID: 11000011, SystemID: 31000998, SystemName: "J223207"},
-	31000999: {RegionID: 11000011, SystemID: 31000999, SystemName: "J144646"},
-	31001000: {RegionID: 11000011, SystemID: 31001000, SystemName: "J142520"},
-	31001001: {RegionID: 11000011, SystemID: 31001001, SystemName: "J230226"},
-	31001002: {RegionID: 11000011, SystemID: 31001002, SystemName: "J150704"},
-	31001003: {RegionID: 11000011, SystemID: 31001003, SystemName: "J225524"},
-	31001004: {RegionID: 11000011, SystemID: 31001004, SystemName: "J133234"},
-	31001005: {RegionID: 11000011, SystemID: 31001005, SystemName: "J125833"},
-	31001006: {RegionID: 11000011, SystemID: 31001006, SystemName: "J104459"},
-	31001007: {RegionID: 11000011, SystemID: 31001007, SystemName: "J164713"},
-	31001008: {RegionID: 11000011, SystemID: 31001008, SystemName: "J135355"},
-	31001009: {RegionID: 11000011, SystemID: 31001009, SystemName: "J220838"},
-	31001010: {RegionID: 11000011, SystemID: 31001010, SystemName: "J225949"},
-	31001011: {RegionID: 11000011, SystemID: 31001011, SystemName: "J130535"},
-	31001012: {RegionID: 11000011, SystemID: 31001012, SystemName: "J111255"},
-	31001013: {RegionID: 11000011, SystemID: 31001013, SystemName: "J121628"},
-	31001014: {RegionID: 11000011, SystemID: 31001014, SystemName: "J221117"},
-	31001015: {RegionID: 11000011, SystemID: 31001015, SystemName: "J115015"},
-	31001016: {RegionID: 11000011, SystemID: 31001016, SystemName: "J172751"},
-	31001017: {RegionID: 11000011, SystemID: 31001017, SystemName: "J134301"},
-	31001018: {RegionID: 11000011, SystemID: 31001018, SystemName: "J104351"},
-	31001019: {RegionID: 11000011, SystemID: 31001019, SystemName: "J144408"},
-	31001020: {RegionID: 11000011, SystemID: 31001020, SystemName: "J220950"},
-	31001021: {RegionID: 11000011, SystemID: 31001021, SystemName: "J165815"},
-	31001022: {RegionID: 11000011, SystemID: 31001022, SystemName: "J120452"},
-	31001023: {RegionID: 11000011, SystemID: 31001023, SystemName: "J142026"},
-	31001024: {RegionID: 11000011, SystemID: 31001024, SystemName: "J111031"},
-	31001025: {RegionID: 11000011, SystemID: 31001025, SystemName: "J130116"},
-	31001026: {RegionID: 11000011, SystemID: 31001026, SystemName: "J111309"},
-	31001027: {RegionID: 11000011, SystemID: 31001027, SystemName: "J122213"},
-	31001028: {RegionID: 11000011, SystemID: 31001028, SystemName: "J170740"},
-	31001029: {RegionID: 11000011, SystemID: 31001029, SystemName: "J113543"},
-	31001030: {RegionID: 11000011, SystemID: 31001030, SystemName: "J170817"},
-	31001031: {RegionID: 11000011, SystemID: 31001031, SystemName: "J123405"},
-	31001032: {RegionID: 11000011, SystemID: 31001032, SystemName: "J125741"},
-	31001033: {RegionID: 11000011, SystemID: 31001033, SystemName: "J114627"},
-	31001034: {RegionID: 11000011, SystemID: 31001034, SystemName: "J155117"},
-	31001035: {RegionID: 11000011, SystemID: 31001035, SystemName: "J165118"},
-	31001036: {RegionID: 11000011, SystemID: 31001036, SystemName: "J141004"},
-	31001037: {RegionID: 11000011, SystemID: 31001037, SystemName: "J174317"},
-	31001038: {RegionID: 11000011, SystemID: 31001038, SystemName: "J145040"},
-	31001039: {RegionID: 11000011, SystemID: 31001039, SystemName: "J171549"},
-	31001040: {RegionID: 11000011, SystemID: 31001040, SystemName: "J100009"},
-	31001041: {RegionID: 11000011, SystemID: 31001041, SystemName: "J124257"},
-	31001042: {RegionID: 11000011, SystemID: 31001042, SystemName: "J154530"},
-	31001043: {RegionID: 11000011, SystemID: 31001043, SystemName: "J152636"},
-	31001044: {RegionID: 11000011, SystemID: 31001044, SystemName: "J100728"},
-	31001045: {RegionID: 11000011, SystemID: 31001045, SystemName: "J115504"},
-	31001046: {RegionID: 11000011, SystemID: 31001046, SystemName: "J104905"},
-	31001047: {RegionID: 11000011, SystemID: 31001047, SystemName: "J125149"},
-	31001048: {RegionID: 11000011, SystemID: 31001048, SystemName: "J172743"},
-	31001049: {RegionID: 11000011, SystemID: 31001049, SystemName: "J111421"},
-	31001050: {RegionID: 11000011, SystemID: 31001050, SystemName: "J152146"},
-	31001051: {RegionID: 11000011, SystemID: 31001051, SystemName: "J111603"},
-	31001052: {RegionID: 11000011, SystemID: 31001052, SystemName: "J114127"},
-	31001053: {RegionID: 11000011, SystemID: 31001053, SystemName: "J113923"},
-	31001054: {RegionID: 11000011, SystemID: 31001054, SystemName: "J123837"},
-	31001055: {RegionID: 11000011, SystemID: 31001055, SystemName: "J125049"},
-	31001056: {RegionID: 11000011, SystemID: 31001056, SystemName: "J161455"},
-	31001057: {RegionID: 11000011, SystemID: 31001057, SystemName: "J124715"},
-	31001058: {RegionID: 11000011, SystemID: 31001058, SystemName: "J124813"},
-	31001059: {RegionID: 11000011, SystemID: 31001059, SystemName: "J120945"},
-	31001060: {RegionID: 11000011, SystemID: 31001060, SystemName: "J155541"},
-	31001061: {RegionID: 11000011, SystemID: 31001061, SystemName: "J105017"},
-	31001062: {RegionID: 11000011, SystemID: 31001062, SystemName: "J154900"},
-	31001063: {RegionID: 11000011, SystemID: 31001063, SystemName: "J164951"},
-	31001064: {RegionID: 11000011, SystemID: 31001064, SystemName: "J162459"},
-	31001065: {RegionID: 11000011, SystemID: 31001065, SystemName: "J141832"},
-	31001066: {RegionID: 11000011, SystemID: 31001066, SystemName: "J115136"},
-	31001067: {RegionID: 11000011, SystemID: 31001067, SystemName: "J112854"},
-	31001068: {RegionID: 11000011, SystemID: 31001068, SystemName: "J222222"},
-	31001069: {RegionID: 11000011, SystemID: 31001069, SystemName: "J124654"},
-	31001070: {RegionID: 11000011, SystemID: 31001070, SystemName: "J132750"},
-	31001071: {RegionID: 11000011, SystemID: 31001071, SystemName: "J170511"},
-	31001072: {RegionID: 11000011, SystemID: 31001072, SystemName: "J155852"},
-	31001073: {RegionID: 11000012, SystemID: 31001073, SystemName: "J121856"},
-	31001074: {RegionID: 11000012, SystemID: 31001074, SystemName: "J131025"},
-	31001075: {RegionID: 11000012, SystemID: 31001075, SystemName: "J122854"},
-	31001076: {RegionID: 11000012, SystemID: 31001076, SystemName: "J132222"},
-	31001077: {RegionID: 11000012, SystemID: 31001077, SystemName: "J203753"},
-	31001078: {RegionID: 11000012, SystemID: 31001078, SystemName: "J130835"},
-	31001079: {RegionID: 11000012, SystemID: 31001079, SystemName: "J134123"},
-	31001080: {RegionID: 11000012, SystemID: 31001080, SystemName: "J133857"},
-	31001081: {RegionID: 11000012, SystemID: 31001081, SystemName: "J171813"},
-	31001082: {RegionID: 11000012, SystemID: 31001082, SystemName: "J143513"},
-	31001083: {RegionID: 11000012, SystemID: 31001083, SystemName: "J112656"},
-	31001084: {RegionID: 11000012, SystemID: 31001084, SystemName: "J154357"},
-	31001085: {RegionID: 11000012, SystemID: 31001085, SystemName: "J154145"},
-	31001086: {RegionID: 11000012, SystemID: 31001086, SystemName: "J100129"},
-	31001087: {RegionID: 11000012, SystemID: 31001087, SystemName: "J155124"},
-	31001088: {RegionID: 11000012, SystemID: 31001088, SystemName: "J133207"},
-	31001089: {RegionID: 11000012, SystemID: 31001089, SystemName: "J124830"},
-	31001090: {RegionID: 11000012, SystemID: 31001090, SystemName: "J144725"},
-	31001091: {RegionID: 11000012, SystemID: 31001091, SystemName: "J170409"},
-	31001092: {RegionID: 11000012, SystemID: 31001092, SystemName: "J103326"},
-	31001093: {RegionID: 11000012, SystemID: 31001093, SystemName: "J160739"},
-	31001094: {RegionID: 11000012, SystemID: 31001094, SystemName: "J133041"},
-	31001095: {RegionID: 11000012, SystemID: 31001095, SystemName: "J205412"},
-	31001096: {RegionID: 11000012, SystemID: 31001096, SystemName: "J145851"},
-	31001097: {RegionID: 11000012, SystemID: 31001097, SystemName: "J132737"},
-	31001098: {RegionID: 11000012, SystemID: 31001098, SystemName: "J130247"},
-	31001099: {RegionID: 11000012, SystemID: 31001099, SystemName: "J151405"},
-	31001100: {RegionID: 11000012, SystemID: 31001100, SystemName: "J131802"},
-	31001101: {RegionID: 11000012, SystemID: 31001101, SystemName: "J221515"},
-	31001102: {RegionID: 11000012, SystemID: 31001102, SystemName: "J142951"},
-	31001103: {RegionID: 11000012, SystemID: 31001103, SystemName: "J101736"},
-	31001104: {RegionID: 11000012, SystemID: 31001104, SystemName: "J233739"},
-	31001105: {RegionID: 11000012, SystemID: 31001105, SystemName: "J221447"},
-	31001106: {RegionID: 11000012, SystemID: 31001106, SystemName: "J145645"},
-	31001107: {RegionID: 11000012, SystemID: 31001107, SystemName: "J153051"},
-	31001108: {RegionID: 11000012, SystemID: 31001108, SystemName: "J141451"},
-	31001109: {RegionID: 11000012, SystemID: 31001109, SystemName: "J131859"},
-	31001110: {RegionID: 11000012, SystemID: 31001110, SystemName: "J144553"},
-	31001111: {RegionID: 11000012, SystemID: 31001111, SystemName: "J145615"},
-	31001112: {RegionID: 11000012, SystemID: 31001112, SystemName: "J223109"},
-	31001113: {RegionID: 11000012, SystemID: 31001113, SystemName: "J132721"},
-	31001114: {RegionID: 11000012, SystemID: 31001114, SystemName: "J103353"},
-	31001115: {RegionID: 11000012, SystemID: 31001115, SystemName: "J173223"},
-	31001116: {RegionID: 11000012, SystemID: 31001116, SystemName: "J104837"},
-	31001117: {RegionID: 11000012, SystemID: 31001117, SystemName: "J163745"},
-	31001118: {RegionID: 11000012, SystemID: 31001118, SystemName: "J101500"},
-	31001119: {RegionID: 11000012, SystemID: 31001119, SystemName: "J224826"},
-	31001120: {RegionID: 11000012, SystemID: 31001120, SystemName: "J230905"},
-	31001121: {RegionID: 11000012, SystemID: 31001121, SystemName: "J110924"},
-	31001122: {RegionID: 11000012, SystemID: 31001122, SystemName: "J122220"},
-	31001123: {RegionID: 11000012, SystemID: 31001123, SystemName: "J165153"},
-	31001124: {RegionID: 11000012, SystemID: 31001124, SystemName: "J204030"},
-	31001125: {RegionID: 11000012, SystemID: 31001125, SystemName: "J134136"},
-	31001126: {RegionID: 11000012, SystemID: 31001126, SystemName: "J113334"},
-	31001127: {RegionID: 11000012, SystemID: 31001127, SystemName: "J125403"},
-	31001128: {RegionID: 11000012, SystemID: 31001128, SystemName: "J162437"},
-	31001129: {RegionID: 11000012, SystemID: 31001129, SystemName: "J235630"},
-	31001130: {RegionID: 11000012, SystemID: 31001130, SystemName: "J105409"},
-	31001131: {RegionID: 11000012, SystemID: 31001131, SystemName: "J163529"},
-	31001132: {RegionID: 11000012, SystemID: 31001132, SystemName: "J152931"},
-	31001133: {RegionID: 11000012, SystemID: 31001133, SystemName: "J131559"},
-	31001134: {RegionID: 11000012, SystemID: 31001134, SystemName: "J143918"},
-	31001135: {RegionID: 11000012, SystemID: 31001135, SystemName: "J104643"},
-	31001136: {RegionID: 11000012, SystemID: 31001136, SystemName: "J212304"},
-	31001137: {RegionID: 11000012, SystemID: 31001137, SystemName: "J154634"},
-	31001138: {RegionID: 11000012, SystemID: 31001138, SystemName: "J172052"},
-	31001139: {RegionID: 11000012, SystemID: 31001139, SystemName: "J135036"},
-	31001140: {RegionID: 11000012, SystemID: 31001140, SystemName: "J134431"},
-	31001141: {RegionID: 11000012, SystemID: 31001141, SystemName: "J121105"},
-	31001142: {RegionID: 11000012, SystemID: 31001142, SystemName: "J120357"},
-	31001143: {RegionID: 11000012, SystemID: 31001143, SystemName: "J101854"},
-	31001144: {RegionID: 11000012, SystemID: 31001144, SystemName: "J232826"},
-	31001145: {RegionID: 11000012, SystemID: 31001145, SystemName: "J154934"},
-	31001146: {RegionID: 11000012, SystemID: 31001146, SystemName: "J170132"},
-	31001147: {RegionID: 11000012, SystemID: 31001147, SystemName: "J114916"},
-	31001148: {RegionID: 11000012, SystemID: 31001148, SystemName: "J142400"},
-	31001149: {RegionID: 11000012, SystemID: 31001149, SystemName: "J115502"},
-	31001150: {RegionID: 11000012, SystemID: 31001150, SystemName: "J121300"},
-	31001151: {RegionID: 11000012, SystemID: 31001151, SystemName: "J223658"},
-	31001152: {RegionID: 11000012, SystemID: 31001152, SystemName: "J124327"},
-	31001153: {RegionID: 11000012, SystemID: 31001153, SystemName: "J220438"},
-	31001154: {RegionID: 11000012, SystemID: 31001154, SystemName: "J144636"},
-	31001155: {RegionID: 11000012, SystemID: 31001155, SystemName: "J113347"},
-	31001156: {RegionID: 11000012, SystemID: 31001156, SystemName: "J150737"},
-	31001157: {RegionID: 11000012, SystemID: 31001157, SystemName: "J152044"},
-	31001158: {RegionID: 11000012, SystemID: 31001158, SystemName: "J142443"},
-	31001159: {RegionID: 11000012, SystemID: 31001159, SystemName: "J164104"},
-	31001160: {RegionID: 11000012, SystemID: 31001160, SystemName: "J155008"},
-	31001161: {RegionID: 11000012, SystemID: 31001161, SystemName: "J114358"},
-	31001162: {RegionID: 11000012, SystemID: 31001162, SystemName: "J122259"},
-	31001163: {RegionID: 11000012, SystemID: 31001163, SystemName: "J125243"},
-	31001164: {RegionID: 11000012, SystemID: 31001164, SystemName: "J114508"},
-	31001165: {RegionID: 11000012, SystemID: 31001165, SystemName: "J125216"},
-	31001166: {RegionID: 11000012, SystemID: 31001166, SystemName: "J113143"},
-	31001167: {RegionID: 11000012, SystemID: 31001167, SystemName: "J101612"},
-	31001168: {RegionID: 11000012, SystemID: 31001168, SystemName: "J101354"},
-	31001169: {RegionID: 11000012, SystemID: 31001169, SystemName: "J100156"},
-	31001170: {RegionID: 11000012, SystemID: 31001170, SystemName: "J110425"},
-	31001171: {RegionID: 11000012, SystemID: 31001171, SystemName: "J133121"},
-	31001172: {RegionID: 11000012, SystemID: 31001172, SystemName: "J165936"},
-	31001173: {RegionID: 11000012, SystemID: 31001173, SystemName: "J213411"},
-	31001174: {RegionID: 11000012, SystemID: 31001174, SystemName: "J231545"},
-	31001175: {RegionID: 11000012, SystemID: 31001175, SystemName: "J121603"},
-	31001176: {RegionID: 11000012, SystemID: 31001176, SystemName: "J222120"},
-	31001177: {RegionID: 11000012, SystemID: 31001177, SystemName: "J231240"},
-	31001178: {RegionID: 11000013, SystemID: 31001178, SystemName: "J164338"},
-	31001179: {RegionID: 11000013, SystemID: 31001179, SystemName: "J142603"},
-	31001180: {RegionID: 11000013, SystemID: 31001180, SystemName: "J212812"},
-	31001181: {RegionID: 11000013, SystemID: 31001181, SystemName: "J164501"},
-	31001182: {RegionID: 11000013, SystemID: 31001182, SystemName: "J144751"},
-	31001183: {RegionID: 11000013, SystemID: 31001183, SystemName: "J214744"},
-	31001184: {RegionID: 11000013, SystemID: 31001184, SystemName: "J135304"},
-	31001185: {RegionID: 11000013, SystemID: 31001185, SystemName: "J223601"},
-	31001186: {RegionID: 11000013, SystemID: 31001186, SystemName: "J103529"},
-	31001187: {RegionID: 11000013, SystemID: 31001187, SystemName: "J161051"},
-	31001188: {RegionID: 11000013, SystemID: 31001188, SystemName: "J224352"},
-	31001189: {RegionID: 11000013, SystemID: 31001189, SystemName: "J113048"},
-	31001190: {RegionID: 11000013, SystemID: 31001190, SystemName: "J140322"},
-	31001191: {RegionID: 11000013, SystemID: 31001191, SystemName: "J152741"},
-	31001192: {RegionID: 11000013, SystemID: 31001192, SystemName: "J222732"},
-	31001193: {RegionID: 11000013, SystemID: 31001193, SystemName: "J171542"},
-	31001194: {RegionID: 11000013, SystemID: 31001194, SystemName: "J205136"},
-	31001195: {RegionID: 11000013, SystemID: 31001195, SystemName: "J121706"},
-	31001196: {RegionID: 11000013, SystemID: 31001196, SystemName: "J143110"},
-	31001197: {RegionID: 11000013, SystemID: 31001197, SystemName: "J110121"},
-	31001198: {RegionID: 11000013, SystemID: 31001198, SystemName: "J104626"},
-	31001199: {RegionID: 11000013, SystemID: 31001199, SystemName: "J212159"},
-	31001200: {RegionID: 11000013, SystemID: 31001200, SystemName: "J151733"},
-	31001201: {RegionID: 11000013, SystemID: 31001201, SystemName: "J103032"},
-	31001202: {RegionID: 11000013, SystemID: 31001202, SystemName: "J150048"},
-	31001203: {RegionID: 11000013, SystemID: 31001203, SystemName: "J113432"},
-	31001204: {RegionID: 11000013, SystemID: 31001204, SystemName: "J134629"},
-	31001205: {RegionID: 11000013, SystemID: 31001205, SystemName: "J113339"},
-	31001206: {RegionID: 11000013, SystemID: 31001206, SystemName: "J122041"},
-	31001207: {RegionID: 11000013, SystemID: 31001207, SystemName: "J130719"},
-	31001208: {RegionID: 11000013, SystemID: 31001208, SystemName: "J204039"},
-	31001209: {RegionID: 11000013, SystemID: 31001209, SystemName: "J101048"},
-	31001210: {RegionID: 11000013, SystemID: 31001210, SystemName: "J114119"},
-	31001211: {RegionID: 11000013, SystemID: 31001211, SystemName: "J110411"},
-	31001212: {RegionID: 11000013, SystemID: 31001212, SystemName: "J104903"},
-	31001213: {RegionID: 11000013, SystemID: 31001213, SystemName: "J151433"},
-	31001214: {RegionID: 11000013, SystemID: 31001214, SystemName: "J143040"},
-	31001215: {RegionID: 11000013, SystemID: 31001215, SystemName: "J133521"},
-	31001216: {RegionID: 11000013, SystemID: 31001216, SystemName: "J163911"},
-	31001217: {RegionID: 11000013, SystemID: 31001217, SystemName: "J204842"},
-	31001218: {RegionID: 11000013, SystemID: 31001218, SystemName: "J135214"},
-	31001219: {RegionID: 11000013, SystemID: 31001219, SystemName: "J131716"},
-	31001220: {RegionID: 11000013, SystemID: 31001220, SystemName: "J225350"},
-	31001221: {RegionID: 11000014, SystemID: 31001221, SystemName: "J105447"},
-	31001222: {RegionID: 11000014, SystemID: 31001222, SystemName: "J144103"},
-	31001223: {RegionID: 11000014, SystemID: 31001223, SystemName: "J165847"},
-	31001224: {RegionID: 11000014, SystemID: 31001224, SystemName: "J121921"},
-	31001225: {RegionID: 11000014, SystemID: 31001225, SystemName: "J212025"},
-	31001226: {RegionID: 11000014, SystemID: 31001226, SystemName: "J145717"},
-	31001227: {RegionID: 11000014, SystemID: 31001227, SystemName: "J113705"},
-	31001228: {RegionID: 11000014, SystemID: 31001228, SystemName: "J132037"},
-	31001229: {RegionID: 11000014, SystemID: 31001229, SystemName: "J224401"},
-	31001230: {RegionID: 11000014, SystemID: 31001230, SystemName: "J152544"},
-	31001231: {RegionID: 11000014, SystemID: 31001231, SystemName: "J114506"},
-	31001232: {RegionID: 11000014, SystemID: 31001232, SystemName: "J231245"},
-	31001233: {RegionID: 11000014, SystemID: 31001233, SystemName: "J151804"},
-	31001234: {RegionID: 11000014, SystemID: 31001234, SystemName: "J105033"},
-	31001235: {RegionID: 11000014, SystemID: 31001235, SystemName: "J214600"},
-	31001236: {RegionID: 11000014, SystemID: 31001236, SystemName: "J152333"},
-	31001237: {RegionID: 11000014, SystemID: 31001237, SystemName: "J131245"},
-	31001238: {RegionID: 11000014, SystemID: 31001238, SystemName: "J151045"},
-	31001239: {RegionID: 11000014, SystemID: 31001239, SystemName: "J160822"},
-	31001240: {RegionID: 11000014, SystemID: 31001240, SystemName: "J125944"},
-	31001241: {RegionID: 11000014, SystemID: 31001241, SystemName: "J173330"},
-	31001242: {RegionID: 11000014, SystemID: 31001242, SystemName: "J115512"},
-	31001243: {RegionID: 11000014, SystemID: 31001243, SystemName: "J161854"},
-	31001244: {RegionID: 11000014, SystemID: 31001244, SystemName: "J122442"},
-	31001245: {RegionID: 11000014, SystemID: 31001245, SystemName: "J114010"},
-	31001246: {RegionID: 11000014, SystemID: 31001246, SystemName: "J153546"},
-	31001247: {RegionID: 11000014, SystemID: 31001247, SystemName: "J141046"},
-	31001248: {RegionID: 11000014, SystemID: 31001248, SystemName: "J160111"},
-	31001249: {RegionID: 11000014, SystemID: 31001249, SystemName: "J101243"},
-	31001250: {RegionID: 11000014, SystemID: 31001250, SystemName: "J214009"},
-	31001251: {RegionID: 11000014, SystemID: 31001251, SystemName: "J152757"},
-	31001252: {RegionID: 11000014, SystemID: 31001252, SystemName: "J164729"},
-	31001253: {RegionID: 11000014, SystemID: 31001253, SystemName: "J171420"},
-	31001254: {RegionID: 11000014, SystemID: 31001254, SystemName: "J232441"},
-	31001255: {RegionID: 11000014, SystemID: 31001255, SystemName: "J131656"},
-	31001256: {RegionID: 11000014, SystemID: 31001256, SystemName: "J135406"},
-	31001257: {RegionID: 11000014, SystemID: 31001257, SystemName: "J230708"},
-	31001258: {RegionID: 11000014, SystemID: 31001258, SystemName: "J120124"},
-	31001259: {RegionID: 11000014, SystemID: 31001259, SystemName: "J132746"},
-	31001260: {RegionID: 11000014, SystemID: 31001260, SystemName: "J212207"},
-	31001261: {RegionID: 11000014, SystemID: 31001261, SystemName: "J104416"},
-	31001262: {RegionID: 11000014, SystemID: 31001262, SystemName: "J104841"},
-	31001263: {RegionID: 11000014, SystemID: 31001263, SystemName: "J172701"},
-	31001264: {RegionID: 11000014, SystemID: 31001264, SystemName: "J104835"},
-	31001265: {RegionID: 11000014, SystemID: 31001265, SystemName: "J113647"},
-	31001266: {RegionID: 11000014, SystemID: 31001266, SystemName: "J144316"},
-	31001267: {RegionID: 11000014, SystemID: 31001267, SystemName: "J114612"},
-	31001268: {RegionID: 11000014, SystemID: 31001268, SystemName: "J130759"},
-	31001269: {RegionID: 11000014, SystemID: 31001269, SystemName: "J164751"},
-	31001270: {RegionID: 11000014, SystemID: 31001270, SystemName: "J131324"},
-	31001271: {RegionID: 11000014, SystemID: 31001271, SystemName: "J230722"},
-	31001272: {RegionID: 11000014, SystemID: 31001272, SystemName: "J134118"},
-	31001273: {RegionID: 11000014, SystemID: 31001273, SystemName: "J164223"},
-	31001274: {RegionID: 11000014, SystemID: 31001274, SystemName: "J154449"},
-	31001275: {RegionID: 11000014, SystemID: 31001275, SystemName: "J172815"},
-	31001276: {RegionID: 11000014, SystemID: 31001276, SystemName: "J151242"},
-	31001277: {RegionID: 11000014, SystemID: 31001277, SystemName: "J213423"},
-	31001278: {RegionID: 11000014, SystemID: 31001278, SystemName: "J145555"},
-	31001279: {RegionID: 11000014, SystemID: 31001279, SystemName: "J101323"},
-	31001280: {RegionID: 11000014, SystemID: 31001280, SystemName: "J112954"},
-	31001281: {RegionID: 11000014, SystemID: 31001281, SystemName: "J133951"},
-	31001282: {RegionID: 11000014, SystemID: 31001282, SystemName: "J150444"},
-	31001283: {RegionID: 11000014, SystemID: 31001283, SystemName: "J153858"},
-	31001284: {RegionID: 11000014, SystemID: 31001284, SystemName: "J172422"},
-	31001285: {RegionID: 11000014, SystemID: 31001285, SystemName: "J155545"},
-	31001286: {RegionID: 11000014, SystemID: 31001286, SystemName: "J161441"},
-	31001287: {RegionID: 11000014, SystemID: 31001287, SystemName: "J125449"},
-	31001288: {RegionID: 11000014, SystemID: 31001288, SystemName: "J210445"},
-	31001289: {RegionID: 11000014, SystemID: 31001289, SystemName: "J123443"},
-	31001290: {RegionID: 11000014, SystemID: 31001290, SystemName: "J121422"},
-	31001291: {RegionID: 11000014, SystemID: 31001291, SystemName: "J155403"},
-	31001292: {RegionID: 11000014, SystemID: 31001292, SystemName: "J103724"},
-	31001293: {RegionID: 11000014, SystemID: 31001293, SystemName: "J112948"},
-	31001294: {RegionID: 11000014, SystemID: 31001294, SystemName: "J235330"},
-	31001295: {RegionID: 11000014, SystemID: 31001295, SystemName: "J121507"},
-	31001296: {RegionID: 11000014, SystemID: 31001296, SystemName: "J235712"},
-	31001297: {RegionID: 11000014, SystemID: 31001297, SystemName: "J125016"},
-	31001298: {RegionID: 11000014, SystemID: 31001298, SystemName: "J151516"},
-	31001299: {RegionID: 11000014, SystemID: 31001299, SystemName: "J132226"},
-	31001300: {RegionID: 11000014, SystemID: 31001300, SystemName: "J170656"},
-	31001301: {RegionID: 11000014, SystemID: 31001301, SystemName: "J155722"},
-	31001302: {RegionID: 11000014, SystemID: 31001302, SystemName: "J150135"},
-	31001303: {RegionID: 11000014, SystemID: 31001303, SystemName: "J151645"},
-	31001304: {RegionID: 11000014, SystemID: 31001304, SystemName: "J211151"},
-	31001305: {RegionID: 11000014, SystemID: 31001305, SystemName: "J213109"},
-	31001306: {RegionID: 11000014, SystemID: 31001306, SystemName: "J233628"},
-	31001307: {RegionID: 11000014, SystemID: 31001307, SystemName: "J102433"},
-	31001308: {RegionID: 11000014, SystemID: 31001308, SystemName: "J111823"},
-	31001309: {RegionID: 11000014, SystemID: 31001309, SystemName: "J211805"},
-	31001310: {RegionID: 11000014, SystemID: 31001310, SystemName: "J172028"},
-	31001311: {RegionID: 11000014, SystemID: 31001311, SystemName: "J101833"},
-	31001312: {RegionID: 11000014, SystemID: 31001312, SystemName: "J104815"},
-	31001313: {RegionID: 11000014, SystemID: 31001313, SystemName: "J124635"},
-	31001314: {RegionID: 11000014, SystemID: 31001314, SystemName: "J110738"},
-	31001315: {RegionID: 11000014, SystemID: 31001315, SystemName: "J170550"},
-	31001316: {RegionID: 11000014, SystemID: 31001316, SystemName: "J143704"},
-	31001317: {RegionID: 11000015, SystemID: 31001317, SystemName: "J143614"},
-	31001318: {RegionID: 11000015, SystemID: 31001318, SystemName: "J170236"},
-	31001319: {RegionID: 11000015, SystemID: 31001319, SystemName: "J153034"},
-	31001320: {RegionID: 11000015, SystemID: 31001320, SystemName: "J220338"},
-	31001321: {RegionID: 11000015, SystemID: 31001321, SystemName: "J171828"},
-	31001322: {RegionID: 11000015, SystemID: 31001322, SystemName: "J112942"},
-	31001323: {RegionID: 11000015, SystemID: 31001323, SystemName: "J112709"},
-	31001324: {RegionID: 11000015, SystemID: 31001324, SystemName: "J161213"},
-	31001325: {RegionID: 11000015, SystemID: 31001325, SystemName: "J140739"},
-	31001326: {RegionID: 11000015, SystemID: 31001326, SystemName: "J213534"},
-	31001327: {RegionID: 11000015, SystemID: 31001327, SystemName: "J165224"},
-	31001328: {RegionID: 11000015, SystemID: 31001328, SystemName: "J170144"},
-	31001329: {RegionID: 11000015, SystemID: 31001329, SystemName: "J162010"},
-	31001330: {RegionID: 11000015, SystemID: 31001330, SystemName: "J110226"},
-	31001331: {RegionID: 11000015, SystemID: 31001331, SystemName: "J104421"},
-	31001332: {RegionID: 11000015, SystemID: 31001332, SystemName: "J104330"},
-	31001333: {RegionID: 11000015, SystemID: 31001333, SystemName: "J170376"},
-	31001334: {RegionID: 11000015, SystemID: 31001334, SystemName: "J100338"},
-	31001335: {RegionID: 11000015, SystemID: 31001335, SystemName: "J110034"},
-	31001336: {RegionID: 11000015, SystemID: 31001336, SystemName: "J124207"},
-	31001337: {RegionID: 11000015, SystemID: 31001337, SystemName: "J132907"},
-	31001338: {RegionID: 11000015, SystemID: 31001338, SystemName: "J100015"},
-	31001339: {RegionID: 11000015, SystemID: 31001339, SystemName: "J144024"},
-	31001340: {RegionID: 11000015, SystemID: 31001340, SystemName: "J122806"},
-	31001341: {RegionID: 11000015, SystemID: 31001341, SystemName: "J133250"},
-	31001342: {RegionID: 11000015, SystemID: 31001342, SystemName: "J135852"},
-	31001343: {RegionID: 11000015, SystemID: 31001343, SystemName: "J130616"},
-	31001344: {RegionID: 11000015, SystemID: 31001344, SystemName: "J204323"},
-	31001345: {RegionID: 11000015, SystemID: 31001345, SystemName: "J120742"},
-	31001346: {RegionID: 11000015, SystemID: 31001346, SystemName: "J105549"},
-	31001347: {RegionID: 11000015, SystemID: 31001347, SystemName: "J103237"},
-	31001348: {RegionID: 11000015, SystemID: 31001348, SystemName: "J215326"},
-	31001349: {RegionID: 11000015, SystemID: 31001349, SystemName: "J121700"},
-	31001350: {RegionID: 11000015, SystemID: 31001350, SystemName: "J124358"},
-	31001351: {RegionID: 11000015, SystemID: 31001351, SystemName: "J112420"},
-	31001352: {RegionID: 11000015, SystemID: 31001352, SystemName: "J130022"},
-	31001353: {RegionID: 11000015, SystemID: 31001353, SystemName: "J155419"},
-	31001354: {RegionID: 11000015, SystemID: 31001354, SystemName: "J135723"},
-	31001355: {RegionID: 11000015, SystemID: 31001355, SystemName: "J105201"},
-	31001356: {RegionID: 11000015, SystemID: 31001356, SystemName: "J133218"},
-	31001357: {RegionID: 11000015, SystemID: 31001357, SystemName: "J143345"},
-	31001358: {RegionID: 11000015, SystemID: 31001358, SystemName: "J115448"},
-	31001359: {RegionID: 11000015, SystemID: 31001359, SystemName: "J135642"},
-	31001360: {RegionID: 11000015, SystemID: 31001360, SystemName: "J205900"},
-	31001361: {RegionID: 11000015, SystemID: 31001361, SystemName: "J112747"},
-	31001362: {RegionID: 11000015, SystemID: 31001362, SystemName: "J121027"},
-	31001363: {RegionID: 11000015, SystemID: 31001363, SystemName: "J124744"},
-	31001364: {RegionID: 11000015, SystemID: 31001364, SystemName: "J161303"},
-	31001365: {RegionID: 11000015, SystemID: 31001365, SystemName: "J141848"},
-	31001366: {RegionID: 11000015, SystemID: 31001366, SystemName: "J104001"},
-	31001367: {RegionID: 11000015, SystemID: 31001367, SystemName: "J102304"},
-	31001368: {RegionID: 11000015, SystemID: 31001368, SystemName: "J172852"},
-	31001369: {RegionID: 11000015, SystemID: 31001369, SystemName: "J131304"},
-	31001370: {RegionID: 11000015, SystemID: 31001370, SystemName: "J145632"},
-	31001371: {RegionID: 11000015, SystemID: 31001371, SystemName: "J102515"},
-	31001372: {RegionID: 11000015, SystemID: 31001372, SystemName: "J160645"},
-	31001373: {RegionID: 11000015, SystemID: 31001373, SystemName: "J135129"},
-	31001374: {RegionID: 11000015, SystemID: 31001374, SystemName: "J115909"},
-	31001375: {RegionID: 11000016, SystemID: 31001375, SystemName: "J160225"},
-	31001376: {RegionID: 11000016, SystemID: 31001376, SystemName: "J210458"},
-	31001377: {RegionID: 11000016, SystemID: 31001377, SystemName: "J113925"},
-	31001378: {RegionID: 11000016, SystemID: 31001378, SystemName: "J204815"},
-	31001379: {RegionID: 11000016, SystemID: 31001379, SystemName: "J104754"},
-	31001380: {RegionID: 11000016, SystemID: 31001380, SystemName: "J130518"},
-	31001381: {RegionID: 11000016, SystemID: 31001381, SystemName: "J142200"},
-	31001382: {RegionID: 11000016, SystemID: 31001382, SystemName: "J103731"},
-	31001383: {RegionID: 11000016, SystemID: 31001383, SystemName: "J100046"},
-	31001384: {RegionID: 11000016, SystemID: 31001384, SystemName: "J230301"},
-	31001385: {RegionID: 11000016, SystemID: 31001385, SystemName: "J165839"},
-	31001386: {RegionID: 11000016, SystemID: 31001386, SystemName: "J235953"},
-	31001387: {RegionID: 11000016, SystemID: 31001387, SystemName: "J115018"},
-	31001388: {RegionID: 11000016, SystemID: 31001388, SystemName: "J133458"},
-	31001389: {RegionID: 11000016, SystemID: 31001389, SystemName: "J221859"},
-	31001390: {RegionID: 11000016, SystemID: 31001390, SystemName: "J170215"},
-	31001391: {RegionID: 11000016, SystemID: 31001391, SystemName: "J165820"},
-	31001392: {RegionID: 11000016, SystemID: 31001392, SystemName: "J130253"},
-	31001393: {RegionID: 11000016, SystemID: 31001393, SystemName: "J120937"},
-	31001394: {RegionID: 11000016, SystemID: 31001394, SystemName: "J214811"},
-	31001395: {RegionID: 11000016, SystemID: 31001395, SystemName: "J160419"},
-	31001396: {RegionID: 11000016, SystemID: 31001396, SystemName: "J161752"},
-	31001397: {RegionID: 11000016, SystemID: 31001397, SystemName: "J112610"},
-	31001398: {RegionID: 11000016, SystemID: 31001398, SystemName: "J123726"},
-	31001399: {RegionID: 11000016, SystemID: 31001399, SystemName: "J124837"},
-	31001400: {RegionID: 11000016, SystemID: 31001400, SystemName: "J151643"},
-	31001401: {RegionID: 11000016, SystemID: 31001401, SystemName: "J160321"},
-	31001402: {RegionID: 11000016, SystemID: 31001402, SystemName: "J165308"},
-	31001403: {RegionID: 11000016, SystemID: 31001403, SystemName: "J171359"},
-	31001404: {RegionID: 11000016, SystemID: 31001404, SystemName: "J115547"},
-	31001405: {RegionID: 11000016, SystemID: 31001405, SystemName: "J141055"},
-	31001406: {RegionID: 11000016, SystemID: 31001406, SystemName: "J153202"},
-	31001407: {RegionID: 11000016, SystemID: 31001407, SystemName: "J121845"},
-	31001408: {RegionID: 11000016, SystemID: 31001408, SystemName: "J113456"},
-	31001409: {RegionID: 11000016, SystemID: 31001409, SystemName: "J144746"},
-	31001410: {RegionID: 11000016, SystemID: 31001410, SystemName: "J171554"},
-	31001411: {RegionID: 11000016, SystemID: 31001411, SystemName: "J111029"},
-	31001412: {RegionID: 11000016, SystemID: 31001412, SystemName: "J145129"},
-	31001413: {RegionID: 11000016, SystemID: 31001413, SystemName: "J113730"},
-	31001414: {RegionID: 11000016, SystemID: 31001414, SystemName: "J100956"},
-	31001415: {RegionID: 11000016, SystemID: 31001415, SystemName: "J134407"},
-	31001416: {RegionID: 11000016, SystemID: 31001416, SystemName: "J101507"},
-	31001417: {RegionID: 11000016, SystemID: 31001417, SystemName: "J105546"},
-	31001418: {RegionID: 11000016, SystemID: 31001418, SystemName: "J123528"},
-	31001419: {RegionID: 11000016, SystemID: 31001419, SystemName: "J145318"},
-	31001420: {RegionID: 11000016, SystemID: 31001420, SystemName: "J132611"},
-	31001421: {RegionID: 11000016, SystemID: 31001421, SystemName: "J153338"},
-	31001422: {RegionID: 11000016, SystemID: 31001422, SystemName: "J102534"},
-	31001423: {RegionID: 11000016, SystemID: 31001423, SystemName: "J144519"},
-	31001424: {RegionID: 11000016, SystemID: 31001424, SystemName: "J141218"},
-	31001425: {RegionID: 11000016, SystemID: 31001425, SystemName: "J152255"},
-	31001426: {RegionID: 11000016, SystemID: 31001426, SystemName: "J142241"},
-	31001427: {RegionID: 11000016, SystemID: 31001427, SystemName: "J131047"},
-	31001428: {RegionID: 11000016, SystemID: 31001428, SystemName: "J100150"},
-	31001429: {RegionID: 11000016, SystemID: 31001429, SystemName: "J104311"},
-	31001430: {RegionID: 11000016, SystemID: 31001430, SystemName: "J111950"},
-	31001431: {RegionID: 11000016, SystemID: 31001431, SystemName: "J132754"},
-	31001432: {RegionID: 11000016, SystemID: 31001432, SystemName: "J101551"},
-	31001433: {RegionID: 11000016, SystemID: 31001433, SystemName: "J211027"},
-	31001434: {RegionID: 11000016, SystemID: 31001434, SystemName: "J105415"},
-	31001435: {RegionID: 11000017, SystemID: 31001435, SystemName: "J224148"},
-	31001436: {RegionID: 11000017, SystemID: 31001436, SystemName: "J100806"},
-	31001437: {RegionID: 11000017, SystemID: 31001437, SystemName: "J133638"},
-	31001438: {RegionID: 11000017, SystemID: 31001438, SystemName: "J231614"},
-	31001439: {RegionID: 11000017, SystemID: 31001439, SystemName: "J103110"},
-	31001440: {RegionID: 11000017, SystemID: 31001440, SystemName: "J101706"},
-	31001441: {RegionID: 11000017, SystemID: 31001441, SystemName: "J142214"},
-	31001442: {RegionID: 11000017, SystemID: 31001442, SystemName: "J155340"},
-	31001443: {RegionID: 11000017, SystemID: 31001443, SystemName: "J165326"},
-	31001444: {RegionID: 11000017, SystemID: 31001444, SystemName: "J133113"},
-	31001445: {RegionID: 11000017, SystemID: 31001445, SystemName: "J122656"},
-	31001446: {RegionID: 11000017, SystemID: 31001446, SystemName: "J134716"},
-	31001447: {RegionID: 11000017, SystemID: 31001447, SystemName: "J171344"},
-	31001448: {RegionID: 11000017, SystemID: 31001448, SystemName: "J161119"},
-	31001449: {RegionID: 11000017, SystemID: 31001449, SystemName: "J111404"},
-	31001450: {RegionID: 11000017, SystemID: 31001450, SystemName: "J105346"},
-	31001451: {RegionID: 11000017, SystemID: 31001451, SystemName: "J154631"},
-	31001452: {RegionID: 11000017, SystemID: 31001452, SystemName: "J150112"},
-	31001453: {RegionID: 11000017, SystemID: 31001453, SystemName: "J104649"},
-	31001454: {RegionID: 11000017, SystemID: 31001454, SystemName: "J234421"},
-	31001455: {RegionID: 11000017, SystemID: 31001455, SystemName: "J105726"},
-	31001456: {RegionID: 11000017, SystemID: 31001456, SystemName: "J111758"},
-	31001457: {RegionID: 11000017, SystemID: 31001457, SystemName: "J142923"},
-	31001458: {RegionID: 11000017, SystemID: 31001458, SystemName: "J114026"},
-	31001459: {RegionID: 11000017, SystemID: 31001459, SystemName: "J115118"},
-	31001460: {RegionID: 11000018, SystemID: 31001460, SystemName: "J162226"},
-	31001461: {RegionID: 11000018, SystemID: 31001461, SystemName: "J165020"},
-	31001462: {RegionID: 11000018, SystemID: 31001462, SystemName: "J125302"},
-	31001463: {RegionID: 11000018, SystemID: 31001463, SystemName: "J113754"},
-	31001464: {RegionID: 11000018, SystemID: 31001464, SystemName: "J153215"},
-	31001465: {RegionID: 11000018, SystemID: 31001465, SystemName: "J213820"},
-	31001466: {RegionID: 11000018, SystemID: 31001466, SystemName: "J111555"},
-	31001467: {RegionID: 11000018, SystemID: 31001467, SystemName: "J131553"},
-	31001468: {RegionID: 11000018, SystemID: 31001468, SystemName: "J143841"},
-	31001469: {RegionID: 11000018, SystemID: 31001469, SystemName: "J113250"},
-	31001470: {RegionID: 11000018, SystemID: 31001470, SystemName: "J204635"},
-	31001471: {RegionID: 11000018, SystemID: 31001471, SystemName: "J212954"},
-	31001472: {RegionID: 11000018, SystemID: 31001472, SystemName: "J132309"},
-	31001473: {RegionID: 11000018, SystemID: 31001473, SystemName: "J231341"},
-	31001474: {RegionID: 11000018, SystemID: 31001474, SystemName: "J160800"},
-	31001475: {RegionID: 11000018, SystemID: 31001475, SystemName: "J155338"},
-	31001476: {RegionID: 11000018, SystemID: 31001476, SystemName: "J132149"},
-	31001477: {RegionID: 11000018, SystemID: 31001477, SystemName: "J171937"},
-	31001478: {RegionID: 11000018, SystemID: 31001478, SystemName: "J152443"},
-	31001479: {RegionID: 11000018, SystemID: 31001479, SystemName: "J111329"},
-	31001480: {RegionID: 11000018, SystemID: 31001480, SystemName: "J171334"},
-	31001481: {RegionID: 11000018, SystemID: 31001481, SystemName: "J115552"},
-	31001482: {RegionID: 11000018, SystemID: 31001482, SystemName: "J151520"},
-	31001483: {RegionID: 11000018, SystemID: 31001483, SystemName: "J165920"},
-	31001484: {RegionID: 11000018, SystemID: 31001484, SystemName: "J145838"},
-	31001485: {RegionID: 11000018, SystemID: 31001485, SystemName: "J125031"},
-	31001486: {RegionID: 11000018, SystemID: 31001486, SystemName: "J110736"},
-	31001487: {RegionID: 11000018, SystemID: 31001487, SystemName: "J165423"},
-	31001488: {RegionID: 11000018, SystemID: 31001488, SystemName: "J155521"},
-	31001489: {RegionID: 11000018, SystemID: 31001489, SystemName: "J135508"},
-	31001490: {RegionID: 11000018, SystemID: 31001490, SystemName: "J103008"},
-	31001491: {RegionID: 11000018, SystemID: 31001491, SystemName: "J103704"},
-	31001492: {RegionID: 11000018, SystemID: 31001492, SystemName: "J104603"},
-	31001493: {RegionID: 11000018, SystemID: 31001493, SystemName: "J163641"},
-	31001494: {RegionID: 11000018, SystemID: 31001494, SystemName: "J203952"},
-	31001495: {RegionID: 11000018, SystemID: 31001495, SystemName: "J105411"},
-	31001496: {RegionID: 11000018, SystemID: 31001496, SystemName: "J101126"},
-	31001497: {RegionID: 11000018, SystemID: 31001497, SystemName: "J122155"},
-	31001498: {RegionID: 11000018, SystemID: 31001498, SystemName: "J110016"},
-	31001499: {RegionID: 11000018, SystemID: 31001499, SystemName: "J212417"},
-	31001500: {RegionID: 11000018, SystemID: 31001500, SystemName: "J142355"},
-	31001501: {RegionID: 11000018, SystemID: 31001501, SystemName: "J121347"},
-	31001502: {RegionID: 11000018, SystemID: 31001502, SystemName: "J172915"},
-	31001503: {RegionID: 11000018, SystemID: 31001503, SystemName: "J155416"},
-	31001504: {RegionID: 11000018, SystemID: 31001504, SystemName: "J211258"},
-	31001505: {RegionID: 11000018, SystemID: 31001505, SystemName: "J120643"},
-	31001506: {RegionID: 11000019, SystemID: 31001506, SystemName: "J143946"},
-	31001507: {RegionID: 11000019, SystemID: 31001507, SystemName: "J125247"},
-	31001508: {RegionID: 11000019, SystemID: 31001508, SystemName: "J144732"},
-	31001509: {RegionID: 11000019, SystemID: 31001509, SystemName: "J155711"},
-	31001510: {RegionID: 11000019, SystemID: 31001510, SystemName: "J214308"},
-	31001511: {RegionID: 11000019, SystemID: 31001511, SystemName: "J134851"},
-	31001512: {RegionID: 11000019, SystemID: 31001512, SystemName: "J145452"},
-	31001513: {RegionID: 11000019, SystemID: 31001513, SystemName: "J115026"},
-	31001514: {RegionID: 11000019, SystemID: 31001514, SystemName: "J151720"},
-	31001515: {RegionID: 11000019, SystemID: 31001515, SystemName: "J151353"},
-	31001516: {RegionID: 11000019, SystemID: 31001516, SystemName: "J113727"},
-	31001517: {RegionID: 11000019, SystemID: 31001517, SystemName: "J130944"},
-	31001518: {RegionID: 11000019, SystemID: 31001518, SystemName: "J145238"},
-	31001519: {RegionID: 11000019, SystemID: 31001519, SystemName: "J235219"},
-	31001520: {RegionID: 11000019, SystemID: 31001520, SystemName: "J172512"},
-	31001521: {RegionID: 11000019, SystemID: 31001521, SystemName: "J141322"},
-	31001522: {RegionID: 11000019, SystemID: 31001522, SystemName: "J210333"},
-	31001523: {RegionID: 11000019, SystemID: 31001523, SystemName: "J135836"},
-	31001524: {RegionID: 11000019, SystemID: 31001524, SystemName: "J101915"},
-	31001525: {RegionID: 11000019, SystemID: 31001525, SystemName: "J130203"},
-	31001526: {RegionID: 11000019, SystemID: 31001526, SystemName: "J110719"},
-	31001527: {RegionID: 11000019, SystemID: 31001527, SystemName: "J131208"},
-	31001528: {RegionID: 11000019, SystemID: 31001528, SystemName: "J121623"},
-	31001529: {RegionID: 11000019, SystemID: 31001529, SystemName: "J150637"},
-	31001530: {RegionID: 11000019, SystemID: 31001530, SystemName: "J130332"},
-	31001531: {RegionID: 11000019, SystemID: 31001531, SystemName: "J1226-0"},
-	31001532: {RegionID: 11000019, SystemID: 31001532, SystemName: "J114355"},
-	31001533: {RegionID: 11000019, SystemID: 31001533, SystemName: "J144401"},
-	31001534: {RegionID: 11000019, SystemID: 31001534, SystemName: "J103242"},
-	31001535: {RegionID: 11000019, SystemID: 31001535, SystemName: "J161815"},
-	31001536: {RegionID: 11000019, SystemID: 31001536, SystemName: "J132256"},
-	31001537: {RegionID: 11000019, SystemID: 31001537, SystemName: "J162819"},
-	31001538: {RegionID: 11000019, SystemID: 31001538, SystemName: "J131004"},
-	31001539: {RegionID: 11000019, SystemID: 31001539, SystemName: "J141931"},
-	31001540: {RegionID: 11000019, SystemID: 31001540, SystemName: "J112630"},
-	31001541: {RegionID: 11000019, SystemID: 31001541, SystemName: "J154109"},
-	31001542: {RegionID: 11000019, SystemID: 31001542, SystemName: "J153116"},
-	31001543: {RegionID: 11000019, SystemID: 31001543, SystemName: "J160455"},
-	31001544: {RegionID: 11000019, SystemID: 31001544, SystemName: "J102439"},
-	31001545: {RegionID: 11000019, SystemID: 31001545, SystemName: "J160459"},
-	31001546: {RegionID: 11000019, SystemID: 31001546, SystemName: "J131712"},
-	31001547: {RegionID: 11000019, SystemID: 31001547, SystemName: "J140244"},
-	31001548: {RegionID: 11000019, SystemID: 31001548, SystemName: "J150635"},
-	31001549: {RegionID: 11000019, SystemID: 31001549, SystemName: "J125213"},
-	31001550: {RegionID: 11000019, SystemID: 31001550, SystemName: "J154541"},
-	31001551: {RegionID: 11000019, SystemID: 31001551, SystemName: "J120409"},
-	31001552: {RegionID: 11000019, SystemID: 31001552, SystemName: "J140545"},
-	31001553: {RegionID: 11000019, SystemID: 31001553, SystemName: "J162251"},
-	31001554: {RegionID: 11000019, SystemID: 31001554, SystemName: "J120450"},
-	31001555: {RegionID: 11000019, SystemID: 31001555, SystemName: "J220151"},
-	31001556: {RegionID: 11000019, SystemID: 31001556, SystemName: "J104502"},
-	31001557: {RegionID: 11000019, SystemID: 31001557, SystemName: "J152654"},
-	31001558: {RegionID: 11000019, SystemID: 31001558, SystemName: "J124727"},
-	31001559: {RegionID: 11000019, SystemID: 31001559, SystemName: "J151940"},
-	31001560: {RegionID: 11000019, SystemID: 31001560, SystemName: "J212129"},
-	31001561: {RegionID: 11000019, SystemID: 31001561, SystemName: "J141633"},
-	31001562: {RegionID: 11000019, SystemID: 31001562, SystemName: "J145604"},
-	31001563: {RegionID: 11000019, SystemID: 31001563, SystemName: "J212851"},
-	31001564: {RegionID: 11000019, SystemID: 31001564, SystemName: "J145659"},
-	31001565: {RegionID: 11000019, SystemID: 31001565, SystemName: "J165719"},
-	31001566: {RegionID: 11000019, SystemID: 31001566, SystemName: "J154706"},
-	31001567: {RegionID: 11000019, SystemID: 31001567, SystemName: "J141252"},
-	31001568: {RegionID: 11000019, SystemID: 31001568, SystemName: "J112110"},
-	31001569: {RegionID: 11000019, SystemID: 31001569, SystemName: "J140912"},
-	31001570: {RegionID: 11000019, SystemID: 31001570, SystemName: "J165532"},
-	31001571: {RegionID: 11000019, SystemID: 31001571, SystemName: "J161037"},
-	31001572: {RegionID: 11000019, SystemID: 31001572, SystemName: "J130828"},
-	31001573: {RegionID: 11000019, SystemID: 31001573, SystemName: "J122934"},
-	31001574: {RegionID: 11000019, SystemID: 31001574, SystemName: "J163435"},
-	31001575: {RegionID: 11000019, SystemID: 31001575, SystemName: "J221855"},
-	31001576: {RegionID: 11000019, SystemID: 31001576, SystemName: "J124109"},
-	31001577: {RegionID: 11000019, SystemID: 31001577, SystemName: "J123458"},
-	31001578: {RegionID: 11000019, SystemID: 31001578, SystemName: "J134527"},
-	31001579: {RegionID: 11000019, SystemID: 31001579, SystemName: "J133157"},
-	31001580: {RegionID: 11000019, SystemID: 31001580, SystemName: "J141342"},
-	31001581: {RegionID: 11000019, SystemID: 31001581, SystemName: "J145757"},
-	31001582: {RegionID: 11000019, SystemID: 31001582, SystemName: "J130343"},
-	31001583: {RegionID: 11000019, SystemID: 31001583, SystemName: "J152628"},
-	31001584: {RegionID: 11000019, SystemID: 31001584, SystemName: "J112928"},
-	31001585: {RegionID: 11000019, SystemID: 31001585, SystemName: "J133358"},
-	31001586: {RegionID: 11000019, SystemID: 31001586, SystemName: "J102844"},
-	31001587: {RegionID: 11000019, SystemID: 31001587, SystemName: "J134732"},
-	31001588: {RegionID: 11000019, SystemID: 31001588, SystemName: "J213615"},
-	31001589: {RegionID: 11000019, SystemID: 31001589, SystemName: "J131037"},
-	31001590: {RegionID: 11000019, SystemID: 31001590, SystemName: "J154724"},
-	31001591: {RegionID: 11000019, SystemID: 31001591, SystemName: "J213139"},
-	31001592: {RegionID: 11000019, SystemID: 31001592, SystemName: "J103104"},
-	31001593: {RegionID: 11000019, SystemID: 31001593, SystemName: "J121116"},
-	31001594: {RegionID: 11000019, SystemID: 31001594, SystemName: "J142822"},
-	31001595: {RegionID: 11000019, SystemID: 31001595, SystemName: "J102521"},
-	31001596: {RegionID: 11000019, SystemID: 31001596, SystemName: "J215935"},
-	31001597: {RegionID: 11000019, SystemID: 31001597, SystemName: "J162303"},
-	31001598: {RegionID: 11000019, SystemID: 31001598, SystemName: "J102409"},
-	31001599: {RegionID: 11000019, SystemID: 31001599, SystemName: "J104714"},
-	31001600: {RegionID: 11000020, SystemID: 31001600, SystemName: "J171225"},
-	31001601: {RegionID: 11000020, SystemID: 31001601, SystemName: "J124306"},
-	31001602: {RegionID: 11000020, SystemID: 31001602, SystemName: "J135908"},
-	31001603: {RegionID: 11000020, SystemID: 31001603, SystemName: "J124930"},
-	31001604: {RegionID: 11000020, SystemID: 31001604, SystemName: "J162632"},
-	31001605: {RegionID: 11000020, SystemID: 31001605, SystemName: "J155313"},
-	31001606: {RegionID: 11000020, SystemID: 31001606, SystemName: "J211328"},
-	31001607: {RegionID: 11000020, SystemID: 31001607, SystemName: "J204506"},
-	31001608: {RegionID: 11000020, SystemID: 31001608, SystemName: "J133957"},
-	31001609: {RegionID: 11000020, SystemID: 31001609, SystemName: "J115304"},
-	31001610: {RegionID: 11000020, SystemID: 31001610, SystemName: "J112150"},
-	31001611: {RegionID: 11000020, SystemID: 31001611, SystemName: "J131252"},
-	31001612: {RegionID: 11000020, SystemID: 31001612, SystemName: "J133936"},
-	31001613: {RegionID: 11000020, SystemID: 31001613, SystemName: "J143140"},
-	31001614: {RegionID: 11000020, SystemID: 31001614, SystemName: "J154833"},
-	31001615: {RegionID: 11000020, SystemID: 31001615, SystemName: "J101331"},
-	31001616: {RegionID: 11000020, SystemID: 31001616, SystemName: "J151359"},
-	31001617: {RegionID: 11000020, SystemID: 31001617, SystemName: "J150951"},
-	31001618: {RegionID: 11000020, SystemID: 31001618, SystemName: "J140215"},
-	31001619: {RegionID: 11000020, SystemID: 31001619, SystemName: "J152421"},
-	31001620: {RegionID: 11000020, SystemID: 31001620, SystemName: "J130554"},
-	31001621: {RegionID: 11000020, SystemID: 31001621, SystemName: "J101604"},
-	31001622: {RegionID: 11000020, SystemID: 31001622, SystemName: "J150320"},
-	31001623: {RegionID: 11000020, SystemID: 31001623, SystemName: "J134414"},
-	31001624: {RegionID: 11000020, SystemID: 31001624, SystemName: "J131113"},
-	31001625: {RegionID: 11000020, SystemID: 31001625, SystemName: "J124933"},
-	31001626: {RegionID: 11000020, SystemID: 31001626, SystemName: "J150044"},
-	31001627: {RegionID: 11000020, SystemID: 31001627, SystemName: "J155200"},
-	31001628: {RegionID: 11000020, SystemID: 31001628, SystemName: "J145944"},
-	31001629: {RegionID: 11000020, SystemID: 31001629, SystemName: "J145535"},
-	31001630: {RegionID: 11000020, SystemID: 31001630, SystemName: "J141021"},
-	31001631: {RegionID: 11000020, SystemID: 31001631, SystemName: "J223312"},
-	31001632: {RegionID: 11000020, SystemID: 31001632, SystemName: "J212028"},
-	31001633: {RegionID: 11000020, SystemID: 31001633, SystemName: "J164835"},
-	31001634: {RegionID: 11000020, SystemID: 31001634, SystemName: "J115528"},
-	31001635: {RegionID: 11000020, SystemID: 31001635, SystemName: "J143922"},
-	31001636: {RegionID: 11000020, SystemID: 31001636, SystemName: "J225234"},
-	31001637: {RegionID: 11000020, SystemID: 31001637, SystemName: "J165645"},
-	31001638: {RegionID: 11000020, SystemID: 31001638, SystemName: "J100142"},
-	31001639: {RegionID: 11000020, SystemID: 31001639, SystemName: "J101129"},
-	31001640: {RegionID: 11000020, SystemID: 31001640, SystemName: "J141220"},
-	31001641: {RegionID: 11000020, SystemID: 31001641, SystemName: "J112450"},
-	31001642: {RegionID: 11000020, SystemID: 31001642, SystemName: "J121558"},
-	31001643: {RegionID: 11000020, SystemID: 31001643, SystemName: "J104404"},
-	31001644: {RegionID: 11000020, SystemID: 31001644, SystemName: "J152633"},
-	31001645: {RegionID: 11000020, SystemID: 31001645, SystemName: "J104624"},
-	31001646: {RegionID: 11000020, SystemID: 31001646, SystemName: "J113227"},
-	31001647: {RegionID: 11000020, SystemID: 31001647, SystemName: "J211817"},
-	31001648: {RegionID: 11000020, SystemID: 31001648, SystemName: "J144329"},
-	31001649: {RegionID: 11000020, SystemID: 31001649, SystemName: "J154102"},
-	31001650: {RegionID: 11000021, SystemID: 31001650, SystemName: "J162231"},
-	31001651: {RegionID: 11000021, SystemID: 31001651, SystemName: "J151303"},
-	31001652: {RegionID: 11000021, SystemID: 31001652, SystemName: "J145848"},
-	31001653: {RegionID: 11000021, SystemID: 31001653, SystemName: "J152720"},
-	31001654: {RegionID: 11000021, SystemID: 31001654, SystemName: "J123753"},
-	31001655: {RegionID: 11000021, SystemID: 31001655, SystemName: "J101042"},
-	31001656: {RegionID: 11000021, SystemID: 31001656, SystemName: "J223824"},
-	31001657: {RegionID: 11000021, SystemID: 31001657, SystemName: "J125929"},
-	31001658: {RegionID: 11000021, SystemID: 31001658, SystemName: "J225111"},
-	31001659: {RegionID: 11000021, SystemID: 31001659, SystemName: "J135346"},
-	31001660: {RegionID: 11000021, SystemID: 31001660, SystemName: "J144120"},
-	31001661: {RegionID: 11000021, SystemID: 31001661, SystemName: "J215743"},
-	31001662: {RegionID: 11000021, SystemID: 31001662, SystemName: "J111007"},
-	31001663: {RegionID: 11000021, SystemID: 31001663, SystemName: "J231710"},
-	31001664: {RegionID: 11000021, SystemID: 31001664, SystemName: "J141001"},
-	31001665: {RegionID: 11000021, SystemID: 31001665, SystemName: "J163444"},
-	31001666: {RegionID: 11000021, SystemID: 31001666, SystemName: "J103141"},
-	31001667: {RegionID: 11000021, SystemID: 31001667, SystemName: "J112215"},
-	31001668: {RegionID: 11000021, SystemID: 31001668, SystemName: "J215758"},
-	31001669: {RegionID: 11000021, SystemID: 31001669, SystemName: "J101145"},
-	31001670: {RegionID: 11000021, SystemID: 31001670, SystemName: "J212838"},
-	31001671: {RegionID: 11000021, SystemID: 31001671, SystemName: "J111458"},
-	31001672: {RegionID: 11000021, SystemID: 31001672, SystemName: "J115554"},
-	31001673: {RegionID: 11000021, SystemID: 31001673, SystemName: "J170445"},
-	31001674: {RegionID: 11000021, SystemID: 31001674, SystemName: "J162831"},
-	31001675: {RegionID: 11000021, SystemID: 31001675, SystemName: "J124236"},
-	31001676: {RegionID: 11000021, SystemID: 31001676, SystemName: "J101535"},
-	31001677: {RegionID: 11000021, SystemID: 31001677, SystemName: "J113551"},
-	31001678: {RegionID: 11000021, SystemID: 31001678, SystemName: "J162656"},
-	31001679: {RegionID: 11000021, SystemID: 31001679, SystemName: "J114530"},
-	31001680: {RegionID: 11000021, SystemID: 31001680, SystemName: "J155214"},
-	31001681: {RegionID: 11000021, SystemID: 31001681, SystemName: "J102336"},
-	31001682: {RegionID: 11000021, SystemID: 31001682, SystemName: "J123352"},
-	31001683: {RegionID: 11000021, SystemID: 31001683, SystemName: "J100937"},
-	31001684: {RegionID: 11000021, SystemID: 31001684, SystemName: "J100107"},
-	31001685: {RegionID: 11000021, SystemID: 31001685, SystemName: "J204230"},
-	31001686: {RegionID: 11000021, SystemID: 31001686, SystemName: "J233909"},
-	31001687: {RegionID: 11000021, SystemID: 31001687, SystemName: "J134354"},
-	31001688: {RegionID: 11000021, SystemID: 31001688, SystemName: "J160046"},
-	31001689: {RegionID: 11000021, SystemID: 31001689, SystemName: "J162816"},
-	31001690: {RegionID: 11000021, SystemID: 31001690, SystemName: "J170305"},
-	31001691: {RegionID: 11000021, SystemID: 31001691, SystemName: "J102045"},
-	31001692: {RegionID: 11000021, SystemID: 31001692, SystemName: "J100830"},
-	31001693: {RegionID: 11000021, SystemID: 31001693, SystemName: "J115658"},
-	31001694: {RegionID: 11000021, SystemID: 31001694, SystemName: "J123947"},
-	31001695: {RegionID: 11000021, SystemID: 31001695, SystemName: "J224754"},
-	31001696: {RegionID: 11000021, SystemID: 31001696, SystemName: "J140246"},
-	31001697: {RegionID: 11000021, SystemID: 31001697, SystemName: "J214440"},
-	31001698: {RegionID: 11000021, SystemID: 31001698, SystemName: "J144135"},
-	31001699: {RegionID: 11000021, SystemID: 31001699, SystemName: "J151021"},
-	31001700: {RegionID: 11000021, SystemID: 31001700, SystemName: "J114133"},
-	31001701: {RegionID: 11000021, SystemID: 31001701, SystemName: "J130810"},
-	31001702: {RegionID: 11000021, SystemID: 31001702, SystemName: "J141425"},
-	31001703: {RegionID: 11000021, SystemID: 31001703, SystemName: "J121847"},
-	31001704: {RegionID: 11000021, SystemID: 31001704, SystemName: "J150805"},
-	31001705: {RegionID: 11000021, SystemID: 31001705, SystemName: "J140810"},
-	31001706: {RegionID: 11000021, SystemID: 31001706, SystemName: "J141104"},
-	31001707: {RegionID: 11000021, SystemID: 31001707, SystemName: "J114735"},
-	31001708: {RegionID: 11000021, SystemID: 31001708, SystemName: "J205922"},
-	31001709: {RegionID: 11000021, SystemID: 31001709, SystemName: "J215101"},
-	31001710: {RegionID: 11000021, SystemID: 31001710, SystemName: "J144131"},
-	31001711: {RegionID: 11000021, SystemID: 31001711, SystemName: "J213429"},
-	31001712: {RegionID: 11000021, SystemID: 31001712, SystemName: "J121006"},
-	31001713: {RegionID: 11000021, SystemID: 31001713, SystemName: "J111644"},
-	31001714: {RegionID: 11000021, SystemID: 31001714, SystemName: "J164031"},
-	31001715: {RegionID: 11000021, SystemID: 31001715, SystemName: "J155249"},
-	31001716: {RegionID: 11000021, SystemID: 31001716, SystemName: "J103228"},
-	31001717: {RegionID: 11000021, SystemID: 31001717, SystemName: "J164025"},
-	31001718: {RegionID: 11000021, SystemID: 31001718, SystemName: "J163217"},
-	31001719: {RegionID: 11000021, SystemID: 31001719, SystemName: "J103631"},
-	31001720: {RegionID: 11000021, SystemID: 31001720, SystemName: "J145739"},
-	31001721: {RegionID: 11000021, SystemID: 31001721, SystemName: "J114408"},
-	31001722: {RegionID: 11000021, SystemID: 31001722, SystemName: "J131704"},
-	31001723: {RegionID: 11000021, SystemID: 31001723, SystemName: "J112404"},
-	31001724: {RegionID: 11000021, SystemID: 31001724, SystemName: "J104723"},
-	31001725: {RegionID: 11000021, SystemID: 31001725, SystemName: "J123746"},
-	31001726: {RegionID: 11000021, SystemID: 31001726, SystemName: "J225441"},
-	31001727: {RegionID: 11000021, SystemID: 31001727, SystemName: "J111629"},
-	31001728: {RegionID: 11000021, SystemID: 31001728, SystemName: "J140154"},
-	31001729: {RegionID: 11000021, SystemID: 31001729, SystemName: "J144743"},
-	31001730: {RegionID: 11000021, SystemID: 31001730, SystemName: "J210247"},
-	31001731: {RegionID: 11000021, SystemID: 31001731, SystemName: "J133013"},
-	31001732: {RegionID: 11000021, SystemID: 31001732, SystemName: "J160032"},
-	31001733: {RegionID: 11000021, SystemID: 31001733, SystemName: "J111009"},
-	31001734: {RegionID: 11000021, SystemID: 31001734, SystemName: "J142117"},
-	31001735: {RegionID: 11000021, SystemID: 31001735, SystemName: "J110417"},
-	31001736: {RegionID: 11000021, SystemID: 31001736, SystemName: "J204853"},
-	31001737: {RegionID: 11000021, SystemID: 31001737, SystemName: "J132144"},
-	31001738: {RegionID: 11000021, SystemID: 31001738, SystemName: "J160016"},
-	31001739: {RegionID: 11000021, SystemID: 31001739, SystemName: "J142335"},
-	31001740: {RegionID: 11000021, SystemID: 31001740, SystemName: "J105835"},
-	31001741: {RegionID: 11000021, SystemID: 31001741, SystemName: "J102055"},
-	31001742: {RegionID: 11000021, SystemID: 31001742, SystemName: "J140932"},
-	31001743: {RegionID: 11000021, SystemID: 31001743, SystemName: "J134333"},
-	31001744: {RegionID: 11000021, SystemID: 31001744, SystemName: "J160156"},
-	31001745: {RegionID: 11000021, SystemID: 31001745, SystemName: "J150944"},
-	31001746: {RegionID: 11000021, SystemID: 31001746, SystemName: "J113508"},
-	31001747: {RegionID: 11000021, SystemID: 31001747, SystemName: "J151757"},
-	31001748: {RegionID: 11000021, SystemID: 31001748, SystemName: "J142800"},
-	31001749: {RegionID: 11000021, SystemID: 31001749, SystemName: "J170642"},
-	31001750: {RegionID: 11000021, SystemID: 31001750, SystemName: "J130451"},
-	31001751: {RegionID: 11000021, SystemID: 31001751, SystemName: "J113158"},
-	31001752: {RegionID: 11000021, SystemID: 31001752, SystemName: "J225133"},
-	31001753: {RegionID: 11000021, SystemID: 31001753, SystemName: "J100001"},
-	31001754: {RegionID: 11000021, SystemID: 31001754, SystemName: "J155831"},
-	31001755: {RegionID: 11000021, SystemID: 31001755, SystemName: "J145749"},
-	31001756: {RegionID: 11000021, SystemID: 31001756, SystemName: "J164659"},
-	31001757: {RegionID: 11000021, SystemID: 31001757, SystemName: "J135543"},
-	31001758: {RegionID: 11000021, SystemID: 31001758, SystemName: "J132735"},
-	31001759: {RegionID: 11000021, SystemID: 31001759, SystemName: "J141740"},
-	31001760: {RegionID: 11000021, SystemID: 31001760, SystemName: "J101315"},
-	31001761: {RegionID: 11000021, SystemID: 31001761, SystemName: "J123345"},
-	31001762: {RegionID: 11000021, SystemID: 31001762, SystemName: "J105621"},
-	31001763: {RegionID: 11000021, SystemID: 31001763, SystemName: "J113813"},
-	31001764: {RegionID: 11000021, SystemID: 31001764, SystemName: "J135807"},
-	31001765: {RegionID: 11000022, SystemID: 31001765, SystemName: "J122728"},
-	31001766: {RegionID: 11000022, SystemID: 31001766, SystemName: "J110915"},
-	31001767: {RegionID: 11000022, SystemID: 31001767, SystemName: "J171430"},
-	31001768: {RegionID: 11000022, SystemID: 31001768, SystemName: "J154321"},
-	31001769: {RegionID: 11000022, SystemID: 31001769, SystemName: "J104729"},
-	31001770: {RegionID: 11000022, SystemID: 31001770, SystemName: "J111753"},
-	31001771: {RegionID: 11000022, SystemID: 31001771, SystemName: "J140823"},
-	31001772: {RegionID: 11000022, SystemID: 31001772, SystemName: "J161357"},
-	31001773: {RegionID: 11000022, SystemID: 31001773, SystemName: "J162205"},
-	31001774: {RegionID: 11000022, SystemID: 31001774, SystemName: "J113950"},
-	31001775: {RegionID: 11000022, SystemID: 31001775, SystemName: "J124224"},
-	31001776: {RegionID: 11000022, SystemID: 31001776, SystemName: "J155959"},
-	31001777: {RegionID: 11000022, SystemID: 31001777, SystemName: "J103213"},
-	31001778: {RegionID: 11000022, SystemID: 31001778, SystemName: "J105858"},
-	31001779: {RegionID: 11000022, SystemID: 31001779, SystemName: "J172502"},
-	31001780: {RegionID: 11000022, SystemID: 31001780, SystemName: "J170151"},
-	31001781: {RegionID: 11000022, SystemID: 31001781, SystemName: "J165058"},
-	31001782: {RegionID: 11000022, SystemID: 31001782, SystemName: "J173638"},
-	31001783: {RegionID: 11000022, SystemID: 31001783, SystemName: "J130330"},
-	31001784: {RegionID: 11000022, SystemID: 31001784, SystemName: "J132740"},
-	31001785: {RegionID: 11000022, SystemID: 31001785, SystemName: "J133931"},
-	31001786: {RegionID: 11000022, SystemID: 31001786, SystemName: "J143902"},
-	31001787: {RegionID: 11000022, SystemID: 31001787, SystemName: "J165105"},
-	31001788: {RegionID: 11000022, SystemID: 31001788, SystemName: "J141316"},
-	31001789: {RegionID: 11000022, SystemID: 31001789, SystemName: "J113152"},
-	31001790: {RegionID: 11000022, SystemID: 31001790, SystemName: "J113453"},
-	31001791: {RegionID: 11000022, SystemID: 31001791, SystemName: "J144845"},
-	31001792: {RegionID: 11000022, SystemID: 31001792, SystemName: "J140053"},
-	31001793: {RegionID: 11000022, SystemID: 31001793, SystemName: "J151416"},
-	31001794: {RegionID: 11000022, SystemID: 31001794, SystemName: "J100846"},
-	31001795: {RegionID: 11000022, SystemID: 31001795, SystemName: "J165943"},
-	31001796: {RegionID: 11000022, SystemID: 31001796, SystemName: "J103538"},
-	31001797: {RegionID: 11000022, SystemID: 31001797, SystemName: "J154854"},
-	31001798: {RegionID: 11000022, SystemID: 31001798, SystemName: "J212627"},
-	31001799: {RegionID: 11000022, SystemID: 31001799, SystemName: "J150656"},
-	31001800: {RegionID: 11000022, SystemID: 31001800, SystemName: "J224031"},
-	31001801: {RegionID: 11000022, SystemID: 31001801, SystemName: "J215455"},
-	31001802: {RegionID: 11000022, SystemID: 31001802, SystemName: "J122503"},
-	31001803: {RegionID: 11000022, SystemID: 31001803, SystemName: "J103120"},
-	31001804: {RegionID: 11000022, SystemID: 31001804, SystemName: "J164528"},
-	31001805: {RegionID: 11000022, SystemID: 31001805, SystemName: "J125316"},
-	31001806: {RegionID: 11000022, SystemID: 31001806, SystemName: "J113131"},
-	31001807: {RegionID: 11000022, SystemID: 31001807, SystemName: "J144038"},
-	31001808: {RegionID: 11000022, SystemID: 31001808, SystemName: "J172926"},
-	31001809: {RegionID: 11000022, SystemID: 31001809, SystemName: "J154610"},
-	31001810: {RegionID: 11000022, SystemID: 31001810, SystemName: "J103412"},
-	31001811: {RegionID: 11000022, SystemID: 31001811, SystemName: "J150318"},
-	31001812: {RegionID: 11000022, SystemID: 31001812, SystemName: "J211504"},
-	31001813: {RegionID: 11000022, SystemID: 31001813, SystemName: "J111617"},
-	31001814: {RegionID: 11000022, SystemID: 31001814, SystemName: "J100346"},
-	31001815: {RegionID: 11000022, SystemID: 31001815, SystemName: "J143557"},
-	31001816: {RegionID: 11000022, SystemID: 31001816, SystemName: "J130001"},
-	31001817: {RegionID: 11000022, SystemID: 31001817, SystemName: "J101142"},
-	31001818: {RegionID: 11000022, SystemID: 31001818, SystemName: "J155002"},
-	31001819: {RegionID: 11000022, SystemID: 31001819, SystemName: "J154232"},
-	31001820: {RegionID: 11000022, SystemID: 31001820, SystemName: "J142506"},
-	31001821: {RegionID: 11000022, SystemID: 31001821, SystemName: "J155506"},
-	31001822: {RegionID: 11000022, SystemID: 31001822, SystemName: "J163754"},
-	31001823: {RegionID: 11000022, SystemID: 31001823, SystemName: "J100357"},
-	31001824: {RegionID: 11000022, SystemID: 31001824, SystemName: "J115031"},
-	31001825: {RegionID: 11000022, SystemID: 31001825, SystemName: "J122706"},
-	31001826: {RegionID: 11000022, SystemID: 31001826, SystemName: "J114318"},
-	31001827: {RegionID: 11000022, SystemID: 31001827, SystemName: "J150625"},
-	31001828: {RegionID: 11000022, SystemID: 31001828, SystemName: "J113437"},
-	31001829: {RegionID: 11000022, SystemID: 31001829, SystemName: "J213924"},
-	31001830: {RegionID: 11000022, SystemID: 31001830, SystemName: "J152711"},
-	31001831: {RegionID: 11000022, SystemID: 31001831, SystemName: "J160929"},
-	31001832: {RegionID: 11000022, SystemID: 31001832, SystemName: "J153110"},
-	31001833: {RegionID: 11000022, SystemID: 31001833, SystemName: "J132532"},
-	31001834: {RegionID: 11000022, SystemID: 31001834, SystemName: "J114031"},
-	31001835: {RegionID: 11000022, SystemID: 31001835, SystemName: "J120316"},
-	31001836: {RegionID: 11000022, SystemID: 31001836, SystemName: "J151610"},
-	31001837: {RegionID: 11000022, SystemID: 31001837, SystemName: "J145937"},
-	31001838: {RegionID: 11000022, SystemID: 31001838, SystemName: "J122056"},
-	31001839: {RegionID: 11000022, SystemID: 31001839, SystemName: "J170127"},
-	31001840: {RegionID: 11000022, SystemID: 31001840, SystemName: "J145424"},
-	31001841: {RegionID: 11000022, SystemID: 31001841, SystemName: "J101835"},
-	31001842: {RegionID: 11000022, SystemID: 31001842, SystemName: "J103907"},
-	31001843: {RegionID: 11000022, SystemID: 31001843, SystemName: "J130237"},
-	31001844: {RegionID: 11000022, SystemID: 31001844, SystemName: "J104948"},
-	31001845: {RegionID: 11000022, SystemID: 31001845, SystemName: "J120844"},
-	31001846: {RegionID: 11000022, SystemID: 31001846, SystemName: "J154824"},
-	31001847: {RegionID: 11000022, SystemID: 31001847, SystemName: "J134216"},
-	31001848: {RegionID: 11000022, SystemID: 31001848, SystemName: "J120131"},
-	31001849: {RegionID: 11000022, SystemID: 31001849, SystemName: "J120134"},
-	31001850: {RegionID: 11000022, SystemID: 31001850, SystemName: "J224558"},
-	31001851: {RegionID: 11000022, SystemID: 31001851, SystemName: "J161029"},
-	31001852: {RegionID: 11000023, SystemID: 31001852, SystemName: "J225530"},
-	31001853: {RegionID: 11000023, SystemID: 31001853, SystemName: "J154407"},
-	31001854: {RegionID: 11000023, SystemID: 31001854, SystemName: "J140720"},
-	31001855: {RegionID: 11000023, SystemID: 31001855, SystemName: "J222125"},
-	31001856: {RegionID: 11000023, SystemID: 31001856, SystemName: "J152502"},
-	31001857: {RegionID: 11000023, SystemID: 31001857, SystemName: "J220215"},
-	31001858: {RegionID: 11000023, SystemID: 31001858, SystemName: "J141647"},
-	31001859: {RegionID: 11000023, SystemID: 31001859, SystemName: "J101957"},
-	31001860: {RegionID: 11000023, SystemID: 31001860, SystemName: "J223808"},
-	31001861: {RegionID: 11000023, SystemID: 31001861, SystemName: "J105433"},
-	31001862: {RegionID: 11000023, SystemID: 31001862, SystemName: "J153722"},
-	31001863: {RegionID: 11000023, SystemID: 31001863, SystemName: "J105348"},
-	31001864: {RegionID: 11000023, SystemID: 31001864, SystemName: "J113133"},
-	31001865: {RegionID: 11000023, SystemID: 31001865, SystemName: "J105319"},
-	31001866: {RegionID: 11000023, SystemID: 31001866, SystemName: "J162828"},
-	31001867: {RegionID: 11000023, SystemID: 31001867, SystemName: "J233449"},
-	31001868: {RegionID: 11000023, SystemID: 31001868, SystemName: "J150026"},
-	31001869: {RegionID: 11000023, SystemID: 31001869, SystemName: "J125629"},
-	31001870: {RegionID: 11000023, SystemID: 31001870, SystemName: "J123246"},
-	31001871: {RegionID: 11000023, SystemID: 31001871, SystemName: "J215615"},
-	31001872: {RegionID: 11000023, SystemID: 31001872, SystemName: "J152404"},
-	31001873: {RegionID: 11000023, SystemID: 31001873, SystemName: "J132152"},
-	31001874: {RegionID: 11000023, SystemID: 31001874, SystemName: "J145335"},
-	31001875: {RegionID: 11000023, SystemID: 31001875, SystemName: "J162255"},
-	31001876: {RegionID: 11000023, SystemID: 31001876, SystemName: "J112722"},
-	31001877: {RegionID: 11000023, SystemID: 31001877, SystemName: "J134459"},
-	31001878: {RegionID: 11000023, SystemID: 31001878, SystemName: "J131624"},
-	31001879: {RegionID: 11000023, SystemID: 31001879, SystemName: "J165611"},
-	31001880: {RegionID: 11000024, SystemID: 31001880, SystemName: "J152820"},
-	31001881: {RegionID: 11000024, SystemID: 31001881, SystemName: "J135100"},
-	31001882: {RegionID: 11000024, SystemID: 31001882, SystemName: "J122515"},
-	31001883: {RegionID: 11000024, SystemID: 31001883, SystemName: "J172943"},
-	31001884: {RegionID: 11000024, SystemID: 31001884, SystemName: "J205738"},
-	31001885: {RegionID: 11000024, SystemID: 31001885, SystemName: "J134242"},
-	31001886: {RegionID: 11000024, SystemID: 31001886, SystemName: "J103251"},
-	31001887: {RegionID: 11000024, SystemID: 31001887, SystemName: "J111220"},
-	31001888: {RegionID: 11000024, SystemID: 31001888, SystemName: "J170540"},
-	31001889: {RegionID: 11000024, SystemID: 31001889, SystemName: "J102446"},
-	31001890: {RegionID: 11000024, SystemID: 31001890, SystemName: "J141137"},
-	31001891: {RegionID: 11000024, SystemID: 31001891, SystemName: "J164147"},
-	31001892: {RegionID: 11000024, SystemID: 31001892, SystemName: "J100702"},
-	31001893: {RegionID: 11000024, SystemID: 31001893, SystemName: "J133119"},
-	31001894: {RegionID: 11000024, SystemID: 31001894, SystemName: "J124730"},
-	31001895: {RegionID: 11000024, SystemID: 31001895, SystemName: "J132823"},
-	31001896: {RegionID: 11000024, SystemID: 31001896, SystemName: "J100724"},
-	31001897: {RegionID: 11000024, SystemID: 31001897, SystemName: "J162638"},
-	31001898: {RegionID: 11000024, SystemID: 31001898, SystemName: "J230257"},
-	31001899: {RegionID: 11000024, SystemID: 31001899, SystemName: "J223018"},
-	31001900: {RegionID: 11000024, SystemID: 31001900, SystemName: "J135449"},
-	31001901: {RegionID: 11000024, SystemID: 31001901, SystemName: "J133537"},
-	31001902: {RegionID: 11000024, SystemID: 31001902, SystemName: "J155714"},
-	31001903: {RegionID: 11000024, SystemID: 31001903, SystemName: "J112325"},
-	31001904: {RegionID: 11000024, SystemID: 31001904, SystemName: "J150606"},
-	31001905: {RegionID: 11000024, SystemID: 31001905, SystemName: "J110550"},
-	31001906: {RegionID: 11000024, SystemID: 31001906, SystemName: "J205659"},
-	31001907: {RegionID: 11000024, SystemID: 31001907, SystemName: "J100120"},
-	31001908: {RegionID: 11000024, SystemID: 31001908, SystemName: "J151057"},
-	31001909: {RegionID: 11000024, SystemID: 31001909, SystemName: "J213245"},
-	31001910: {RegionID: 11000024, SystemID: 31001910, SystemName: "J121230"},
-	31001911: {RegionID: 11000024, SystemID: 31001911, SystemName: "J145203"},
-	31001912: {RegionID: 11000024, SystemID: 31001912, SystemName: "J111106"},
-	31001913: {RegionID: 11000024, SystemID: 31001913, SystemName: "J222834"},
-	31001914: {RegionID: 11000024, SystemID: 31001914, SystemName: "J232336"},
-	31001915: {RegionID: 11000024, SystemID: 31001915, SystemName: "J161032"},
-	31001916: {RegionID: 11000024, SystemID: 31001916, SystemName: "J154733"},
-	31001917: {RegionID: 11000024, SystemID: 31001917, SystemName: "J153144"},
-	31001918: {RegionID: 11000024, SystemID: 31001918, SystemName: "J122721"},
-	31001919: {RegionID: 11000024, SystemID: 31001919, SystemName: "J133440"},
-	31001920: {RegionID: 11000024, SystemID: 31001920, SystemName: "J103727"},
-	31001921: {RegionID: 11000024, SystemID: 31001921, SystemName: "J152739"},
-	31001922: {RegionID: 11000024, SystemID: 31001922, SystemName: "J140717"},
-	31001923: {RegionID: 11000024, SystemID: 31001923, SystemName: "J205205"},
-	31001924: {RegionID: 11000024, SystemID: 31001924, SystemName: "J130026"},
-	31001925: {RegionID: 11000024, SystemID: 31001925, SystemName: "J151035"},
-	31001926: {RegionID: 11000024, SystemID: 31001926, SystemName: "J112603"},
-	31001927: {RegionID: 11000024, SystemID: 31001927, SystemName: "J151811"},
-	31001928: {RegionID: 11000024, SystemID: 31001928, SystemName: "J105441"},
-	31001929: {RegionID: 11000024, SystemID: 31001929, SystemName: "J124949"},
-	31001930: {RegionID: 11000024, SystemID: 31001930, SystemName: "J130711"},
-	31001931: {RegionID: 11000024, SystemID: 31001931, SystemName: "J131948"},
-	31001932: {RegionID: 11000024, SystemID: 31001932, SystemName: "J132814"},
-	31001933: {RegionID: 11000024, SystemID: 31001933, SystemName: "J213734"},
-	31001934: {RegionID: 11000024, SystemID: 31001934, SystemName: "J140608"},
-	31001935: {RegionID: 11000024, SystemID: 31001935, SystemName: "J212336"},
-	31001936: {RegionID: 11000024, SystemID: 31001936, SystemName: "J104448"},
-	31001937: {RegionID: 11000024, SystemID: 31001937, SystemName: "J164846"},
-	31001938: {RegionID: 11000024, SystemID: 31001938, SystemName: "J161354"},
-	31001939: {RegionID: 11000024, SystemID: 31001939, SystemName: "J103959"},
-	31001940: {RegionID: 11000024, SystemID: 31001940, SystemName: "J114353"},
-	31001941: {RegionID: 11000024, SystemID: 31001941, SystemName: "J171622"},
-	31001942: {RegionID: 11000024, SystemID: 31001942, SystemName: "J143123"},
-	31001943: {RegionID: 11000024, SystemID: 31001943, SystemName: "J125023"},
-	31001944: {RegionID: 11000024, SystemID: 31001944, SystemName: "J122116"},
-	31001945: {RegionID: 11000024, SystemID: 31001945, SystemName: "J151718"},
-	31001946: {RegionID: 11000024, SystemID: 31001946, SystemName: "J153335"},
-	31001947: {RegionID: 11000024, SystemID: 31001947, SystemName: "J170122"},
-	31001948: {RegionID: 11000024, SystemID: 31001948, SystemName: "J150515"},
-	31001949: {RegionID: 11000024, SystemID: 31001949, SystemName: "J104216"},
-	31001950: {RegionID: 11000024, SystemID: 31001950, SystemName: "J111000"},
-	31001951: {RegionID: 11000024, SystemID: 31001951, SystemName: "J155600"},
-	31001952: {RegionID: 11000024, SystemID: 31001952, SystemName: "J132601"},
-	31001953: {RegionID: 11000024, SystemID: 31001953, SystemName: "J132258"},
-	31001954: {RegionID: 11000024, SystemID: 31001954, SystemName: "J221512"},
-	31001955: {RegionID: 11000024, SystemID: 31001955, SystemName: "J125853"},
-	31001956: {RegionID: 11000024, SystemID: 31001956, SystemName: "J141609"},
-	31001957: {RegionID: 11000024, SystemID: 31001957, SystemName: "J134096"},
-	31001958: {RegionID: 11000024, SystemID: 31001958, SystemName: "J121146"},
-	31001959: {RegionID: 11000024, SystemID: 31001959, SystemName: "J121649"},
-	31001960: {RegionID: 11000024, SystemID: 31001960, SystemName: "J135559"},
-	31001961: {RegionID: 11000024, SystemID: 31001961, SystemName: "J130735"},
-	31001962: {RegionID: 11000024, SystemID: 31001962, SystemName: "J144115"},
-	31001963: {RegionID: 11000024, SystemID: 31001963, SystemName: "J112241"},
-	31001964: {RegionID: 11000024, SystemID: 31001964, SystemName: "J141204"},
-	31001965: {RegionID: 11000024, SystemID: 31001965, SystemName: "J131401"},
-	31001966: {RegionID: 11000024, SystemID: 31001966, SystemName: "J170231"},
-	31001967: {RegionID: 11000024, SystemID: 31001967, SystemName: "J164430"},
-	31001968: {RegionID: 11000025, SystemID: 31001968, SystemName: "J143740"},
-	31001969: {RegionID: 11000025, SystemID: 31001969, SystemName: "J115517"},
-	31001970: {RegionID: 11000025, SystemID: 31001970, SystemName: "J230936"},
-	31001971: {RegionID: 11000025, SystemID: 31001971, SystemName: "J133525"},
-	31001972: {RegionID: 11000025, SystemID: 31001972, SystemName: "J215338"},
-	31001973: {RegionID: 11000025, SystemID: 31001973, SystemName: "J112003"},
-	31001974: {RegionID: 11000025, SystemID: 31001974, SystemName: "J214318"},
-	31001975: {RegionID: 11000025, SystemID: 31001975, SystemName: "J110938"},
-	31001976: {RegionID: 11000025, SystemID: 31001976, SystemName: "J103453"},
-	31001977: {RegionID: 11000025, SystemID: 31001977, SystemName: "J101000"},
-	31001978: {RegionID: 11000025, SystemID: 31001978, SystemName: "J110117"},
-	31001979: {RegionID: 11000025, SystemID: 31001979, SystemName: "J100328"},
-	31001980: {RegionID: 11000025, SystemID: 31001980, SystemName: "J155845"},
-	31001981: {RegionID: 11000025, SystemID: 31001981, SystemName: "J152703"},
-	31001982: {RegionID: 11000025, SystemID: 31001982, SystemName: "J110628"},
-	31001983: {RegionID: 11000025, SystemID: 31001983, SystemName: "J142438"},
-	31001984: {RegionID: 11000025, SystemID: 31001984, SystemName: "J153054"},
-	31001985: {RegionID: 11000025, SystemID: 31001985, SystemName: "J114019"},
-	31001986: {RegionID: 11000025, SystemID: 31001986, SystemName: "J233255"},
-	31001987: {RegionID: 11000025, SystemID: 31001987, SystemName: "J135046"},
-	31001988: {RegionID: 11000025, SystemID: 31001988, SystemName: "J145322"},
-	31001989: {RegionID: 11000025, SystemID: 31001989, SystemName: "J112844"},
-	31001990: {RegionID: 11000025, SystemID: 31001990, SystemName: "J120816"},
-	31001991: {RegionID: 11000025, SystemID: 31001991, SystemName: "J165340"},
-	31001992: {RegionID: 11000025, SystemID: 31001992, SystemName: "J124409"},
-	31001993: {RegionID: 11000025, SystemID: 31001993, SystemName: "J124733"},
-	31001994: {RegionID: 11000025, SystemID: 31001994, SystemName: "J173645"},
-	31001995: {RegionID: 11000025, SystemID: 31001995, SystemName: "J165743"},
-	31001996: {RegionID: 11000025, SystemID: 31001996, SystemName: "J171013"},
-	31001997: {RegionID: 11000025, SystemID: 31001997, SystemName: "J155905"},
-	31001998: {RegionID: 11000025, SystemID: 31001998, SystemName: "J213055"},
-	31001999: {RegionID: 11000025, SystemID: 31001999, SystemName: "J212224"},
-	31002000: {RegionID: 11000025, SystemID: 31002000, SystemName: "J234252"},
-	31002001: {RegionID: 11000025, SystemID: 31002001, SystemName: "J150036"},
-	31002002: {RegionID: 11000025, SystemID: 31002002, SystemName: "J172431"},
-	31002003: {RegionID: 11000025, SystemID: 31002003, SystemName: "J114154"},
-	31002004: {RegionID: 11000025, SystemID: 31002004, SystemName: "J115127"},
-	31002005: {RegionID: 11000025, SystemID: 31002005, SystemName: "J233658"},
-	31002006: {RegionID: 11000025, SystemID: 31002006, SystemName: "J114403"},
-	31002007: {RegionID: 11000025, SystemID: 31002007, SystemName: "J165946"},
-	31002008: {RegionID: 11000025, SystemID: 31002008, SystemName: "J124046"},
-	31002009: {RegionID: 11000025, SystemID: 31002009, SystemName: "J102736"},
-	31002010: {RegionID: 11000025, SystemID: 31002010, SystemName: "J133252"},
-	31002011: {RegionID: 11000025, SystemID: 31002011, SystemName: "J140600"},
-	31002012: {RegionID: 11000025, SystemID: 31002012, SystemName: "J163701"},
-	31002013: {RegionID: 11000025, SystemID: 31002013, SystemName: "J115041"},
-	31002014: {RegionID: 11000025, SystemID: 31002014, SystemName: "J154726"},
-	31002015: {RegionID: 11000025, SystemID: 31002015, SystemName: "J123602"},
-	31002016: {RegionID: 11000025, SystemID: 31002016, SystemName: "J100549"},
-	31002017: {RegionID: 11000025, SystemID: 31002017, SystemName: "J124100"},
-	31002018: {RegionID: 11000025, SystemID: 31002018, SystemName: "J113701"},
-	31002019: {RegionID: 11000025, SystemID: 31002019, SystemName: "J164327"},
-	31002020: {RegionID: 11000025, SystemID: 31002020, SystemName: "J110018"},
-	31002021: {RegionID: 11000025, SystemID: 31002021, SystemName: "J164511"},
-	31002022: {RegionID: 11000025, SystemID: 31002022, SystemName: "J130037"},
-	31002023: {RegionID: 11000025, SystemID: 31002023, SystemName: "J101028"},
-	31002024: {RegionID: 11000025, SystemID: 31002024, SystemName: "J100858"},
-	31002025: {RegionID: 11000025, SystemID: 31002025, SystemName: "J151813"},
-	31002026: {RegionID: 11000025, SystemID: 31002026, SystemName: "J113758"},
-	31002027: {RegionID: 11000025, SystemID: 31002027, SystemName: "J153802"},
-	31002028: {RegionID: 11000025, SystemID: 31002028, SystemName: "J130401"},
-	31002029: {RegionID: 11000025, SystemID: 31002029, SystemName: "J130241"},
-	31002030: {RegionID: 11000025, SystemID: 31002030, SystemName: "J232934"},
-	31002031: {RegionID: 11000025, SystemID: 31002031, SystemName: "J213642"},
-	31002032: {RegionID: 11000025, SystemID: 31002032, SystemName: "J144107"},
-	31002033: {RegionID: 11000025, SystemID: 31002033, SystemName: "J140336"},
-	31002034: {RegionID: 11000025, SystemID: 31002034, SystemName: "J214712"},
-	31002035: {RegionID: 11000025, SystemID: 31002035, SystemName: "J170809"},
-	31002036: {RegionID: 11000025, SystemID: 31002036, SystemName: "J220924"},
-	31002037: {RegionID: 11000025, SystemID: 31002037, SystemName: "J142624"},
-	31002038: {RegionID: 11000025, SystemID: 31002038, SystemName: "J150306"},
-	31002039: {RegionID: 11000025, SystemID: 31002039, SystemName: "J100425"},
-	31002040: {RegionID: 11000025, SystemID: 31002040, SystemName: "J161745"},
-	31002041: {RegionID: 11000025, SystemID: 31002041, SystemName: "J131718"},
-	31002042: {RegionID: 11000025, SystemID: 31002042, SystemName: "J151319"},
-	31002043: {RegionID: 11000025, SystemID: 31002043, SystemName: "J113059"},
-	31002044: {RegionID: 11000025, SystemID: 31002044, SystemName: "J142234"},
-	31002045: {RegionID: 11000025, SystemID: 31002045, SystemName: "J113636"},
-	31002046: {RegionID: 11000025, SystemID: 31002046, SystemName: "J142858"},
-	31002047: {RegionID: 11000025, SystemID: 31002047, SystemName: "J135540"},
-	31002048: {RegionID: 11000025, SystemID: 31002048, SystemName: "J155739"},
-	31002049: {RegionID: 11000025, SystemID: 31002049, SystemName: "J132427"},
-	31002050: {RegionID: 11000025, SystemID: 31002050, SystemName: "J114337"},
-	31002051: {RegionID: 11000025, SystemID: 31002051, SystemName: "J121842"},
-	31002052: {RegionID: 11000025, SystemID: 31002052, SystemName: "J100415"},
-	31002053: {RegionID: 11000025, SystemID: 31002053, SystemName: "J143057"},
-	31002054: {RegionID: 11000025, SystemID: 31002054, SystemName: "J165953"},
-	31002055: {RegionID: 11000025, SystemID: 31002055, SystemName: "J102753"},
-	31002056: {RegionID: 11000025, SystemID: 31002056, SystemName: "J221325"},
-	31002057: {RegionID: 11000025, SystemID: 31002057, SystemName: "J114443"},
-	31002058: {RegionID: 11000025, SystemID: 31002058, SystemName: "J125990"},
-	31002059: {RegionID: 11000025, SystemID: 31002059, SystemName: "J114518"},
-	31002060: {RegionID: 11000025, SystemID: 31002060, SystemName: "J102623"},
-	31002061: {RegionID: 11000026, SystemID: 31002061, SystemName: "J164900"},
-	31002062: {RegionID: 11000026, SystemID: 31002062, SystemName: "J122049"},
-	31002063: {RegionID: 11000026, SystemID: 31002063, SystemName: "J154846"},
-	31002064: {RegionID: 11000026, SystemID: 31002064, SystemName: "J115522"},
-	31002065: {RegionID: 11000026, SystemID: 31002065, SystemName: "J145844"},
-	31002066: {RegionID: 11000026, SystemID: 31002066, SystemName: "J121704"},
-	31002067: {RegionID: 11000026, SystemID: 31002067, SystemName: "J121959"},
-	31002068: {RegionID: 11000026, SystemID: 31002068, SystemName: "J105849"},
-	31002069: {RegionID: 11000026, SystemID: 31002069, SystemName: "J122610"},
-	31002070: {RegionID: 11000026, SystemID: 31002070, SystemName: "J152912"},
-	31002071: {RegionID: 11000026, SystemID: 31002071, SystemName: "J145359"},
-	31002072: {RegionID: 11000026, SystemID: 31002072, SystemName: "J154906"},
-	31002073: {RegionID: 11000026, SystemID: 31002073, SystemName: "J160547"},
-	31002074: {RegionID: 11000026, SystemID: 31002074, SystemName: "J111150"},
-	31002075: {RegionID: 11000026, SystemID: 31002075, SystemName: "J111935"},
-	31002076: {RegionID: 11000026, SystemID: 31002076, SystemName: "J100237"},
-	31002077: {RegionID: 11000026, SystemID: 31002077, SystemName: "J145735"},
-	31002078: {RegionID: 11000026, SystemID: 31002078, SystemName: "J103600"},
-	31002079: {RegionID: 11000026, SystemID: 31002079, SystemName: "J211517"},
-	31002080: {RegionID: 11000026, SystemID: 31002080, SystemName: "J131128"},
-	31002081: {RegionID: 11000026, SystemID: 31002081, SystemName: "J143107"},
-	31002082: {RegionID: 11000026, SystemID: 31002082, SystemName: "J213125"},
-	31002083: {RegionID: 11000026, SystemID: 31002083, SystemName: "J152325"},
-	31002084: {RegionID: 11000026, SystemID: 31002084, SystemName: "J114014"},
-	31002085: {RegionID: 11000026, SystemID: 31002085, SystemName: "J215215"},
-	31002086: {RegionID: 11000026, SystemID: 31002086, SystemName: "J143718"},
-	31002087: {RegionID: 11000026, SystemID: 31002087, SystemName: "J134141"},
-	31002088: {RegionID: 11000026, SystemID: 31002088, SystemName: "J114842"},
-	31002089: {RegionID: 11000026, SystemID: 31002089, SystemName: "J105504"},
-	31002090: {RegionID: 11000026, SystemID: 31002090, SystemName: "J130302"},
-	31002091: {RegionID: 11000026, SystemID: 31002091, SystemName: "J170327"},
-	31002092: {RegionID: 11000026, SystemID: 31002092, SystemName: "J120823"},
-	31002093: {RegionID: 11000026, SystemID: 31002093, SystemName: "J153449"},
-	31002094: {RegionID: 11000026, SystemID: 31002094, SystemName: "J164621"},
-	31002095: {RegionID: 11000026, SystemID: 31002095, SystemName: "J142631"},
-	31002096: {RegionID: 11000026, SystemID: 31002096, SystemName: "J134652"},
-	31002097: {RegionID: 11000026, SystemID: 31002097, SystemName: "J123628"},
-	31002098: {RegionID: 11000026, SystemID: 31002098, SystemName: "J130621"},
-	31002099: {RegionID: 11000026, SystemID: 31002099, SystemName: "J171158"},
-	31002100: {RegionID: 11000026, SystemID: 31002100, SystemName: "J163930"},
-	31002101: {RegionID: 11000026, SystemID: 31002101, SystemName: "J113907"},
-	31002102: {RegionID: 11000026, SystemID: 31002102, SystemName: "J164610"},
-	31002103: {RegionID: 11000026, SystemID: 31002103, SystemName: "J145512"},
-	31002104: {RegionID: 11000026, SystemID: 31002104, SystemName: "J133833"},
-	31002105: {RegionID: 11000026, SystemID: 31002105, SystemName: "J141250"},
-	31002106: {RegionID: 11000026, SystemID: 31002106, SystemName: "J105623"},
-	31002107: {RegionID: 11000026, SystemID: 31002107, SystemName: "J120010"},
-	31002108: {RegionID: 11000026, SystemID: 31002108, SystemName: "J233630"},
-	31002109: {RegionID: 11000026, SystemID: 31002109, SystemName: "J162007"},
-	31002110: {RegionID: 11000026, SystemID: 31002110, SystemName: "J215124"},
-	31002111: {RegionID: 11000026, SystemID: 31002111, SystemName: "J141615"},
-	31002112: {RegionID: 11000026, SystemID: 31002112, SystemName: "J133222"},
-	31002113: {RegionID: 11000026, SystemID: 31002113, SystemName: "J105822"},
-	31002114: {RegionID: 11000026, SystemID: 31002114, SystemName: "J171136"},
-	31002115: {RegionID: 11000026, SystemID: 31002115, SystemName: "J152111"},
-	31002116: {RegionID: 11000026, SystemID: 31002116, SystemName: "J104846"},
-	31002117: {RegionID: 11000026, SystemID: 31002117, SystemName: "J123440"},
-	31002118: {RegionID: 11000026, SystemID: 31002118, SystemName: "J121416"},
-	31002119: {RegionID: 11000026, SystemID: 31002119, SystemName: "J161635"},
-	31002120: {RegionID: 11000026, SystemID: 31002120, SystemName: "J141043"},
-	31002121: {RegionID: 11000026, SystemID: 31002121, SystemName: "J163408"},
-	31002122: {RegionID: 11000026, SystemID: 31002122, SystemName: "J135204"},
-	31002123: {RegionID: 11000026, SystemID: 31002123, SystemName: "J133913"},
-	31002124: {RegionID: 11000026, SystemID: 31002124, SystemName: "J153447"},
-	31002125: {RegionID: 11000026, SystemID: 31002125, SystemName: "J145313"},
-	31002126: {RegionID: 11000027, SystemID: 31002126, SystemName: "J162753"},
-	31002127: {RegionID: 11000027, SystemID: 31002127, SystemName: "J135301"},
-	31002128: {RegionID: 11000027, SystemID: 31002128, SystemName: "J125254"},
-	31002129: {RegionID: 11000027, SystemID: 31002129, SystemName: "J145320"},
-	31002130: {RegionID: 11000027, SystemID: 31002130, SystemName: "J103116"},
-	31002131: {RegionID: 11000027, SystemID: 31002131, SystemName: "J120437"},
-	31002132: {RegionID: 11000027, SystemID: 31002132, SystemName: "J100252"},
-	31002133: {RegionID: 11000027, SystemID: 31002133, SystemName: "J153919"},
-	31002134: {RegionID: 11000027, SystemID: 31002134, SystemName: "J115520"},
-	31002135: {RegionID: 11000027, SystemID: 31002135, SystemName: "J100919"},
-	31002136: {RegionID: 11000027, SystemID: 31002136, SystemName: "J115933"},
-	31002137: {RegionID: 11000027, SystemID: 31002137, SystemName: "J133529"},
-	31002138: {RegionID: 11000027, SystemID: 31002138, SystemName: "J131549"},
-	31002139: {RegionID: 11000027, SystemID: 31002139, SystemName: "J205517"},
-	31002140: {RegionID: 11000027, SystemID: 31002140, SystemName: "J220546"},
-	31002141: {RegionID: 11000027, SystemID: 31002141, SystemName: "J102853"},
-	31002142: {RegionID: 11000027, SystemID: 31002142, SystemName: "J115418"},
-	31002143: {RegionID: 11000027, SystemID: 31002143, SystemName: "J155040"},
-	31002144: {RegionID: 11000027, SystemID: 31002144, SystemName: "J172937"},
-	31002145: {RegionID: 11000027, SystemID: 31002145, SystemName: "J122757"},
-	31002146: {RegionID: 11000027, SystemID: 31002146, SystemName: "J100759"},
-	31002147: {RegionID: 11000027, SystemID: 31002147, SystemName: "J145416"},
-	31002148: {RegionID: 11000027, SystemID: 31002148, SystemName: "J152034"},
-	31002149: {RegionID: 11000027, SystemID: 31002149, SystemName: "J125544"},
-	31002150: {RegionID: 11000027, SystemID: 31002150, SystemName: "J123235"},
-	31002151: {RegionID: 11000027, SystemID: 31002151, SystemName: "J151204"},
-	31002152: {RegionID: 11000027, SystemID: 31002152, SystemName: "J104537"},
-	31002153: {RegionID: 11000027, SystemID: 31002153, SystemName: "J162047"},
-	31002154: {RegionID: 11000027, SystemID: 31002154, SystemName: "J140514"},
-	31002155: {RegionID: 11000027, SystemID: 31002155, SystemName: "J154152"},
-	31002156: {RegionID: 11000027, SystemID: 31002156, SystemName: "J143626"},
-	31002157: {RegionID: 11000027, SystemID: 31002157, SystemName: "J161737"},
-	31002158: {RegionID: 11000027, SystemID: 31002158, SystemName: "J142110"},
-	31002159: {RegionID: 11000027, SystemID: 31002159, SystemName: "J112042"},
-	31002160: {RegionID: 11000027, SystemID: 31002160, SystemName: "J105719"},
-	31002161: {RegionID: 11000027, SystemID: 31002161, SystemName: "J101556"},
-	31002162: {RegionID: 11000027, SystemID: 31002162, SystemName: "J230047"},
-	31002163: {RegionID: 11000027, SystemID: 31002163, SystemName: "J213226"},
-	31002164: {RegionID: 11000027, SystemID: 31002164, SystemName: "J104218"},
-	31002165: {RegionID: 11000027, SystemID: 31002165, SystemName: "J115308"},
-	31002166: {RegionID: 11000027, SystemID: 31002166, SystemName: "J140418"},
-	31002167: {RegionID: 11000027, SystemID: 31002167, SystemName: "J101343"},
-	31002168: {RegionID: 11000027, SystemID: 31002168, SystemName: "J105135"},
-	31002169: {RegionID: 11000027, SystemID: 31002169, SystemName: "J111818"},
-	31002170: {RegionID: 11000027, SystemID: 31002170, SystemName: "J110841"},
-	31002171: {RegionID: 11000027, SystemID: 31002171, SystemName: "J134024"},
-	31002172: {RegionID: 11000027, SystemID: 31002172, SystemName: "J142349"},
-	31002173: {RegionID: 11000027, SystemID: 31002173, SystemName: "J212203"},
-	31002174: {RegionID: 11000027, SystemID: 31002174, SystemName: "J124942"},
-	31002175: {RegionID: 11000027, SystemID: 31002175, SystemName: "J131747"},
-	31002176: {RegionID: 11000027, SystemID: 31002176, SystemName: "J133553"},
-	31002177: {RegionID: 11000027, SystemID: 31002177, SystemName: "J115422"},
-	31002178: {RegionID: 11000027, SystemID: 31002178, SystemName: "J103448"},
-	31002179: {RegionID: 11000027, SystemID: 31002179, SystemName: "J161301"},
-	31002180: {RegionID: 11000027, SystemID: 31002180, SystemName: "J130334"},
-	31002181: {RegionID: 11000027, SystemID: 31002181, SystemName: "J172125"},
-	31002182: {RegionID: 11000027, SystemID: 31002182, SystemName: "J114537"},
-	31002183: {RegionID: 11000027, SystemID: 31002183, SystemName: "J134440"},
-	31002184: {RegionID: 11000027, SystemID: 31002184, SystemName: "J101912"},
-	31002185: {RegionID: 11000027, SystemID: 31002185, SystemName: "J214006"},
-	31002186: {RegionID: 11000027, SystemID: 31002186, SystemName: "J235108"},
-	31002187: {RegionID: 11000027, SystemID: 31002187, SystemName: "J124538"},
-	31002188: {RegionID: 11000027, SystemID: 31002188, SystemName: "J125641"},
-	31002189: {RegionID: 11000027, SystemID: 31002189, SystemName: "J141728"},
-	31002190: {RegionID: 11000027, SystemID: 31002190, SystemName: "J100616"},
-	31002191: {RegionID: 11000027, SystemID: 31002191, SystemName: "J204350"},
-	31002192: {RegionID: 11000027, SystemID: 31002192, SystemName: "J151300"},
-	31002193: {RegionID: 11000027, SystemID: 31002193, SystemName: "J114041"},
-	31002194: {RegionID: 11000028, SystemID: 31002194, SystemName: "J115644"},
-	31002195: {RegionID: 11000028, SystemID: 31002195, SystemName: "J103328"},
-	31002196: {RegionID: 11000028, SystemID: 31002196, SystemName: "J143127"},
-	31002197: {RegionID: 11000028, SystemID: 31002197, SystemName: "J121459"},
-	31002198: {RegionID: 11000028, SystemID: 31002198, SystemName: "J114441"},
-	31002199: {RegionID: 11000028, SystemID: 31002199, SystemName: "J102345"},
-	31002200: {RegionID: 11000028, SystemID: 31002200, SystemName: "J161609"},
-	31002201: {RegionID: 11000028, SystemID: 31002201, SystemName: "J131646"},
-	31002202: {RegionID: 11000028, SystemID: 31002202, SystemName: "J144543"},
-	31002203: {RegionID: 11000028, SystemID: 31002203, SystemName: "J123658"},
-	31002204: {RegionID: 11000028, SystemID: 31002204, SystemName: "J121450"},
-	31002205: {RegionID: 11000028, SystemID: 31002205, SystemName: "J145753"},
-	31002206: {RegionID: 11000028, SystemID: 31002206, SystemName: "J130510"},
-	31002207: {RegionID: 11000028, SystemID: 31002207, SystemName: "J135402"},
-	31002208: {RegionID: 11000028, SystemID: 31002208, SystemName: "J165220"},
-	31002209: {RegionID: 11000028, SystemID: 31002209, SystemName: "J124152"},
-	31002210: {RegionID: 11000028, SystemID: 31002210, SystemName: "J162349"},
-	31002211: {RegionID: 11000028, SystemID: 31002211, SystemName: "J210750"},
-	31002212: {RegionID: 11000028, SystemID: 31002212, SystemName: "J235305"},
-	31002213: {RegionID: 11000028, SystemID: 31002213, SystemName: "J120546"},
-	31002214: {RegionID: 11000028, SystemID: 31002214, SystemName: "J105059"},
-	31002215: {RegionID: 11000028, SystemID: 31002215, SystemName: "J140249"},
-	31002216: {RegionID: 11000028, SystemID: 31002216, SystemName: "J154551"},
-	31002217: {RegionID: 11000028, SystemID: 31002217, SystemName: "J151530"},
-	31002218: {RegionID: 11000028, SystemID: 31002218, SystemName: "J122520"},
-	31002219: {RegionID: 11000028, SystemID: 31002219, SystemName: "J151200"},
-	31002220: {RegionID: 11000028, SystemID: 31002220, SystemName: "J143245"},
-	31002221: {RegionID: 11000028, SystemID: 31002221, SystemName: "J124722"},
-	31002222: {RegionID: 11000028, SystemID: 31002222, SystemName: "J173506"},
-	31002223: {RegionID: 11000028, SystemID: 31002223, SystemName: "J121747"},
-	31002224: {RegionID: 11000028, SystemID: 31002224, SystemName: "J235456"},
-	31002225: {RegionID: 11000028, SystemID: 31002225, SystemName: "J113712"},
-	31002226: {RegionID: 11000028, SystemID: 31002226, SystemName: "J165940"},
-	31002227: {RegionID: 11000028, SystemID: 31002227, SystemName: "J165520"},
-	31002228: {RegionID: 11000028, SystemID: 31002228, SystemName: "J135653"},
-	31002229: {RegionID: 11000028, SystemID: 31002229, SystemName: "J132946"},
-	31002230: {RegionID: 11000028, SystemID: 31002230, SystemName: "J134702"},
-	31002231: {RegionID: 11000028, SystemID: 31002231, SystemName: "J111249"},
-	31002232: {RegionID: 11000028, SystemID: 31002232, SystemName: "J105607"},
-	31002233: {RegionID: 11000028, SystemID: 31002233, SystemName: "J100551"},
-	31002234: {RegionID: 11000028, SystemID: 31002234, SystemName: "J140524"},
-	31002235: {RegionID: 11000028, SystemID: 31002235, SystemName: "J234152"},
-	31002236: {RegionID: 11000028, SystemID: 31002236, SystemName: "J164732"},
-	31002237: {RegionID: 11000028, SystemID: 31002237, SystemName: "J131505"},
-	31002238: {RegionID: 11000028, SystemID: 31002238, SystemName: "J115405"},
-	31002239: {RegionID: 11000028, SystemID: 31002239, SystemName: "J215009"},
-	31002240: {RegionID: 11000028, SystemID: 31002240, SystemName: "J161940"},
-	31002241: {RegionID: 11000028, SystemID: 31002241, SystemName: "J145825"},
-	31002242: {RegionID: 11000028, SystemID: 31002242, SystemName: "J132605"},
-	31002243: {RegionID: 11000028, SystemID: 31002243, SystemName: "J115907"},
-	31002244: {RegionID: 11000028, SystemID: 31002244, SystemName: "J125634"},
-	31002245: {RegionID: 11000028, SystemID: 31002245, SystemName: "J110530"},
-	31002246: {RegionID: 11000028, SystemID: 31002246, SystemName: "J100854"},
-	31002247: {RegionID: 11000028, SystemID: 31002247, SystemName: "J102053"},
-	31002248: {RegionID: 11000028, SystemID: 31002248, SystemName: "J111518"},
-	31002249: {RegionID: 11000028, SystemID: 31002249, SystemName: "J145349"},
-	31002250: {RegionID: 11000028, SystemID: 31002250, SystemName: "J145846"},
-	31002251: {RegionID: 11000028, SystemID: 31002251, SystemName: "J215554"},
-	31002252: {RegionID: 11000028, SystemID: 31002252, SystemName: "J115950"},
-	31002253: {RegionID: 11000028, SystemID: 31002253, SystemName: "J100109"},
-	31002254: {RegionID: 11000028, SystemID: 31002254, SystemName: "J161838"},
-	31002255: {RegionID: 11000028, SystemID: 31002255, SystemName: "J173052"},
-	31002256: {RegionID: 11000028, SystemID: 31002256, SystemName: "J170807"},
-	31002257: {RegionID: 11000028, SystemID: 31002257, SystemName: "J105246"},
-	31002258: {RegionID: 11000028, SystemID: 31002258, SystemName: "J144944"},
-	31002259: {RegionID: 11000028, SystemID: 31002259, SystemName: "J170930"},
-	31002260: {RegionID: 11000028, SystemID: 31002260, SystemName: "J130039"},
-	31002261: {RegionID: 11000028, SystemID: 31002261, SystemName: "J103615"},
-	31002262: {RegionID: 11000028, SystemID: 31002262, SystemName: "J111227"},
-	31002263: {RegionID: 11000028, SystemID: 31002263, SystemName: "J153222"},
-	31002264: {RegionID: 11000028, SystemID: 31002264, SystemName: "J144434"},
-	31002265: {RegionID: 11000028, SystemID: 31002265, SystemName: "J230842"},
-	31002266: {RegionID: 11000028, SystemID: 31002266, SystemName: "J172139"},
-	31002267: {RegionID: 11000028, SystemID: 31002267, SystemName: "J232741"},
-	31002268: {RegionID: 11000028, SystemID: 31002268, SystemName: "J110634"},
-	31002269: {RegionID: 11000028, SystemID: 31002269, SystemName: "J124058"},
-	31002270: {RegionID: 11000028, SystemID: 31002270, SystemName: "J123432"},
-	31002271: {RegionID: 11000028, SystemID: 31002271, SystemName: "J151909"},
-	31002272: {RegionID: 11000028, SystemID: 31002272, SystemName: "J145706"},
-	31002273: {RegionID: 11000028, SystemID: 31002273, SystemName: "J230242"},
-	31002274: {RegionID: 11000028, SystemID: 31002274, SystemName: "J172840"},
-	31002275: {RegionID: 11000028, SystemID: 31002275, SystemName: "J131450"},
-	31002276: {RegionID: 11000028, SystemID: 31002276, SystemName: "J125101"},
-	31002277: {RegionID: 11000028, SystemID: 31002277, SystemName: "J142910"},
-	31002278: {RegionID: 11000028, SystemID: 31002278, SystemName: "J131142"},
-	31002279: {RegionID: 11000028, SystemID: 31002279, SystemName: "J152827"},
-	31002280: {RegionID: 11000028, SystemID: 31002280, SystemName: "J115901"},
-	31002281: {RegionID: 11000028, SystemID: 31002281, SystemName: "J114100"},
-	31002282: {RegionID: 11000028, SystemID: 31002282, SystemName: "J162132"},
-	31002283: {RegionID: 11000029, SystemID: 31002283, SystemName: "J171722"},
-	31002284: {RegionID: 11000029, SystemID: 31002284, SystemName: "J135554"},
-	31002285: {RegionID: 11000029, SystemID: 31002285, SystemName: "J214212"},
-	31002286: {RegionID: 11000029, SystemID: 31002286, SystemName: "J130305"},
-	31002287: {RegionID: 11000029, SystemID: 31002287, SystemName: "J111543"},
-	31002288: {RegionID: 11000029, SystemID: 31002288, SystemName: "J160623"},
-	31002289: {RegionID: 11000029, SystemID: 31002289, SystemName: "J234722"},
-	31002290: {RegionID: 11000029, SystemID: 31002290, SystemName: "J133513"},
-	31002291: {RegionID: 11000029, SystemID: 31002291, SystemName: "J104704"},
-	31002292: {RegionID: 11000029, SystemID: 31002292, SystemName: "J120354"},
-	31002293: {RegionID: 11000029, SystemID: 31002293, SystemName: "J165312"},
-	31002294: {RegionID: 11000029, SystemID: 31002294, SystemName: "J103812"},
-	31002295: {RegionID: 11000029, SystemID: 31002295, SystemName: "J143525"},
-	31002296: {RegionID: 11000029, SystemID: 31002296, SystemName: "J125716"},
-	31002297: {RegionID: 11000029, SystemID: 31002297, SystemName: "J143845"},
-	31002298: {RegionID: 11000029, SystemID: 31002298, SystemName: "J135626"},
-	31002299: {RegionID: 11000029, SystemID: 31002299, SystemName: "J115905"},
-	31002300: {RegionID: 11000029, SystemID: 31002300, SystemName: "J233917"},
-	31002301: {RegionID: 11000029, SystemID: 31002301, SystemName: "J140343"},
-	31002302: {RegionID: 11000029, SystemID: 31002302, SystemName: "J114315"},
-	31002303: {RegionID: 11000029, SystemID: 31002303, SystemName: "J142653"},
-	31002304: {RegionID: 11000029, SystemID: 31002304, SystemName: "J132546"},
-	31002305: {RegionID: 11000029, SystemID: 31002305, SystemName: "J165205"},
-	31002306: {RegionID: 11000029, SystemID: 31002306, SystemName: "J122518"},
-	31002307: {RegionID: 11000029, SystemID: 31002307, SystemName: "J153825"},
-	31002308: {RegionID: 11000029, SystemID: 31002308, SystemName: "J101435"},
-	31002309: {RegionID: 11000029, SystemID: 31002309, SystemName: "J143819"},
-	31002310: {RegionID: 11000029, SystemID: 31002310, SystemName: "J170417"},
-	31002311: {RegionID: 11000029, SystemID: 31002311, SystemName: "J115727"},
-	31002312: {RegionID: 11000029, SystemID: 31002312, SystemName: "J115124"},
-	31002313: {RegionID: 11000029, SystemID: 31002313, SystemName: "J135533"},
-	31002314: {RegionID: 11000029, SystemID: 31002314, SystemName: "J230745"},
-	31002315: {RegionID: 11000029, SystemID: 31002315, SystemName: "J112918"},
-	31002316: {RegionID: 11000029, SystemID: 31002316, SystemName: "J111640"},
-	31002317: {RegionID: 11000029, SystemID: 31002317, SystemName: "J231137"},
-	31002318: {RegionID: 11000029, SystemID: 31002318, SystemName: "J111613"},
-	31002319: {RegionID: 11000029, SystemID: 31002319, SystemName: "J145131"},
-	31002320: {RegionID: 11000029, SystemID: 31002320, SystemName: "J111003"},
-	31002321: {RegionID: 11000029, SystemID: 31002321, SystemName: "J131520"},
-	31002322: {RegionID: 11000029, SystemID: 31002322, SystemName: "J120922"},
-	31002323: {RegionID: 11000029, SystemID: 31002323, SystemName: "J142138"},
-	31002324: {RegionID: 11000029, SystemID: 31002324, SystemName: "J153106"},
-	31002325: {RegionID: 11000029, SystemID: 31002325, SystemName: "J111939"},
-	31002326: {RegionID: 11000029, SystemID: 31002326, SystemName: "J173550"},
-	31002327: {RegionID: 11000029, SystemID: 31002327, SystemName: "J145310"},
-	31002328: {RegionID: 11000029, SystemID: 31002328, SystemName: "J155928"},
-	31002329: {RegionID: 11000029, SystemID: 31002329, SystemName: "J234208"},
-	31002330: {RegionID: 11000029, SystemID: 31002330, SystemName: "J105722"},
-	31002331: {RegionID: 11000029, SystemID: 31002331, SystemName: "J123546"},
-	31002332: {RegionID: 11000029, SystemID: 31002332, SystemName: "J210235"},
-	31002333: {RegionID: 11000029, SystemID: 31002333, SystemName: "J170736"},
-	31002334: {RegionID: 11000029, SystemID: 31002334, SystemName: "J170240"},
-	31002335: {RegionID: 11000029, SystemID: 31002335, SystemName: "J125532"},
-	31002336: {RegionID: 11000029, SystemID: 31002336, SystemName: "J133923"},
-	31002337: {RegionID: 11000029, SystemID: 31002337, SystemName: "J131509"},
-	31002338: {RegionID: 11000029, SystemID: 31002338, SystemName: "J210519"},
-	31002339: {RegionID: 11000029, SystemID: 31002339, SystemName: "J233517"},
-	31002340: {RegionID: 11000029, SystemID: 31002340, SystemName: "J162612"},
-	31002341: {RegionID: 11000029, SystemID: 31002341, SystemName: "J123055"},
-	31002342: {RegionID: 11000029, SystemID: 31002342, SystemName: "J212302"},
-	31002343: {RegionID: 11000029, SystemID: 31002343, SystemName: "J102103"},
-	31002344: {RegionID: 11000029, SystemID: 31002344, SystemName: "J213956"},
-	31002345: {RegionID: 11000029, SystemID: 31002345, SystemName: "J161944"},
-	31002346: {RegionID: 11000029, SystemID: 31002346, SystemName: "J135705"},
-	31002347: {RegionID: 11000029, SystemID: 31002347, SystemName: "J103215"},
-	31002348: {RegionID: 11000029, SystemID: 31002348, SystemName: "J141032"},
-	31002349: {RegionID: 11000029, SystemID: 31002349, SystemName: "J135306"},
-	31002350: {RegionID: 11000029, SystemID: 31002350, SystemName: "J155256"},
-	31002351: {RegionID: 11000029, SystemID: 31002351, SystemName: "J162853"},
-	31002352: {RegionID: 11000029, SystemID: 31002352, SystemName: "J104809"},
-	31002353: {RegionID: 11000029, SystemID: 31002353, SystemName: "J144454"},
-	31002354: {RegionID: 11000029, SystemID: 31002354, SystemName: "J110759"},
-	31002355: {RegionID: 11000029, SystemID: 31002355, SystemName: "J215304"},
-	31002356: {RegionID: 11000029, SystemID: 31002356, SystemName: "J110946"},
-	31002357: {RegionID: 11000029, SystemID: 31002357, SystemName: "J230221"},
-	31002358: {RegionID: 11000029, SystemID: 31002358, SystemName: "J140133"},
-	31002359: {RegionID: 11000029, SystemID: 31002359, SystemName: "J105531"},
-	31002360: {RegionID: 11000029, SystemID: 31002360, SystemName: "J213502"},
-	31002361: {RegionID: 11000029, SystemID: 31002361, SystemName: "J101647"},
-	31002362: {RegionID: 11000029, SystemID: 31002362, SystemName: "J133023"},
-	31002363: {RegionID: 11000029, SystemID: 31002363, SystemName: "J164553"},
-	31002364: {RegionID: 11000029, SystemID: 31002364, SystemName: "J232959"},
-	31002365: {RegionID: 11000029, SystemID: 31002365, SystemName: "J152106"},
-	31002366: {RegionID: 11000029, SystemID: 31002366, SystemName: "J142535"},
-	31002367: {RegionID: 11000030, SystemID: 31002367, SystemName: "J104859"},
-	31002368: {RegionID: 11000030, SystemID: 31002368, SystemName: "J155838"},
-	31002369: {RegionID: 11000030, SystemID: 31002369, SystemName: "J164745"},
-	31002370: {RegionID: 11000030, SystemID: 31002370, SystemName: "J135504"},
-	31002371: {RegionID: 11000030, SystemID: 31002371, SystemName: "J115923"},
-	31002372: {RegionID: 11000030, SystemID: 31002372, SystemName: "J151325"},
-	31002373: {RegionID: 11000030, SystemID: 31002373, SystemName: "J105705"},
-	31002374: {RegionID: 11000030, SystemID: 31002374, SystemName: "J141434"},
-	31002375: {RegionID: 11000030, SystemID: 31002375, SystemName: "J115844"},
-	31002376: {RegionID: 11000030, SystemID: 31002376, SystemName: "J133906"},
-	31002377: {RegionID: 11000030, SystemID: 31002377, SystemName: "J115855"},
-	31002378: {RegionID: 11000030, SystemID: 31002378, SystemName: "J150020"},
-	31002379: {RegionID: 11000030, SystemID: 31002379, SystemName: "J142528"},
-	31002380: {RegionID: 11000030, SystemID: 31002380, SystemName: "J130900"},
-	31002381: {RegionID: 11000030, SystemID: 31002381, SystemName: "J222045"},
-	31002382: {RegionID: 11000030, SystemID: 31002382, SystemName: "J111450"},
-	31002383: {RegionID: 11000030, SystemID: 31002383, SystemName: "J103800"},
-	31002384: {RegionID: 11000030, SystemID: 31002384, SystemName: "J144420"},
-	31002385: {RegionID: 11000030, SystemID: 31002385, SystemName: "J152550"},
-	31002386: {RegionID: 11000030, SystemID: 31002386, SystemName: "J114003"},
-	31002387: {RegionID: 11000030, SystemID: 31002387, SystemName: "J143751"},
-	31002388: {RegionID: 11000030, SystemID: 31002388, SystemName: "J215930"},
-	31002389: {RegionID: 11000030, SystemID: 31002389, SystemName: "J120512"},
-	31002390: {RegionID: 11000030, SystemID: 31002390, SystemName: "J145939"},
-	31002391: {RegionID: 11000030, SystemID: 31002391, SystemName: "J135623"},
-	31002392: {RegionID: 11000030, SystemID: 31002392, SystemName: "J111918"},
-	31002393: {RegionID: 11000030, SystemID: 31002393, SystemName: "J162614"},
-	31002394: {RegionID: 11000030, SystemID: 31002394, SystemName: "J104921"},
-	31002395: {RegionID: 11000030, SystemID: 31002395, SystemName: "J230049"},
-	31002396: {RegionID: 11000030, SystemID: 31002396, SystemName: "J115935"},
-	31002397: {RegionID: 11000030, SystemID: 31002397, SystemName: "J151920"},
-	31002398: {RegionID: 11000030, SystemID: 31002398, SystemName: "J160855"},
-	31002399: {RegionID: 11000030, SystemID: 31002399, SystemName: "J155311"},
-	31002400: {RegionID: 11000030, SystemID: 31002400, SystemName: "J101553"},
-	31002401: {RegionID: 11000030, SystemID: 31002401, SystemName: "J110421"},
-	31002402: {RegionID: 11000030, SystemID: 31002402, SystemName: "J155207"},
-	31002403: {RegionID: 11000030, SystemID: 31002403, SystemName: "J143200"},
-	31002404: {RegionID: 11000030, SystemID: 31002404, SystemName: "J110834"},
-	31002405: {RegionID: 11000030, SystemID: 31002405, SystemName: "J142247"},
-	31002406: {RegionID: 11000030, SystemID: 31002406, SystemName: "J112028"},
-	31002407: {RegionID: 11000030, SystemID: 31002407, SystemName: "J111520"},
-	31002408: {RegionID: 11000030, SystemID: 31002408, SystemName: "J161335"},
-	31002409: {RegionID: 11000030, SystemID: 31002409, SystemName: "J170118"},
-	31002410: {RegionID: 11000030, SystemID: 31002410, SystemName: "J160722"},
-	31002411: {RegionID: 11000030, SystemID: 31002411, SystemName: "J124504"},
-	31002412: {RegionID: 11000030, SystemID: 31002412, SystemName: "J212612"},
-	31002413: {RegionID: 11000030, SystemID: 31002413, SystemName: "J154829"},
-	31002414: {RegionID: 11000030, SystemID: 31002414, SystemName: "J144436"},
-	31002415: {RegionID: 11000030, SystemID: 31002415, SystemName: "J165641"},
-	31002416: {RegionID: 11000030, SystemID: 31002416, SystemName: "J145510"},
-	31002417: {RegionID: 11000030, SystemID: 31002417, SystemName: "J110810"},
-	31002418: {RegionID: 11000030, SystemID: 31002418, SystemName: "J131916"},
-	31002419: {RegionID: 11000030, SystemID: 31002419, SystemName: "J120103"},
-	31002420: {RegionID: 11000030, SystemID: 31002420, SystemName: "J171404"},
-	31002421: {RegionID: 11000030, SystemID: 31002421, SystemName: "J104632"},
-	31002422: {RegionID: 11000030, SystemID: 31002422, SystemName: "J103400"},
-	31002423: {RegionID: 11000030, SystemID: 31002423, SystemName: "J154021"},
-	31002424: {RegionID: 11000030, SystemID: 31002424, SystemName: "J122249"},
-	31002425: {RegionID: 11000030, SystemID: 31002425, SystemName: "J213753"},
-	31002426: {RegionID: 11000030, SystemID: 31002426, SystemName: "J155650"},
-	31002427: {RegionID: 11000030, SystemID: 31002427, SystemName: "J152722"},
-	31002428: {RegionID: 11000030, SystemID: 31002428, SystemName: "J100501"},
-	31002429: {RegionID: 11000030, SystemID: 31002429, SystemName: "J140918"},
-	31002430: {RegionID: 11000030, SystemID: 31002430, SystemName: "J103414"},
-	31002431: {RegionID: 11000030, SystemID: 31002431, SystemName: "J223432"},
-	31002432: {RegionID: 11000030, SystemID: 31002432, SystemName: "J100409"},
-	31002433: {RegionID: 11000030, SystemID: 31002433, SystemName: "J141502"},
-	31002434: {RegionID: 11000030, SystemID: 31002434, SystemName: "J115008"},
-	31002435: {RegionID: 11000030, SystemID: 31002435, SystemName: "J112829"},
-	31002436: {RegionID: 11000030, SystemID: 31002436, SystemName: "J105801"},
-	31002437: {RegionID: 11000030, SystemID: 31002437, SystemName: "J105023"},
-	31002438: {RegionID: 11000030, SystemID: 31002438, SystemName: "J101652"},
-	31002439: {RegionID: 11000030, SystemID: 31002439, SystemName: "J163225"},
-	31002440: {RegionID: 11000030, SystemID: 31002440, SystemName: "J135220"},
-	31002441: {RegionID: 11000030, SystemID: 31002441, SystemName: "J114430"},
-	31002442: {RegionID: 11000030, SystemID: 31002442, SystemName: "J103504"},
-	31002443: {RegionID: 11000030, SystemID: 31002443, SystemName: "J123940"},
-	31002444: {RegionID: 11000030, SystemID: 31002444, SystemName: "J104857"},
-	31002445: {RegionID: 11000030, SystemID: 31002445, SystemName: "J112417"},
-	31002446: {RegionID: 11000030, SystemID: 31002446, SystemName: "J205141"},
-	31002447: {RegionID: 11000030, SystemID: 31002447, SystemName: "J104037"},
-	31002448: {RegionID: 11000030, SystemID: 31002448, SystemName: "J144902"},
-	31002449: {RegionID: 11000030, SystemID: 31002449, SystemName: "J122818"},
-	31002450: {RegionID: 11000030, SystemID: 31002450, SystemName: "J211353"},
-	31002451: {RegionID: 11000030, SystemID: 31002451, SystemName: "J104136"},
-	31002452: {RegionID: 11000030, SystemID: 31002452, SystemName: "J172842"},
-	31002453: {RegionID: 11000030, SystemID: 31002453, SystemName: "J122452"},
-	31002454: {RegionID: 11000030, SystemID: 31002454, SystemName: "J140200"},
-	31002455: {RegionID: 11000030, SystemID: 31002455, SystemName: "J232246"},
-	31002456: {RegionID: 11000030, SystemID: 31002456, SystemName: "J101748"},
-	31002457: {RegionID: 11000030, SystemID: 31002457, SystemName: "J133241"},
-	31002458: {RegionID: 11000030, SystemID: 31002458, SystemName: "J151817"},
-	31002459: {RegionID: 11000030, SystemID: 31002459, SystemName: "J124201"},
-	31002460: {RegionID: 11000030, SystemID: 31002460, SystemName: "J125111"},
-	31002461: {RegionID: 11000030, SystemID: 31002461, SystemName: "J101755"},
-	31002462: {RegionID: 11000030, SystemID: 31002462, SystemName: "J101708"},
-	31002463: {RegionID: 11000030, SystemID: 31002463, SystemName: "J135031"},
-	31002464: {RegionID: 11000030, SystemID: 31002464, SystemName: "J152117"},
-	31002465: {RegionID: 11000030, SystemID: 31002465, SystemName: "J164701"},
-	31002466: {RegionID: 11000030, SystemID: 31002466, SystemName: "J132106"},
-	31002467: {RegionID: 11000030, SystemID: 31002467, SystemName: "J141319"},
-	31002468: {RegionID: 11000030, SystemID: 31002468, SystemName: "J170845"},
-	31002469: {RegionID: 11000030, SystemID: 31002469, SystemName: "J161215"},
-	31002470: {RegionID: 11000030, SystemID: 31002470, SystemName: "J111447"},
-	31002471: {RegionID: 11000029, SystemID: 31002471, SystemName: "J222408"},
-	31002472: {RegionID: 11000028, SystemID: 31002472, SystemName: "J161747"},
-	31002473: {RegionID: 11000029, SystemID: 31002473, SystemName: "J132328"},
-	31002474: {RegionID: 11000030, SystemID: 31002474, SystemName: "J153217"},
-	31002475: {RegionID: 11000029, SystemID: 31002475, SystemName: "J142814"},
-	31002476: {RegionID: 11000026, SystemID: 31002476, SystemName: "J101248"},
-	31002477: {RegionID: 11000029, SystemID: 31002477, SystemName: "J135825"},
-	31002478: {RegionID: 11000030, SystemID: 31002478, SystemName: "J151838"},
-	31002479: {RegionID: 11000028, SystemID: 31002479, SystemName: "J100820"},
-	31002480: {RegionID: 11000029, SystemID: 31002480, SystemName: "J213344"},
-	31002481: {RegionID: 11000025, SystemID: 31002481, SystemName: "J103701"},
-	31002482: {RegionID: 11000025, SystemID: 31002482, SystemName: "J171246"},
-	31002483: {RegionID: 11000024, SystemID: 31002483, SystemName: "J170158"},
-	31002484: {RegionID: 11000030, SystemID: 31002484, SystemName: "J230559"},
-	31002485: {RegionID: 11000025, SystemID: 31002485, SystemName: "J145208"},
-	31002486: {RegionID: 11000024, SystemID: 31002486, SystemName: "J224721"},
-	31002487: {RegionID: 11000030, SystemID: 31002487, SystemName: "J105934"},
-	31002488: {RegionID: 11000025, SystemID: 31002488, SystemName: "J130818"},
-	31002489: {RegionID: 11000030, SystemID: 31002489, SystemName: "J140555"},
-	31002490: {RegionID: 11000027, SystemID: 31002490, SystemName: "J221356"},
-	31002491: {RegionID: 11000028, SystemID: 31002491, SystemName: "J111245"},
-	31002492: {RegionID: 11000030, SystemID: 31002492, SystemName: "J102834"},
-	31002493: {RegionID: 11000026, SystemID: 31002493, SystemName: "J154509"},
-	31002494: {RegionID: 11000025, SystemID: 31002494, SystemName: "J234928"},
-	31002495: {RegionID: 11000030, SystemID: 31002495, SystemName: "J222604"},
-	31002496: {RegionID: 11000025, SystemID: 31002496, SystemName: "J133011"},
-	31002497: {RegionID: 11000029, SystemID: 31002497, SystemName: "J125245"},
-	31002498: {RegionID: 11000030, SystemID: 31002498, SystemName: "J145523"},
-	31002499: {RegionID: 11000027, SystemID: 31002499, SystemName: "J143605"},
-	31002500: {RegionID: 11000024, SystemID: 31002500, SystemName: "J120750"},
-	31002501: {RegionID: 11000026, SystemID: 31002501, SystemName: "J104617"},
-	31002502: {RegionID: 11000030, SystemID: 31002502, SystemName: "J125122"},
-	31002503: {RegionID: 11000025, SystemID: 31002503, SystemName: "J114809"},
-	31002504: {RegionID: 11000027, SystemID: 31002504, SystemName: "J125657"},
-	31002505: {RegionID: 11000001, SystemID: 31002505, SystemName: "J015092"},
-	31002506: {RegionID: 11000001, SystemID: 31002506, SystemName: "J005834"},
-	31002507: {RegionID: 11000001, SystemID: 31002507, SystemName: "J012402"},
-	31002508: {RegionID: 11000001, SystemID: 31002508, SystemName: "J005926"},
-	31002509: {RegionID: 11000001, SystemID: 31002509, SystemName: "J010569"},
-	31002510: {RegionID: 11000001, SystemID: 31002510, SystemName: "J012157"},
-	31002511: {RegionID: 11000001, SystemID: 31002511, SystemName: "J002757"},
-	31002512: {RegionID: 11000001, SystemID: 31002512, SystemName: "J004791"},
-	31002513: {RegionID: 11000001, SystemID: 31002513, SystemName: "J004317"},
-	31002514: {RegionID: 11000001, SystemID: 31002514, SystemName: "J002216"},
-	31002515: {RegionID: 11000004, SystemID: 31002515, SystemName: "J005482"},
-	31002516: {RegionID: 11000004, SystemID: 31002516, SystemName: "J005900"},
-	31002517: {RegionID: 11000004, SystemID: 31002517, SystemName: "J013070"},
-	31002518: {RegionID: 11000004, SystemID: 31002518, SystemName: "J001057"},
-	31002519: {RegionID: 11000004, SystemID: 31002519, SystemName: "J011321"},
-	31002520: {RegionID: 11000004, SystemID: 31002520, SystemName: "J013123"},
-	31002521: {RegionID: 11000004, SystemID: 31002521, SystemName: "J012735"},
-	31002522: {RegionID: 11000004, SystemID: 31002522, SystemName: "J012635"},
-	31002523: {RegionID: 11000004, SystemID: 31002523, SystemName: "J010000"},
-	31002524: {RegionID: 11000004, SystemID: 31002524, SystemName: "J001890"},
-	31002525: {RegionID: 11000004, SystemID: 31002525, SystemName: "J002964"},
-	31002526: {RegionID: 11000004, SystemID: 31002526, SystemName: "J004686"},
-	31002527: {RegionID: 11000009, SystemID: 31002527, SystemName: "J011563"},
-	31002528: {RegionID: 11000009, SystemID: 31002528, SystemName: "J001348"},
-	31002529: {RegionID: 11000009, SystemID: 31002529, SystemName: "J002838"},
-	31002530: {RegionID: 11000009, SystemID: 31002530, SystemName: "J001670"},
-	31002531: {RegionID: 11000009, SystemID: 31002531, SystemName: "J014348"},
-	31002532: {RegionID: 11000009, SystemID: 31002532, SystemName: "J003789"},
-	31002533: {RegionID: 11000009, SystemID: 31002533, SystemName: "J012794"},
-	31002534: {RegionID: 11000009, SystemID: 31002534, SystemName: "J005923"},
-	31002535: {RegionID: 11000009, SystemID: 31002535, SystemName: "J001398"},
-	31002536: {RegionID: 11000009, SystemID: 31002536, SystemName: "J011339"},
-	31002537: {RegionID: 11000009, SystemID: 31002537, SystemName: "J004283"},
-	31002538: {RegionID: 11000016, SystemID: 31002538, SystemName: "J002625"},
-	31002539: {RegionID: 11000016, SystemID: 31002539, SystemName: "J004470"},
-	31002540: {RegionID: 11000016, SystemID: 31002540, SystemName: "J011824"},
-	31002541: {RegionID: 11000016, SystemID: 31002541, SystemName: "J010247"},
-	31002542: {RegionID: 11000016, SystemID: 31002542, SystemName: "J002423"},
-	31002543: {RegionID: 11000016, SystemID: 31002543, SystemName: "J001302"},
-	31002544: {RegionID: 11000016, SystemID: 31002544, SystemName: "J010556"},
-	31002545: {RegionID: 11000016, SystemID: 31002545, SystemName: "J011778"},
-	31002546: {RegionID: 11000016, SystemID: 31002546, SystemName: "J005259"},
-	31002547: {RegionID: 11000016, SystemID: 31002547, SystemName: "J005969"},
-	31002548: {RegionID: 11000016, SystemID: 31002548, SystemName: "J001769"},
-	31002549: {RegionID: 11000016, SystemID: 31002549, SystemName: "J004150"},
-	31002550: {RegionID: 11000016, SystemID: 31002550, SystemName: "J005872"},
-	31002551: {RegionID: 11000016, SystemID: 31002551, SystemName: "J011376"},
-	31002552: {RegionID: 11000016, SystemID: 31002552, SystemName: "J005299"},
-	31002553: {RegionID: 11000016, SystemID: 31002553, SystemName: "J011355"},
-	31002554: {RegionID: 11000016, SystemID: 31002554, SystemName: "J011195"},
-	31002555: {RegionID: 11000016, SystemID: 31002555, SystemName: "J005280"},
-	31002556: {RegionID: 11000024, SystemID: 31002556, SystemName: "J010811"},
-	31002557: {RegionID: 11000024, SystemID: 31002557, SystemName: "J001694"},
-	31002558: {RegionID: 11000024, SystemID: 31002558, SystemName: "J003941"},
-	31002559: {RegionID: 11000024, SystemID: 31002559, SystemName: "J011790"},
-	31002560: {RegionID: 11000024, SystemID: 31002560, SystemName: "J013146"},
-	31002561: {RegionID: 11000024, SystemID: 31002561, SystemName: "J004998"},
-	31002562: {RegionID: 11000024, SystemID: 31002562, SystemName: "J003382"},
-	31002563: {RegionID: 11000024, SystemID: 31002563, SystemName: "J003546"},
-	31002564: {RegionID: 11000024, SystemID: 31002564, SystemName: "J001820"},
-	31002565: {RegionID: 11000024, SystemID: 31002565, SystemName: "J012578"},
-	31002566: {RegionID: 11000024, SystemID: 31002566, SystemName: "J012773"},
-	31002567: {RegionID: 11000024, SystemID: 31002567, SystemName: "J005223"},
-	31002568: {RegionID: 11000024, SystemID: 31002568, SystemName: "J005663"},
-	31002569: {RegionID: 11000024, SystemID: 31002569, SystemName: "J003793"},
-	31002570: {RegionID: 11000024, SystemID: 31002570, SystemName: "J004128"},
-	31002571: {RegionID: 11000024, SystemID: 31002571, SystemName: "J005070"},
-	31002572: {RegionID: 11000024, SystemID: 31002572, SystemName: "J001025"},
-	31002573: {RegionID: 11000024, SystemID: 31002573, SystemName: "J005724"},
-	31002574: {RegionID: 11000024, SystemID: 31002574, SystemName: "J004921"},
-	31002575: {RegionID: 11000030, SystemID: 31002575, SystemName: "J012686"},
-	31002576: {RegionID: 11000030, SystemID: 31002576, SystemName: "J010366"},
-	31002577: {RegionID: 11000030, SystemID: 31002577, SystemName: "J012475"},
-	31002578: {RegionID: 11000030, SystemID: 31002578, SystemName: "J015227"},
-	31002579: {RegionID: 11000030, SystemID: 31002579, SystemName: "J010951"},
-	31002580: {RegionID: 11000032, SystemID: 31002580, SystemName: "J000895"},
-	31002581: {RegionID: 11000032, SystemID: 31002581, SystemName: "J000487"},
-	31002582: {RegionID: 11000032, SystemID: 31002582, SystemName: "J000621"},
-	31002583: {RegionID: 11000032, SystemID: 31002583, SystemName: "J000551"},
-	31002584: {RegionID: 11000032, SystemID: 31002584, SystemName: "J000630"},
-	31002585: {RegionID: 11000032, SystemID: 31002585, SystemName: "J000452"},
-	31002586: {RegionID: 11000032, SystemID: 31002586, SystemName: "J000327"},
-	31002587: {RegionID: 11000032, SystemID: 31002587, SystemName: "J000186"},
-	31002588: {RegionID: 11000032, SystemID: 31002588, SystemName: "J000528"},
-	31002589: {RegionID: 11000032, SystemID: 31002589, SystemName: "J000353"},
-	31002590: {RegionID: 11000032, SystemID: 31002590, SystemName: "J000461"},
-	31002591: {RegionID: 11000032, SystemID: 31002591, SystemName: "J000522"},
-	31002592: {RegionID: 11000032, SystemID: 31002592, SystemName: "J000965"},
-	31002593: {RegionID: 11000032, SystemID: 31002593, SystemName: "J000304"},
-	31002594: {RegionID: 11000032, SystemID: 31002594, SystemName: "J000595"},
-	31002595: {RegionID: 11000032, SystemID: 31002595, SystemName: "J000685"},
-	31002596: {RegionID: 11000032, SystemID: 31002596, SystemName: "J000844"},
-	31002597: {RegionID: 11000032, SystemID: 31002597, SystemName: "J000719"},
-	31002598: {RegionID: 11000032, SystemID: 31002598, SystemName: "J000726"},
-	31002599: {RegionID: 11000032, SystemID: 31002599, SystemName: "J000214"},
-	31002600: {RegionID: 11000032, SystemID: 31002600, SystemName: "J000652"},
-	31002601: {RegionID: 11000032, SystemID: 31002601, SystemName: "J000313"},
-	31002602: {RegionID: 11000032, SystemID: 31002602, SystemName: "J000687"},
-	31002603: {RegionID: 11000032, SystemID: 31002603, SystemName: "J000427"},
-	31002604: {RegionID: 11000032, SystemID: 31002604, SystemName: "J000102"},
+	31000001: {RegionID: 11000033, SystemID: 31000001, SystemName: "J055520", WormholeClass: 14},
+	31000002: {RegionID: 11000033, SystemID: 31000002, SystemName: "J110145", WormholeClass: 15},
+	31000003: {RegionID: 11000033, SystemID: 31000003, SystemName: "J164710", WormholeClass: 16},
+	31000004: {RegionID: 11000033, SystemID: 31000004, SystemName: "J200727", WormholeClass: 17},
+	31000005: {RegionID: 11000031, SystemID: 31000005, SystemName: "Thera", WormholeClass: 12},
+	31000006: {RegionID: 11000033, SystemID: 31000006, SystemName: "J174618", WormholeClass: 18},
+	31000007: {RegionID: 11000001, SystemID: 31000007, SystemName: "J105443", WormholeClass: 1},
+	31000008: {RegionID: 11000001, SystemID: 31000008, SystemName: "J100744", WormholeClass: 1},
+	31000009: {RegionID: 11000001, SystemID: 31000009, SystemName: "J225046", WormholeClass: 1},
+	31000010: {RegionID: 11000001, SystemID: 31000010, SystemName: "J160837", WormholeClass: 1},
+	31000011: {RegionID: 11000001, SystemID: 31000011, SystemName: "J114700", WormholeClass: 1},
+	31000012: {RegionID: 11000001, SystemID: 31000012, SystemName: "J134914", WormholeClass: 1},
+	31000013: {RegionID: 11000001, SystemID: 31000013, SystemName: "J102655", WormholeClass: 1},
+	31000014: {RegionID: 11000001, SystemID: 31000014, SystemName: "J134312", WormholeClass: 1},
+	31000015: {RegionID: 11000001, SystemID: 31000015, SystemName: "J205818", WormholeClass: 1},
+	31000016: {RegionID: 11000001, SystemID: 31000016, SystemName: "J113434", WormholeClass: 1},
+	31000017: {RegionID: 11000001, SystemID: 31000017, SystemName: "J105711", WormholeClass: 1},
+	31000018: {RegionID: 11000001, SystemID: 31000018, SystemName: "J164218", WormholeClass: 1},
+	31000019: {RegionID: 11000001, SystemID: 31000019, SystemName: "J154535", WormholeClass: 1},
+	31000020: {RegionID: 11000001, SystemID: 31000020, SystemName: "J111301", WormholeClass: 1},
+	31000021: {RegionID: 11000001, SystemID: 31000021, SystemName: "J135038", WormholeClass: 1},
+	31000022: {RegionID: 11000001, SystemID: 31000022, SystemName: "J121358", WormholeClass: 1},
+	31000023: {RegionID: 11000001, SystemID: 31000023, SystemName: "J222914", WormholeClass: 1},
+	31000024: {RegionID: 11000001, SystemID: 31000024, SystemName: "J155429", WormholeClass: 1},
+	31000025: {RegionID: 11000001, SystemID: 31000025, SystemName: "J204640", WormholeClass: 1},
+	31000026: {RegionID: 11000001, SystemID: 31000026, SystemName: "J162604", WormholeClass: 1},
+	31000027: {RegionID: 11000001, SystemID: 31000027, SystemName: "J164807", WormholeClass: 1},
+	31000028: {RegionID: 11000001, SystemID: 31000028, SystemName: "J233317", WormholeClass: 1},
+	31000029: {RegionID: 11000001, SystemID: 31000029, SystemName: "J155023", WormholeClass: 1},
+	31000030: {RegionID: 11000001, SystemID: 31000030, SystemName: "J112628", WormholeClass: 1},
+	31000031: {RegionID: 11000001, SystemID: 31000031, SystemName: "J153001", WormholeClass: 1},
+	31000032: {RegionID: 11000001, SystemID: 31000032, SystemName: "J143204", WormholeClass: 1},
+	31000033: {RegionID: 11000001, SystemID: 31000033, SystemName: "J101729", WormholeClass: 1},
+	31000034: {RegionID: 11000001, SystemID: 31000034, SystemName: "J221203", WormholeClass: 1},
+	31000035: {RegionID: 11000001, SystemID: 31000035, SystemName: "J125428", WormholeClass: 1},
+	31000036: {RegionID: 11000001, SystemID: 31000036, SystemName: "J131854", WormholeClass: 1},
+	31000037: {RegionID: 11000001, SystemID: 31000037, SystemName: "J160534", WormholeClass: 1},
+	31000038: {RegionID: 11000001, SystemID: 31000038, SystemName: "J144855", WormholeClass: 1},
+	31000039: {RegionID: 11000001, SystemID: 31000039, SystemName: "J101453", WormholeClass: 1},
+	31000040: {RegionID: 11000001, SystemID: 31000040, SystemName: "J144913", WormholeClass: 1},
+	31000041: {RegionID: 11000001, SystemID: 31000041, SystemName: "J144530", WormholeClass: 1},
+	31000042: {RegionID: 11000001, SystemID: 31000042, SystemName: "J135411", WormholeClass: 1},
+	31000043: {RegionID: 11000001, SystemID: 31000043, SystemName: "J125713", WormholeClass: 1},
+	31000044: {RegionID: 11000001, SystemID: 31000044, SystemName: "J105521", WormholeClass: 1},
+	31000045: {RegionID: 11000001, SystemID: 31000045, SystemName: "J224324", WormholeClass: 1},
+	31000046: {RegionID: 11000001, SystemID: 31000046, SystemName: "J163203", WormholeClass: 1},
+	31000047: {RegionID: 11000001, SystemID: 31000047, SystemName: "J171818", WormholeClass: 1},
+	31000048: {RegionID: 11000001, SystemID: 31000048, SystemName: "J145337", WormholeClass: 1},
+	31000049: {RegionID: 11000001, SystemID: 31000049, SystemName: "J150131", WormholeClass: 1},
+	31000050: {RegionID: 11000001, SystemID: 31000050, SystemName: "J130842", WormholeClass: 1},
+	31000051: {RegionID: 11000001, SystemID: 31000051, SystemName: "J145406", WormholeClass: 1},
+	31000052: {RegionID: 11000001, SystemID: 31000052, SystemName: "J103404", WormholeClass: 1},
+	31000053: {RegionID: 11000001, SystemID: 31000053, SystemName: "J112124", WormholeClass: 1},
+	31000054: {RegionID: 11000001, SystemID: 31000054, SystemName: "J124926", WormholeClass: 1},
+	31000055: {RegionID: 11000001, SystemID: 31000055, SystemName: "J232605", WormholeClass: 1},
+	31000056: {RegionID: 11000001, SystemID: 31000056, SystemName: "J144938", WormholeClass: 1},
+	31000057: {RegionID: 11000001, SystemID: 31000057, SystemName: "J233828", WormholeClass: 1},
+	31000058: {RegionID: 11000001, SystemID: 31000058, SystemName: "J130714", WormholeClass: 1},
+	31000059: {RegionID: 11000001, SystemID: 31000059, SystemName: "J142701", WormholeClass: 1},
+	31000060: {RegionID: 11000001, SystemID: 31000060, SystemName: "J154226", WormholeClass: 1},
+	31000061: {RegionID: 11000001, SystemID: 31000061, SystemName: "J125843", WormholeClass: 1},
+	31000062: {RegionID: 11000001, SystemID: 31000062, SystemName: "J155459", WormholeClass: 1},
+	31000063: {RegionID: 11000001, SystemID: 31000063, SystemName: "J225555", WormholeClass: 1},
+	31000064: {RegionID: 11000001, SystemID: 31000064, SystemName: "J115549", WormholeClass: 1},
+	31000065: {RegionID: 11000001, SystemID: 31000065, SystemName: "J140741", WormholeClass: 1},
+	31000066: {RegionID: 11000001, SystemID: 31000066, SystemName: "J104140", WormholeClass: 1},
+	31000067: {RegionID: 11000001, SystemID: 31000067, SystemName: "J101757", WormholeClass: 1},
+	31000068: {RegionID: 11000001, SystemID: 31000068, SystemName: "J100250", WormholeClass: 1},
+	31000069: {RegionID: 11000001, SystemID: 31000069, SystemName: "J113721", WormholeClass: 1},
+	31000070: {RegionID: 11000001, SystemID: 31000070, SystemName: "J105039", WormholeClass: 1},
+	31000071: {RegionID: 11000001, SystemID: 31000071, SystemName: "J233550", WormholeClass: 1},
+	31000072: {RegionID: 11000001, SystemID: 31000072, SystemName: "J114033", WormholeClass: 1},
+	31000073: {RegionID: 11000001, SystemID: 31000073, SystemName: "J105936", WormholeClass: 1},
+	31000074: {RegionID: 11000001, SystemID: 31000074, SystemName: "J140121", WormholeClass: 1},
+	31000075: {RegionID: 11000001, SystemID: 31000075, SystemName: "J154029", WormholeClass: 1},
+	31000076: {RegionID: 11000001, SystemID: 31000076, SystemName: "J101336", WormholeClass: 1},
+	31000077: {RegionID: 11000001, SystemID: 31000077, SystemName: "J160039", WormholeClass: 1},
+	31000078: {RegionID: 11000001, SystemID: 31000078, SystemName: "J161257", WormholeClass: 1},
+	31000079: {RegionID: 11000001, SystemID: 31000079, SystemName: "J144303", WormholeClass: 1},
+	31000080: {RegionID: 11000001, SystemID: 31000080, SystemName: "J121131", WormholeClass: 1},
+	31000081: {RegionID: 11000001, SystemID: 31000081, SystemName: "J113653", WormholeClass: 1},
+	31000082: {RegionID: 11000001, SystemID: 31000082, SystemName: "J123454", WormholeClass: 1},
+	31000083: {RegionID: 11000001, SystemID: 31000083, SystemName: "J110750", WormholeClass: 1},
+	31000084: {RegionID: 11000001, SystemID: 31000084, SystemName: "J151106", WormholeClass: 1},
+	31000085: {RegionID: 11000001, SystemID: 31000085, SystemName: "J100040", WormholeClass: 1},
+	31000086: {RegionID: 11000001, SystemID: 31000086, SystemName: "J223733", WormholeClass: 1},
+	31000087: {RegionID: 11000001, SystemID: 31000087, SystemName: "J110431", WormholeClass: 1},
+	31000088: {RegionID: 11000001, SystemID: 31000088, SystemName: "J134446", WormholeClass: 1},
+	31000089: {RegionID: 11000001, SystemID: 31000089, SystemName: "J143202", WormholeClass: 1},
+	31000090: {RegionID: 11000001, SystemID: 31000090, SystemName: "J210436", WormholeClass: 1},
+	31000091: {RegionID: 11000001, SystemID: 31000091, SystemName: "J133030", WormholeClass: 1},
+	31000092: {RegionID: 11000001, SystemID: 31000092, SystemName: "J170645", WormholeClass: 1},
+	31000093: {RegionID: 11000001, SystemID: 31000093, SystemName: "J103151", WormholeClass: 1},
+	31000094: {RegionID: 11000001, SystemID: 31000094, SystemName: "J222206", WormholeClass: 1},
+	31000095: {RegionID: 11000001, SystemID: 31000095, SystemName: "J130602", WormholeClass: 1},
+	31000096: {RegionID: 11000001, SystemID: 31000096, SystemName: "J123708", WormholeClass: 1},
+	31000097: {RegionID: 11000001, SystemID: 31000097, SystemName: "J123831", WormholeClass: 1},
+	31000098: {RegionID: 11000001, SystemID: 31000098, SystemName: "J164927", WormholeClass: 1},
+	31000099: {RegionID: 11000001, SystemID: 31000099, SystemName: "J134143", WormholeClass: 1},
+	31000100: {RegionID: 11000001, SystemID: 31000100, SystemName: "J214534", WormholeClass: 1},
+	31000101: {RegionID: 11000001, SystemID: 31000101, SystemName: "J135250", WormholeClass: 1},
+	31000102: {RegionID: 11000001, SystemID: 31000102, SystemName: "J111707", WormholeClass: 1},
+	31000103: {RegionID: 11000001, SystemID: 31000103, SystemName: "J135245", WormholeClass: 1},
+	31000104: {RegionID: 11000001, SystemID: 31000104, SystemName: "J101408", WormholeClass: 1},
+	31000105: {RegionID: 11000001, SystemID: 31000105, SystemName: "J215117", WormholeClass: 1},
+	31000106: {RegionID: 11000001, SystemID: 31000106, SystemName: "J141019", WormholeClass: 1},
+	31000107: {RegionID: 11000001, SystemID: 31000107, SystemName: "J102206", WormholeClass: 1},
+	31000108: {RegionID: 11000001, SystemID: 31000108, SystemName: "J125903", WormholeClass: 1},
+	31000109: {RegionID: 11000001, SystemID: 31000109, SystemName: "J134939", WormholeClass: 1},
+	31000110: {RegionID: 11000001, SystemID: 31000110, SystemName: "J162641", WormholeClass: 1},
+	31000111: {RegionID: 11000001, SystemID: 31000111, SystemName: "J152537", WormholeClass: 1},
+	31000112: {RegionID: 11000001, SystemID: 31000112, SystemName: "J224442", WormholeClass: 1},
+	31000113: {RegionID: 11000001, SystemID: 31000113, SystemName: "J104138", WormholeClass: 1},
+	31000114: {RegionID: 11000001, SystemID: 31000114, SystemName: "J121516", WormholeClass: 1},
+	31000115: {RegionID: 11000001, SystemID: 31000115, SystemName: "J114540", WormholeClass: 1},
+	31000116: {RegionID: 11000001, SystemID: 31000116, SystemName: "J155013", WormholeClass: 1},
+	31000117: {RegionID: 11000001, SystemID: 31000117, SystemName: "J144450", WormholeClass: 1},
+	31000118: {RegionID: 11000001, SystemID: 31000118, SystemName: "J141239", WormholeClass: 1},
+	31000119: {RegionID: 11000001, SystemID: 31000119, SystemName: "J115545", WormholeClass: 1},
+	31000120: {RegionID: 11000001, SystemID: 31000120, SystemName: "J114905", WormholeClass: 1},
+	31000121: {RegionID: 11000001, SystemID: 31000121, SystemName: "J120522", WormholeClass: 1},
+	31000122: {RegionID: 11000001, SystemID: 31000122, SystemName: "J172907", WormholeClass: 1},
+	31000123: {RegionID: 11000001, SystemID: 31000123, SystemName: "J164550", WormholeClass: 1},
+	31000124: {RegionID: 11000001, SystemID: 31000124, SystemName: "J141812", WormholeClass: 1},
+	31000125: {RegionID: 11000001, SystemID: 31000125, SystemName: "J134637", WormholeClass: 1},
+	31000126: {RegionID: 11000001, SystemID: 31000126, SystemName: "J134132", WormholeClass: 1},
+	31000127: {RegionID: 11000001, SystemID: 31000127, SystemName: "J125011", WormholeClass: 1},
+	31000128: {RegionID: 11000001, SystemID: 31000128, SystemName: "J105942", WormholeClass: 1},
+	31000129: {RegionID: 11000001, SystemID: 31000129, SystemName: "J171653", WormholeClass: 1},
+	31000130: {RegionID: 11000001, SystemID: 31000130, SystemName: "J110213", WormholeClass: 1},
+	31000131: {RegionID: 11000001, SystemID: 31000131, SystemName: "J115048", WormholeClass: 1},
+	31000132: {RegionID: 11000001, SystemID: 31000132, SystemName: "J174405", WormholeClass: 1},
+	31000133: {RegionID: 11000001, SystemID: 31000133, SystemName: "J123111", WormholeClass: 1},
+	31000134: {RegionID: 11000001, SystemID: 31000134, SystemName: "J123047", WormholeClass: 1},
+	31000135: {RegionID: 11000001, SystemID: 31000135, SystemName: "J231541", WormholeClass: 1},
+	31000136: {RegionID: 11000001, SystemID: 31000136, SystemName: "J215900", WormholeClass: 1},
+	31000137: {RegionID: 11000001, SystemID: 31000137, SystemName: "J110651", WormholeClass: 1},
+	31000138: {RegionID: 11000001, SystemID: 31000138, SystemName: "J140602", WormholeClass: 1},
+	31000139: {RegionID: 11000001, SystemID: 31000139, SystemName: "J163804", WormholeClass: 1},
+	31000140: {RegionID: 11000002, SystemID: 31000140, SystemName: "J125209", WormholeClass: 1},
+	31000141: {RegionID: 11000002, SystemID: 31000141, SystemName: "J140831", WormholeClass: 1},
+	31000142: {RegionID: 11000002, SystemID: 31000142, SystemName: "J161811", WormholeClass: 1},
+	31000143: {RegionID: 11000002, SystemID: 31000143, SystemName: "J112913", WormholeClass: 1},
+	31000144: {RegionID: 11000002, SystemID: 31000144, SystemName: "J105632", WormholeClass: 1},
+	31000145: {RegionID: 11000002, SystemID: 31000145, SystemName: "J160710", WormholeClass: 1},
+	31000146: {RegionID: 11000002, SystemID: 31000146, SystemName: "J160835", WormholeClass: 1},
+	31000147: {RegionID: 11000002, SystemID: 31000147, SystemName: "J122659", WormholeClass: 1},
+	31000148: {RegionID: 11000002, SystemID: 31000148, SystemName: "J130931", WormholeClass: 1},
+	31000149: {RegionID: 11000002, SystemID: 31000149, SystemName: "J161115", WormholeClass: 1},
+	31000150: {RegionID: 11000002, SystemID: 31000150, SystemName: "J120734", WormholeClass: 1},
+	31000151: {RegionID: 11000002, SystemID: 31000151, SystemName: "J110906", WormholeClass: 1},
+	31000152: {RegionID: 11000002, SystemID: 31000152, SystemName: "J121418", WormholeClass: 1},
+	31000153: {RegionID: 11000002, SystemID: 31000153, SystemName: "J113820", WormholeClass: 1},
+	31000154: {RegionID: 11000002, SystemID: 31000154, SystemName: "J113506", WormholeClass: 1},
+	31000155: {RegionID: 11000002, SystemID: 31000155, SystemName: "J172240", WormholeClass: 1},
+	31000156: {RegionID: 11000002, SystemID: 31000156, SystemName: "J110101", WormholeClass: 1},
+	31000157: {RegionID: 11000002, SystemID: 31000157, SystemName: "J110108", WormholeClass: 1},
+	31000158: {RegionID: 11000002, SystemID: 31000158, SystemName: "J213555", WormholeClass: 1},
+	31000159: {RegionID: 11000002, SystemID: 31000159, SystemName: "J144704", WormholeClass: 1},
+	31000160: {RegionID: 11000002, SystemID: 31000160, SystemName: "J115815", WormholeClass: 1},
+	31000161: {RegionID: 11000002, SystemID: 31000161, SystemName: "J133653", WormholeClass: 1},
+	31000162: {RegionID: 11000002, SystemID: 31000162, SystemName: "J171700", WormholeClass: 1},
+	31000163: {RegionID: 11000002, SystemID: 31000163, SystemName: "J104628", WormholeClass: 1},
+	31000164: {RegionID: 11000002, SystemID: 31000164, SystemName: "J114546", WormholeClass: 1},
+	31000165: {RegionID: 11000002, SystemID: 31000165, SystemName: "J235419", WormholeClass: 1},
+	31000166: {RegionID: 11000002, SystemID: 31000166, SystemName: "J204503", WormholeClass: 1},
+	31000167: {RegionID: 11000002, SystemID: 31000167, SystemName: "J215417", WormholeClass: 1},
+	31000168: {RegionID: 11000002, SystemID: 31000168, SystemName: "J110545", WormholeClass: 1},
+	31000169: {RegionID: 11000002, SystemID: 31000169, SystemName: "J153536", WormholeClass: 1},
+	31000170: {RegionID: 11000002, SystemID: 31000170, SystemName: "J150407", WormholeClass: 1},
+	31000171: {RegionID: 11000002, SystemID: 31000171, SystemName: "J153530", WormholeClass: 1},
+	31000172: {RegionID: 11000002, SystemID: 31000172, SystemName: "J131232", WormholeClass: 1},
+	31000173: {RegionID: 11000002, SystemID: 31000173, SystemName: "J101020", WormholeClass: 1},
+	31000174: {RegionID: 11000002, SystemID: 31000174, SystemName: "J133613", WormholeClass: 1},
+	31000175: {RegionID: 11000002, SystemID: 31000175, SystemName: "J165901", WormholeClass: 1},
+	31000176: {RegionID: 11000002, SystemID: 31000176, SystemName: "J152006", WormholeClass: 1},
+	31000177: {RegionID: 11000002, SystemID: 31000177, SystemName: "J160345", WormholeClass: 1},
+	31000178: {RegionID: 11000002, SystemID: 31000178, SystemName: "J134330", WormholeClass: 1},
+	31000179: {RegionID: 11000002, SystemID: 31000179, SystemName: "J213342", WormholeClass: 1},
+	31000180: {RegionID: 11000002, SystemID: 31000180, SystemName: "J150745", WormholeClass: 1},
+	31000181: {RegionID: 11000002, SystemID: 31000181, SystemName: "J165056", WormholeClass: 1},
+	31000182: {RegionID: 11000002, SystemID: 31000182, SystemName: "J150827", WormholeClass: 1},
+	31000183: {RegionID: 11000002, SystemID: 31000183, SystemName: "J144632", WormholeClass: 1},
+	31000184: {RegionID: 11000002, SystemID: 31000184, SystemName: "J102630", WormholeClass: 1},
+	31000185: {RegionID: 11000002, SystemID: 31000185, SystemName: "J133335", WormholeClass: 1},
+	31000186: {RegionID: 11000002, SystemID: 31000186, SystemName: "J125925", WormholeClass: 1},
+	31000187: {RegionID: 11000002, SystemID: 31000187, SystemName: "J142617", WormholeClass: 1},
+	31000188: {RegionID: 11000002, SystemID: 31000188, SystemName: "J150818", WormholeClass: 1},
+	31000189: {RegionID: 11000002, SystemID: 31000189, SystemName: "J114420", WormholeClass: 1},
+	31000190: {RegionID: 11000002, SystemID: 31000190, SystemName: "J112250", WormholeClass: 1},
+	31000191: {RegionID: 11000002, SystemID: 31000191, SystemName: "J155935", WormholeClass: 1},
+	31000192: {RegionID: 11000002, SystemID: 31000192, SystemName: "J115738", WormholeClass: 1},
+	31000193: {RegionID: 11000002, SystemID: 31000193, SystemName: "J232715", WormholeClass: 1},
+	31000194: {RegionID: 11000002, SystemID: 31000194, SystemName: "J110051", WormholeClass: 1},
+	31000195: {RegionID: 11000002, SystemID: 31000195, SystemName: "J152928", WormholeClass: 1},
+	31000196: {RegionID: 11000002, SystemID: 31000196, SystemName: "J132216", WormholeClass: 1},
+	31000197: {RegionID: 11000002, SystemID: 31000197, SystemName: "J132758", WormholeClass: 1},
+	31000198: {RegionID: 11000002, SystemID: 31000198, SystemName: "J134145", WormholeClass: 1},
+	31000199: {RegionID: 11000002, SystemID: 31000199, SystemName: "J102849", WormholeClass: 1},
+	31000200: {RegionID: 11000002, SystemID: 31000200, SystemName: "J120335", WormholeClass: 1},
+	31000201: {RegionID: 11000002, SystemID: 31000201, SystemName: "J104439", WormholeClass: 1},
+	31000202: {RegionID: 11000002, SystemID: 31000202, SystemName: "J122717", WormholeClass: 1},
+	31000203: {RegionID: 11000002, SystemID: 31000203, SystemName: "J101817", WormholeClass: 1},
+	31000204: {RegionID: 11000002, SystemID: 31000204, SystemName: "J131551", WormholeClass: 1},
+	31000205: {RegionID: 11000002, SystemID: 31000205, SystemName: "J115200", WormholeClass: 1},
+	31000206: {RegionID: 11000002, SystemID: 31000206, SystemName: "J155029", WormholeClass: 1},
+	31000207: {RegionID: 11000002, SystemID: 31000207, SystemName: "J223703", WormholeClass: 1},
+	31000208: {RegionID: 11000002, SystemID: 31000208, SystemName: "J233359", WormholeClass: 1},
+	31000209: {RegionID: 11000002, SystemID: 31000209, SystemName: "J114107", WormholeClass: 1},
+	31000210: {RegionID: 11000002, SystemID: 31000210, SystemName: "J152014", WormholeClass: 1},
+	31000211: {RegionID: 11000002, SystemID: 31000211, SystemName: "J100211", WormholeClass: 1},
+	31000212: {RegionID: 11000002, SystemID: 31000212, SystemName: "J104103", WormholeClass: 1},
+	31000213: {RegionID: 11000002, SystemID: 31000213, SystemName: "J171019", WormholeClass: 1},
+	31000214: {RegionID: 11000002, SystemID: 31000214, SystemName: "J114313", WormholeClass: 1},
+	31000215: {RegionID: 11000002, SystemID: 31000215, SystemName: "J130542", WormholeClass: 1},
+	31000216: {RegionID: 11000002, SystemID: 31000216, SystemName: "J114719", WormholeClass: 1},
+	31000217: {RegionID: 11000002, SystemID: 31000217, SystemName: "J130322", WormholeClass: 1},
+	31000218: {RegionID: 11000002, SystemID: 31000218, SystemName: "J161524", WormholeClass: 1},
+	31000219: {RegionID: 11000002, SystemID: 31000219, SystemName: "J162858", WormholeClass: 1},
+	31000220: {RegionID: 11000002, SystemID: 31000220, SystemName: "J121158", WormholeClass: 1},
+	31000221: {RegionID: 11000002, SystemID: 31000221, SystemName: "J113050", WormholeClass: 1},
+	31000222: {RegionID: 11000002, SystemID: 31000222, SystemName: "J141514", WormholeClass: 1},
+	31000223: {RegionID: 11000002, SystemID: 31000223, SystemName: "J220654", WormholeClass: 1},
+	31000224: {RegionID: 11000002, SystemID: 31000224, SystemName: "J162118", WormholeClass: 1},
+	31000225: {RegionID: 11000002, SystemID: 31000225, SystemName: "J231004", WormholeClass: 1},
+	31000226: {RegionID: 11000002, SystemID: 31000226, SystemName: "J120621", WormholeClass: 1},
+	31000227: {RegionID: 11000002, SystemID: 31000227, SystemName: "J151601", WormholeClass: 1},
+	31000228: {RegionID: 11000002, SystemID: 31000228, SystemName: "J105837", WormholeClass: 1},
+	31000229: {RegionID: 11000002, SystemID: 31000229, SystemName: "J143133", WormholeClass: 1},
+	31000230: {RegionID: 11000002, SystemID: 31000230, SystemName: "J100422", WormholeClass: 1},
+	31000231: {RegionID: 11000002, SystemID: 31000231, SystemName: "J104335", WormholeClass: 1},
+	31000232: {RegionID: 11000002, SystemID: 31000232, SystemName: "J105700", WormholeClass: 1},
+	31000233: {RegionID: 11000002, SystemID: 31000233, SystemName: "J215431", WormholeClass: 1},
+	31000234: {RegionID: 11000002, SystemID: 31000234, SystemName: "J143628", WormholeClass: 1},
+	31000235: {RegionID: 11000002, SystemID: 31000235, SystemName: "J141150", WormholeClass: 1},
+	31000236: {RegionID: 11000002, SystemID: 31000236, SystemName: "J163743", WormholeClass: 1},
+	31000237: {RegionID: 11000002, SystemID: 31000237, SystemName: "J161509", WormholeClass: 1},
+	31000238: {RegionID: 11000002, SystemID: 31000238, SystemName: "J150629", WormholeClass: 1},
+	31000239: {RegionID: 11000002, SystemID: 31000239, SystemName: "J141017", WormholeClass: 1},
+	31000240: {RegionID: 11000002, SystemID: 31000240, SystemName: "J130045", WormholeClass: 1},
+	31000241: {RegionID: 11000002, SystemID: 31000241, SystemName: "J123748", WormholeClass: 1},
+	31000242: {RegionID: 11000002, SystemID: 31000242, SystemName: "J124051", WormholeClass: 1},
+	31000243: {RegionID: 11000002, SystemID: 31000243, SystemName: "J151141", WormholeClass: 1},
+	31000244: {RegionID: 11000002, SystemID: 31000244, SystemName: "J112129", WormholeClass: 1},
+	31000245: {RegionID: 11000002, SystemID: 31000245, SystemName: "J213653", WormholeClass: 1},
+	31000246: {RegionID: 11000002, SystemID: 31000246, SystemName: "J143649", WormholeClass: 1},
+	31000247: {RegionID: 11000002, SystemID: 31000247, SystemName: "J223855", WormholeClass: 1},
+	31000248: {RegionID: 11000002, SystemID: 31000248, SystemName: "J140019", WormholeClass: 1},
+	31000249: {RegionID: 11000002, SystemID: 31000249, SystemName: "J110605", WormholeClass: 1},
+	31000250: {RegionID: 11000002, SystemID: 31000250, SystemName: "J122843", WormholeClass: 1},
+	31000251: {RegionID: 11000002, SystemID: 31000251, SystemName: "J205004", WormholeClass: 1},
+	31000252: {RegionID: 11000002, SystemID: 31000252, SystemName: "J122114", WormholeClass: 1},
+	31000253: {RegionID: 11000002, SystemID: 31000253, SystemName: "J163533", WormholeClass: 1},
+	31000254: {RegionID: 11000002, SystemID: 31000254, SystemName: "J151518", WormholeClass: 1},
+	31000255: {RegionID: 11000002, SystemID: 31000255, SystemName: "J122712", WormholeClass: 1},
+	31000256: {RegionID: 11000002, SystemID: 31000256, SystemName: "J143002", WormholeClass: 1},
+	31000257: {RegionID: 11000002, SystemID: 31000257, SystemName: "J160307", WormholeClass: 1},
+	31000258: {RegionID: 11000002, SystemID: 31000258, SystemName: "J213932", WormholeClass: 1},
+	31000259: {RegionID: 11000002, SystemID: 31000259, SystemName: "J102414", WormholeClass: 1},
+	31000260: {RegionID: 11000002, SystemID: 31000260, SystemName: "J170949", WormholeClass: 1},
+	31000261: {RegionID: 11000002, SystemID: 31000261, SystemName: "J235001", WormholeClass: 1},
+	31000262: {RegionID: 11000002, SystemID: 31000262, SystemName: "J103320", WormholeClass: 1},
+	31000263: {RegionID: 11000002, SystemID: 31000263, SystemName: "J141038", WormholeClass: 1},
+	31000264: {RegionID: 11000002, SystemID: 31000264, SystemName: "J172003", WormholeClass: 1},
+	31000265: {RegionID: 11000002, SystemID: 31000265, SystemName: "J124215", WormholeClass: 1},
+	31000266: {RegionID: 11000002, SystemID: 31000266, SystemName: "J215754", WormholeClass: 1},
+	31000267: {RegionID: 11000002, SystemID: 31000267, SystemName: "J102837", WormholeClass: 1},
+	31000268: {RegionID: 11000002, SystemID: 31000268, SystemName: "J130155", WormholeClass: 1},
+	31000269: {RegionID: 11000002, SystemID: 31000269, SystemName: "J170544", WormholeClass: 1},
+	31000270: {RegionID: 11000002, SystemID: 31000270, SystemName: "J142649", WormholeClass: 1},
+	31000271: {RegionID: 11000002, SystemID: 31000271, SystemName: "J150539", WormholeClass: 1},
+	31000272: {RegionID: 11000002, SystemID: 31000272, SystemName: "J163156", WormholeClass: 1},
+	31000273: {RegionID: 11000002, SystemID: 31000273, SystemName: "J120338", WormholeClass: 1},
+	31000274: {RegionID: 11000002, SystemID: 31000274, SystemName: "J142941", WormholeClass: 1},
+	31000275: {RegionID: 11000002, SystemID: 31000275, SystemName: "J110126", WormholeClass: 1},
+	31000276: {RegionID: 11000002, SystemID: 31000276, SystemName: "J155504", WormholeClass: 1},
+	31000277: {RegionID: 11000002, SystemID: 31000277, SystemName: "J144228", WormholeClass: 1},
+	31000278: {RegionID: 11000002, SystemID: 31000278, SystemName: "J223650", WormholeClass: 1},
+	31000279: {RegionID: 11000002, SystemID: 31000279, SystemName: "J102734", WormholeClass: 1},
+	31000280: {RegionID: 11000002, SystemID: 31000280, SystemName: "J105232", WormholeClass: 1},
+	31000281: {RegionID: 11000002, SystemID: 31000281, SystemName: "J133128", WormholeClass: 1},
+	31000282: {RegionID: 11000002, SystemID: 31000282, SystemName: "J140135", WormholeClass: 1},
+	31000283: {RegionID: 11000002, SystemID: 31000283, SystemName: "J115216", WormholeClass: 1},
+	31000284: {RegionID: 11000002, SystemID: 31000284, SystemName: "J153311", WormholeClass: 1},
+	31000285: {RegionID: 11000002, SystemID: 31000285, SystemName: "J164756", WormholeClass: 1},
+	31000286: {RegionID: 11000002, SystemID: 31000286, SystemName: "J165216", WormholeClass: 1},
+	31000287: {RegionID: 11000002, SystemID: 31000287, SystemName: "J114914", WormholeClass: 1},
+	31000288: {RegionID: 11000002, SystemID: 31000288, SystemName: "J212504", WormholeClass: 1},
+	31000289: {RegionID: 11000002, SystemID: 31000289, SystemName: "J120256", WormholeClass: 1},
+	31000290: {RegionID: 11000002, SystemID: 31000290, SystemName: "J143421", WormholeClass: 1},
+	31000291: {RegionID: 11000002, SystemID: 31000291, SystemName: "J223026", WormholeClass: 1},
+	31000292: {RegionID: 11000002, SystemID: 31000292, SystemName: "J102918", WormholeClass: 1},
+	31000293: {RegionID: 11000003, SystemID: 31000293, SystemName: "J142136", WormholeClass: 1},
+	31000294: {RegionID: 11000003, SystemID: 31000294, SystemName: "J134540", WormholeClass: 1},
+	31000295: {RegionID: 11000003, SystemID: 31000295, SystemName: "J124451", WormholeClass: 1},
+	31000296: {RegionID: 11000003, SystemID: 31000296, SystemName: "J102749", WormholeClass: 1},
+	31000297: {RegionID: 11000003, SystemID: 31000297, SystemName: "J165741", WormholeClass: 1},
+	31000298: {RegionID: 11000003, SystemID: 31000298, SystemName: "J142119", WormholeClass: 1},
+	31000299: {RegionID: 11000003, SystemID: 31000299, SystemName: "J105642", WormholeClass: 1},
+	31000300: {RegionID: 11000003, SystemID: 31000300, SystemName: "J154354", WormholeClass: 1},
+	31000301: {RegionID: 11000003, SystemID: 31000301, SystemName: "J102005", WormholeClass: 1},
+	31000302: {RegionID: 11000003, SystemID: 31000302, SystemName: "J133052", WormholeClass: 1},
+	31000303: {RegionID: 11000003, SystemID: 31000303, SystemName: "J103341", WormholeClass: 1},
+	31000304: {RegionID: 11000003, SystemID: 31000304, SystemName: "J142114", WormholeClass: 1},
+	31000305: {RegionID: 11000003, SystemID: 31000305, SystemName: "J152353", WormholeClass: 1},
+	31000306: {RegionID: 11000003, SystemID: 31000306, SystemName: "J120431", WormholeClass: 1},
+	31000307: {RegionID: 11000003, SystemID: 31000307, SystemName: "J103547", WormholeClass: 1},
+	31000308: {RegionID: 11000003, SystemID: 31000308, SystemName: "J161107", WormholeClass: 1},
+	31000309: {RegionID: 11000003, SystemID: 31000309, SystemName: "J160334", WormholeClass: 1},
+	31000310: {RegionID: 11000003, SystemID: 31000310, SystemName: "J101845", WormholeClass: 1},
+	31000311: {RegionID: 11000003, SystemID: 31000311, SystemName: "J171805", WormholeClass: 1},
+	31000312: {RegionID: 11000003, SystemID: 31000312, SystemName: "J134610", WormholeClass: 1},
+	31000313: {RegionID: 11000003, SystemID: 31000313, SystemName: "J173842", WormholeClass: 1},
+	31000314: {RegionID: 11000003, SystemID: 31000314, SystemName: "J155203", WormholeClass: 1},
+	31000315: {RegionID: 11000003, SystemID: 31000315, SystemName: "J121935", WormholeClass: 1},
+	31000316: {RegionID: 11000003, SystemID: 31000316, SystemName: "J105013", WormholeClass: 1},
+	31000317: {RegionID: 11000003, SystemID: 31000317, SystemName: "J161846", WormholeClass: 1},
+	31000318: {RegionID: 11000003, SystemID: 31000318, SystemName: "J113632", WormholeClass: 1},
+	31000319: {RegionID: 11000003, SystemID: 31000319, SystemName: "J170106", WormholeClass: 1},
+	31000320: {RegionID: 11000003, SystemID: 31000320, SystemName: "J123828", WormholeClass: 1},
+	31000321: {RegionID: 11000003, SystemID: 31000321, SystemName: "J172147", WormholeClass: 1},
+	31000322: {RegionID: 11000003, SystemID: 31000322, SystemName: "J164759", WormholeClass: 1},
+	31000323: {RegionID: 11000003, SystemID: 31000323, SystemName: "J212957", WormholeClass: 1},
+	31000324: {RegionID: 11000003, SystemID: 31000324, SystemName: "J212338", WormholeClass: 1},
+	31000325: {RegionID: 11000003, SystemID: 31000325, SystemName: "J115823", WormholeClass: 1},
+	31000326: {RegionID: 11000003, SystemID: 31000326, SystemName: "J112744", WormholeClass: 1},
+	31000327: {RegionID: 11000003, SystemID: 31000327, SystemName: "J121941", WormholeClass: 1},
+	31000328: {RegionID: 11000003, SystemID: 31000328, SystemName: "J125650", WormholeClass: 1},
+	31000329: {RegionID: 11000003, SystemID: 31000329, SystemName: "J161344", WormholeClass: 1},
+	31000330: {RegionID: 11000003, SystemID: 31000330, SystemName: "J134833", WormholeClass: 1},
+	31000331: {RegionID: 11000003, SystemID: 31000331, SystemName: "J143517", WormholeClass: 1},
+	31000332: {RegionID: 11000003, SystemID: 31000332, SystemName: "J133245", WormholeClass: 1},
+	31000333: {RegionID: 11000003, SystemID: 31000333, SystemName: "J113918", WormholeClass: 1},
+	31000334: {RegionID: 11000003, SystemID: 31000334, SystemName: "J161644", WormholeClass: 1},
+	31000335: {RegionID: 11000003, SystemID: 31000335, SystemName: "J152257", WormholeClass: 1},
+	31000336: {RegionID: 11000003, SystemID: 31000336, SystemName: "J155833", WormholeClass: 1},
+	31000337: {RegionID: 11000003, SystemID: 31000337, SystemName: "J151332", WormholeClass: 1},
+	31000338: {RegionID: 11000003, SystemID: 31000338, SystemName: "J231306", WormholeClass: 1},
+	31000339: {RegionID: 11000003, SystemID: 31000339, SystemName: "J171312", WormholeClass: 1},
+	31000340: {RegionID: 11000003, SystemID: 31000340, SystemName: "J112406", WormholeClass: 1},
+	31000341: {RegionID: 11000003, SystemID: 31000341, SystemName: "J212904", WormholeClass: 1},
+	31000342: {RegionID: 11000003, SystemID: 31000342, SystemName: "J111811", WormholeClass: 1},
+	31000343: {RegionID: 11000003, SystemID: 31000343, SystemName: "J104201", WormholeClass: 1},
+	31000344: {RegionID: 11000003, SystemID: 31000344, SystemName: "J150216", WormholeClass: 1},
+	31000345: {RegionID: 11000003, SystemID: 31000345, SystemName: "J112934", WormholeClass: 1},
+	31000346: {RegionID: 11000003, SystemID: 31000346, SystemName: "J115808", WormholeClass: 1},
+	31000347: {RegionID: 11000003, SystemID: 31000347, SystemName: "J121915", WormholeClass: 1},
+	31000348: {RegionID: 11000003, SystemID: 31000348, SystemName: "J133259", WormholeClass: 1},
+	31000349: {RegionID: 11000003, SystemID: 31000349, SystemName: "J150325", WormholeClass: 1},
+	31000350: {RegionID: 11000003, SystemID: 31000350, SystemName: "J122224", WormholeClass: 1},
+	31000351: {RegionID: 11000003, SystemID: 31000351, SystemName: "J131702", WormholeClass: 1},
+	31000352: {RegionID: 11000003, SystemID: 31000352, SystemName: "J141807", WormholeClass: 1},
+	31000353: {RegionID: 11000003, SystemID: 31000353, SystemName: "J142327", WormholeClass: 1},
+	31000354: {RegionID: 11000003, SystemID: 31000354, SystemName: "J144822", WormholeClass: 1},
+	31000355: {RegionID: 11000004, SystemID: 31000355, SystemName: "J164417", WormholeClass: 2},
+	31000356: {RegionID: 11000004, SystemID: 31000356, SystemName: "J125227", WormholeClass: 2},
+	31000357: {RegionID: 11000004, SystemID: 31000357, SystemName: "J113057", WormholeClass: 2},
+	31000358: {RegionID: 11000004, SystemID: 31000358, SystemName: "J101524", WormholeClass: 2},
+	31000359: {RegionID: 11000004, SystemID: 31000359, SystemName: "J124508", WormholeClass: 2},
+	31000360: {RegionID: 11000004, SystemID: 31000360, SystemName: "J141156", WormholeClass: 2},
+	31000361: {RegionID: 11000004, SystemID: 31000361, SystemName: "J222822", WormholeClass: 2},
+	31000362: {RegionID: 11000004, SystemID: 31000362, SystemName: "J104253", WormholeClass: 2},
+	31000363: {RegionID: 11000004, SystemID: 31000363, SystemName: "J144153", WormholeClass: 2},
+	31000364: {RegionID: 11000004, SystemID: 31000364, SystemName: "J103406", WormholeClass: 2},
+	31000365: {RegionID: 11000004, SystemID: 31000365, SystemName: "J133210", WormholeClass: 2},
+	31000366: {RegionID: 11000004, SystemID: 31000366, SystemName: "J111740", WormholeClass: 2},
+	31000367: {RegionID: 11000004, SystemID: 31000367, SystemName: "J125956", WormholeClass: 2},
+	31000368: {RegionID: 11000004, SystemID: 31000368, SystemName: "J105951", WormholeClass: 2},
+	31000369: {RegionID: 11000004, SystemID: 31000369, SystemName: "J141857", WormholeClass: 2},
+	31000370: {RegionID: 11000004, SystemID: 31000370, SystemName: "J120726", WormholeClass: 2},
+	31000371: {RegionID: 11000004, SystemID: 31000371, SystemName: "J155737", WormholeClass: 2},
+	31000372: {RegionID: 11000004, SystemID: 31000372, SystemName: "J144605", WormholeClass: 2},
+	31000373: {RegionID: 11000004, SystemID: 31000373, SystemName: "J144218", WormholeClass: 2},
+	31000374: {RegionID: 11000004, SystemID: 31000374, SystemName: "J114835", WormholeClass: 2},
+	31000375: {RegionID: 11000004, SystemID: 31000375, SystemName: "J134006", WormholeClass: 2},
+	31000376: {RegionID: 11000004, SystemID: 31000376, SystemName: "J160941", WormholeClass: 2},
+	31000377: {RegionID: 11000004, SystemID: 31000377, SystemName: "J111557", WormholeClass: 2},
+	31000378: {RegionID: 11000004, SystemID: 31000378, SystemName: "J124219", WormholeClass: 2},
+	31000379: {RegionID: 11000004, SystemID: 31000379, SystemName: "J155620", WormholeClass: 2},
+	31000380: {RegionID: 11000004, SystemID: 31000380, SystemName: "J114346", WormholeClass: 2},
+	31000381: {RegionID: 11000004, SystemID: 31000381, SystemName: "J153532", WormholeClass: 2},
+	31000382: {RegionID: 11000004, SystemID: 31000382, SystemName: "J211936", WormholeClass: 2},
+	31000383: {RegionID: 11000004, SystemID: 31000383, SystemName: "J211036", WormholeClass: 2},
+	31000384: {RegionID: 11000004, SystemID: 31000384, SystemName: "J145805", WormholeClass: 2},
+	31000385: {RegionID: 11000004, SystemID: 31000385, SystemName: "J151548", WormholeClass: 2},
+	31000386: {RegionID: 11000004, SystemID: 31000386, SystemName: "J160412", WormholeClass: 2},
+	31000387: {RegionID: 11000004, SystemID: 31000387, SystemName: "J104008", WormholeClass: 2},
+	31000388: {RegionID: 11000004, SystemID: 31000388, SystemName: "J145715", WormholeClass: 2},
+	31000389: {RegionID: 11000004, SystemID: 31000389, SystemName: "J235321", WormholeClass: 2},
+	31000390: {RegionID: 11000004, SystemID: 31000390, SystemName: "J103339", WormholeClass: 2},
+	31000391: {RegionID: 11000004, SystemID: 31000391, SystemName: "J132009", WormholeClass: 2},
+	31000392: {RegionID: 11000004, SystemID: 31000392, SystemName: "J210548", WormholeClass: 2},
+	31000393: {RegionID: 11000004, SystemID: 31000393, SystemName: "J235408", WormholeClass: 2},
+	31000394: {RegionID: 11000004, SystemID: 31000394, SystemName: "J122821", WormholeClass: 2},
+	31000395: {RegionID: 11000004, SystemID: 31000395, SystemName: "J121925", WormholeClass: 2},
+	31000396: {RegionID: 11000004, SystemID: 31000396, SystemName: "J151047", WormholeClass: 2},
+	31000397: {RegionID: 11000004, SystemID: 31000397, SystemName: "J111011", WormholeClass: 2},
+	31000398: {RegionID: 11000004, SystemID: 31000398, SystemName: "J154212", WormholeClass: 2},
+	31000399: {RegionID: 11000004, SystemID: 31000399, SystemName: "J130256", WormholeClass: 2},
+	31000400: {RegionID: 11000004, SystemID: 31000400, SystemName: "J113230", WormholeClass: 2},
+	31000401: {RegionID: 11000004, SystemID: 31000401, SystemName: "J132557", WormholeClass: 2},
+	31000402: {RegionID: 11000004, SystemID: 31000402, SystemName: "J233534", WormholeClass: 2},
+	31000403: {RegionID: 11000004, SystemID: 31000403, SystemName: "J232801", WormholeClass: 2},
+	31000404: {RegionID: 11000004, SystemID: 31000404, SystemName: "J153627", WormholeClass: 2},
+	31000405: {RegionID: 11000004, SystemID: 31000405, SystemName: "J134800", WormholeClass: 2},
+	31000406: {RegionID: 11000004, SystemID: 31000406, SystemName: "J111508", WormholeClass: 2},
+	31000407: {RegionID: 11000004, SystemID: 31000407, SystemName: "J143639", WormholeClass: 2},
+	31000408: {RegionID: 11000004, SystemID: 31000408, SystemName: "J160753", WormholeClass: 2},
+	31000409: {RegionID: 11000004, SystemID: 31000409, SystemName: "J151231", WormholeClass: 2},
+	31000410: {RegionID: 11000004, SystemID: 31000410, SystemName: "J142547", WormholeClass: 2},
+	31000411: {RegionID: 11000004, SystemID: 31000411, SystemName: "J142306", WormholeClass: 2},
+	31000412: {RegionID: 11000004, SystemID: 31000412, SystemName: "J210422", WormholeClass: 2},
+	31000413: {RegionID: 11000004, SystemID: 31000413, SystemName: "J112137", WormholeClass: 2},
+	31000414: {RegionID: 11000004, SystemID: 31000414, SystemName: "J160715", WormholeClass: 2},
+	31000415: {RegionID: 11000004, SystemID: 31000415, SystemName: "J145931", WormholeClass: 2},
+	31000416: {RegionID: 11000004, SystemID: 31000416, SystemName: "J103217", WormholeClass: 2},
+	31000417: {RegionID: 11000004, SystemID: 31000417, SystemName: "J143505", WormholeClass: 2},
+	31000418: {RegionID: 11000004, SystemID: 31000418, SystemName: "J142533", WormholeClass: 2},
+	31000419: {RegionID: 11000004, SystemID: 31000419, SystemName: "J112146", WormholeClass: 2},
+	31000420: {RegionID: 11000004, SystemID: 31000420, SystemName: "J103839", WormholeClass: 2},
+	31000421: {RegionID: 11000004, SystemID: 31000421, SystemName: "J152433", WormholeClass: 2},
+	31000422: {RegionID: 11000004, SystemID: 31000422, SystemName: "J110408", WormholeClass: 2},
+	31000423: {RegionID: 11000004, SystemID: 31000423, SystemName: "J205546", WormholeClass: 2},
+	31000424: {RegionID: 11000004, SystemID: 31000424, SystemName: "J152801", WormholeClass: 2},
+	31000425: {RegionID: 11000004, SystemID: 31000425, SystemName: "J120308", WormholeClass: 2},
+	31000426: {RegionID: 11000004, SystemID: 31000426, SystemName: "J103948", WormholeClass: 2},
+	31000427: {RegionID: 11000004, SystemID: 31000427, SystemName: "J112820", WormholeClass: 2},
+	31000428: {RegionID: 11000004, SystemID: 31000428, SystemName: "J231837", WormholeClass: 2},
+	31000429: {RegionID: 11000004, SystemID: 31000429, SystemName: "J110043", WormholeClass: 2},
+	31000430: {RegionID: 11000004, SystemID: 31000430, SystemName: "J121454", WormholeClass: 2},
+	31000431: {RegionID: 11000004, SystemID: 31000431, SystemName: "J230959", WormholeClass: 2},
+	31000432: {RegionID: 11000004, SystemID: 31000432, SystemName: "J103408", WormholeClass: 2},
+	31000433: {RegionID: 11000004, SystemID: 31000433, SystemName: "J162720", WormholeClass: 2},
+	31000434: {RegionID: 11000004, SystemID: 31000434, SystemName: "J132418", WormholeClass: 2},
+	31000435: {RegionID: 11000004, SystemID: 31000435, SystemName: "J164235", WormholeClass: 2},
+	31000436: {RegionID: 11000004, SystemID: 31000436, SystemName: "J143429", WormholeClass: 2},
+	31000437: {RegionID: 11000004, SystemID: 31000437, SystemName: "J134654", WormholeClass: 2},
+	31000438: {RegionID: 11000004, SystemID: 31000438, SystemName: "J112801", WormholeClass: 2},
+	31000439: {RegionID: 11000004, SystemID: 31000439, SystemName: "J110656", WormholeClass: 2},
+	31000440: {RegionID: 11000004, SystemID: 31000440, SystemName: "J134951", WormholeClass: 2},
+	31000441: {RegionID: 11000004, SystemID: 31000441, SystemName: "J155307", WormholeClass: 2},
+	31000442: {RegionID: 11000004, SystemID: 31000442, SystemName: "J131204", WormholeClass: 2},
+	31000443: {RegionID: 11000004, SystemID: 31000443, SystemName: "J213737", WormholeClass: 2},
+	31000444: {RegionID: 11000004, SystemID: 31000444, SystemName: "J235117", WormholeClass: 2},
+	31000445: {RegionID: 11000004, SystemID: 31000445, SystemName: "J164938", WormholeClass: 2},
+	31000446: {RegionID: 11000004, SystemID: 31000446, SystemName: "J120310", WormholeClass: 2},
+	31000447: {RegionID: 11000004, SystemID: 31000447, SystemName: "J144838", WormholeClass: 2},
+	31000448: {RegionID: 11000004, SystemID: 31000448, SystemName: "J101307", WormholeClass: 2},
+	31000449: {RegionID: 11000004, SystemID: 31000449, SystemName: "J172354", WormholeClass: 2},
+	31000450: {RegionID: 11000004, SystemID: 31000450, SystemName: "J235447", WormholeClass: 2},
+	31000451: {RegionID: 11000004, SystemID: 31000451, SystemName: "J124023", WormholeClass: 2},
+	31000452: {RegionID: 11000004, SystemID: 31000452, SystemName: "J171424", WormholeClass: 2},
+	31000453: {RegionID: 11000004, SystemID: 31000453, SystemName: "J150921", WormholeClass: 2},
+	31000454: {RegionID: 11000004, SystemID: 31000454, SystemName: "J171518", WormholeClass: 2},
+	31000455: {RegionID: 11000004, SystemID: 31000455, SystemName: "J140012", WormholeClass: 2},
+	31000456: {RegionID: 11000004, SystemID: 31000456, SystemName: "J155616", WormholeClass: 2},
+	31000457: {RegionID: 11000004, SystemID: 31000457, SystemName: "J234557", WormholeClass: 2},
+	31000458: {RegionID: 11000004, SystemID: 31000458, SystemName: "J162700", WormholeClass: 2},
+	31000459: {RegionID: 11000005, SystemID: 31000459, SystemName: "J103716", WormholeClass: 2},
+	31000460: {RegionID: 11000005, SystemID: 31000460, SystemName: "J100640", WormholeClass: 2},
+	31000461: {RegionID: 11000005, SystemID: 31000461, SystemName: "J151250", WormholeClass: 2},
+	31000462: {RegionID: 11000005, SystemID: 31000462, SystemName: "J141332", WormholeClass: 2},
+	31000463: {RegionID: 11000005, SystemID: 31000463, SystemName: "J211915", WormholeClass: 2},
+	31000464: {RegionID: 11000005, SystemID: 31000464, SystemName: "J144546", WormholeClass: 2},
+	31000465: {RegionID: 11000005, SystemID: 31000465, SystemName: "J145759", WormholeClass: 2},
+	31000466: {RegionID: 11000005, SystemID: 31000466, SystemName: "J103422", WormholeClass: 2},
+	31000467: {RegionID: 11000005, SystemID: 31000467, SystemName: "J130222", WormholeClass: 2},
+	31000468: {RegionID: 11000005, SystemID: 31000468, SystemName: "J165357", WormholeClass: 2},
+	31000469: {RegionID: 11000005, SystemID: 31000469, SystemName: "J105321", WormholeClass: 2},
+	31000470: {RegionID: 11000005, SystemID: 31000470, SystemName: "J105803", WormholeClass: 2},
+	31000471: {RegionID: 11000005, SystemID: 31000471, SystemName: "J130209", WormholeClass: 2},
+	31000472: {RegionID: 11000005, SystemID: 31000472, SystemName: "J122832", WormholeClass: 2},
+	31000473: {RegionID: 11000005, SystemID: 31000473, SystemName: "J143359", WormholeClass: 2},
+	31000474: {RegionID: 11000005, SystemID: 31000474, SystemName: "J100447", WormholeClass: 2},
+	31000475: {RegionID: 11000005, SystemID: 31000475, SystemName: "J115651", WormholeClass: 2},
+	31000476: {RegionID: 11000005, SystemID: 31000476, SystemName: "J134323", WormholeClass: 2},
+	31000477: {RegionID: 11000005, SystemID: 31000477, SystemName: "J160014", WormholeClass: 2},
+	31000478: {RegionID: 11000005, SystemID: 31000478, SystemName: "J110823", WormholeClass: 2},
+	31000479: {RegionID: 11000005, SystemID: 31000479, SystemName: "J100246", WormholeClass: 2},
+	31000480: {RegionID: 11000005, SystemID: 31000480, SystemName: "J131221", WormholeClass: 2},
+	31000481: {RegionID: 11000005, SystemID: 31000481, SystemName: "J145155", WormholeClass: 2},
+	31000482: {RegionID: 11000005, SystemID: 31000482, SystemName: "J114405", WormholeClass: 2},
+	31000483: {RegionID: 11000005, SystemID: 31000483, SystemName: "J215537", WormholeClass: 2},
+	31000484: {RegionID: 11000005, SystemID: 31000484, SystemName: "J172551", WormholeClass: 2},
+	31000485: {RegionID: 11000005, SystemID: 31000485, SystemName: "J131107", WormholeClass: 2},
+	31000486: {RegionID: 11000005, SystemID: 31000486, SystemName: "J113108", WormholeClass: 2},
+	31000487: {RegionID: 11000005, SystemID: 31000487, SystemName: "J172556", WormholeClass: 2},
+	31000488: {RegionID: 11000005, SystemID: 31000488, SystemName: "J123450", WormholeClass: 2},
+	31000489: {RegionID: 11000005, SystemID: 31000489, SystemName: "J135809", WormholeClass: 2},
+	31000490: {RegionID: 11000005, SystemID: 31000490, SystemName: "J212319", WormholeClass: 2},
+	31000491: {RegionID: 11000005, SystemID: 31000491, SystemName: "J104210", WormholeClass: 2},
+	31000492: {RegionID: 11000005, SystemID: 31000492, SystemName: "J170038", WormholeClass: 2},
+	31000493: {RegionID: 11000005, SystemID: 31000493, SystemName: "J104115", WormholeClass: 2},
+	31000494: {RegionID: 11000005, SystemID: 31000494, SystemName: "J114758", WormholeClass: 2},
+	31000495: {RegionID: 11000005, SystemID: 31000495, SystemName: "J231517", WormholeClass: 2},
+	31000496: {RegionID: 11000005, SystemID: 31000496, SystemName: "J114055", WormholeClass: 2},
+	31000497: {RegionID: 11000005, SystemID: 31000497, SystemName: "J120619", WormholeClass: 2},
+	31000498: {RegionID: 11000005, SystemID: 31000498, SystemName: "J213058", WormholeClass: 2},
+	31000499: {RegionID: 11000005, SystemID: 31000499, SystemName: "J162430", WormholeClass: 2},
+	31000500: {RegionID: 11000005, SystemID: 31000500, SystemName: "J120928", WormholeClass: 2},
+	31000501: {RegionID: 11000005, SystemID: 31000501, SystemName: "J132918", WormholeClass: 2},
+	31000502: {RegionID: 11000005, SystemID: 31000502, SystemName: "J105203", WormholeClass: 2},
+	31000503: {RegionID: 11000005, SystemID: 31000503, SystemName: "J104328", WormholeClass: 2},
+	31000504: {RegionID: 11000005, SystemID: 31000504, SystemName: "J130854", WormholeClass: 2},
+	31000505: {RegionID: 11000005, SystemID: 31000505, SystemName: "J164130", WormholeClass: 2},
+	31000506: {RegionID: 11000005, SystemID: 31000506, SystemName: "J110316", WormholeClass: 2},
+	31000507: {RegionID: 11000005, SystemID: 31000507, SystemName: "J122331", WormholeClass: 2},
+	31000508: {RegionID: 11000005, SystemID: 31000508, SystemName: "J133419", WormholeClass: 2},
+	31000509: {RegionID: 11000005, SystemID: 31000509, SystemName: "J150137", WormholeClass: 2},
+	31000510: {RegionID: 11000005, SystemID: 31000510, SystemName: "J140112", WormholeClass: 2},
+	31000511: {RegionID: 11000005, SystemID: 31000511, SystemName: "J125721", WormholeClass: 2},
+	31000512: {RegionID: 11000005, SystemID: 31000512, SystemName: "J154515", WormholeClass: 2},
+	31000513: {RegionID: 11000005, SystemID: 31000513, SystemName: "J135703", WormholeClass: 2},
+	31000514: {RegionID: 11000005, SystemID: 31000514, SystemName: "J102347", WormholeClass: 2},
+	31000515: {RegionID: 11000005, SystemID: 31000515, SystemName: "J234942", WormholeClass: 2},
+	31000516: {RegionID: 11000005, SystemID: 31000516, SystemName: "J134618", WormholeClass: 2},
+	31000517: {RegionID: 11000005, SystemID: 31000517, SystemName: "J154858", WormholeClass: 2},
+	31000518: {RegionID: 11000005, SystemID: 31000518, SystemName: "J132052", WormholeClass: 2},
+	31000519: {RegionID: 11000005, SystemID: 31000519, SystemName: "J114046", WormholeClass: 2},
+	31000520: {RegionID: 11000005, SystemID: 31000520, SystemName: "J150859", WormholeClass: 2},
+	31000521: {RegionID: 11000005, SystemID: 31000521, SystemName: "J151615", WormholeClass: 2},
+	31000522: {RegionID: 11000005, SystemID: 31000522, SystemName: "J122246", WormholeClass: 2},
+	31000523: {RegionID: 11000005, SystemID: 31000523, SystemName: "J132559", WormholeClass: 2},
+	31000524: {RegionID: 11000005, SystemID: 31000524, SystemName: "J230207", WormholeClass: 2},
+	31000525: {RegionID: 11000005, SystemID: 31000525, SystemName: "J140308", WormholeClass: 2},
+	31000526: {RegionID: 11000005, SystemID: 31000526, SystemName: "J140642", WormholeClass: 2},
+	31000527: {RegionID: 11000005, SystemID: 31000527, SystemName: "J114306", WormholeClass: 2},
+	31000528: {RegionID: 11000005, SystemID: 31000528, SystemName: "J154606", WormholeClass: 2},
+	31000529: {RegionID: 11000005, SystemID: 31000529, SystemName: "J135910", WormholeClass: 2},
+	31000530: {RegionID: 11000005, SystemID: 31000530, SystemName: "J132024", WormholeClass: 2},
+	31000531: {RegionID: 11000005, SystemID: 31000531, SystemName: "J160311", WormholeClass: 2},
+	31000532: {RegionID: 11000005, SystemID: 31000532, SystemName: "J142239", WormholeClass: 2},
+	31000533: {RegionID: 11000005, SystemID: 31000533, SystemName: "J101149", WormholeClass: 2},
+	31000534: {RegionID: 11000005, SystemID: 31000534, SystemName: "J154538", WormholeClass: 2},
+	31000535: {RegionID: 11000005, SystemID: 31000535, SystemName: "J144426", WormholeClass: 2},
+	31000536: {RegionID: 11000005, SystemID: 31000536, SystemName: "J150341", WormholeClass: 2},
+	31000537: {RegionID: 11000005, SystemID: 31000537, SystemName: "J122124", WormholeClass: 2},
+	31000538: {RegionID: 11000005, SystemID: 31000538, SystemName: "J112614", WormholeClass: 2},
+	31000539: {RegionID: 11000005, SystemID: 31000539, SystemName: "J145316", WormholeClass: 2},
+	31000540: {RegionID: 11000005, SystemID: 31000540, SystemName: "J215736", WormholeClass: 2},
+	31000541: {RegionID: 11000005, SystemID: 31000541, SystemName: "J163138", WormholeClass: 2},
+	31000542: {RegionID: 11000005, SystemID: 31000542, SystemName: "J100033", WormholeClass: 2},
+	31000543: {RegionID: 11000005, SystemID: 31000543, SystemName: "J170552", WormholeClass: 2},
+	31000544: {RegionID: 11000005, SystemID: 31000544, SystemName: "J104820", WormholeClass: 2},
+	31000545: {RegionID: 11000005, SystemID: 31000545, SystemName: "J131240", WormholeClass: 2},
+	31000546: {RegionID: 11000005, SystemID: 31000546, SystemName: "J120442", WormholeClass: 2},
+	31000547: {RegionID: 11000005, SystemID: 31000547, SystemName: "J105000", WormholeClass: 2},
+	31000548: {RegionID: 11000005, SystemID: 31000548, SystemName: "J164507", WormholeClass: 2},
+	31000549: {RegionID: 11000005, SystemID: 31000549, SystemName: "J144956", WormholeClass: 2},
+	31000550: {RegionID: 11000005, SystemID: 31000550, SystemName: "J114712", WormholeClass: 2},
+	31000551: {RegionID: 11000005, SystemID: 31000551, SystemName: "J211908", WormholeClass: 2},
+	31000552: {RegionID: 11000005, SystemID: 31000552, SystemName: "J150700", WormholeClass: 2},
+	31000553: {RegionID: 11000005, SystemID: 31000553, SystemName: "J164931", WormholeClass: 2},
+	31000554: {RegionID: 11000005, SystemID: 31000554, SystemName: "J121412", WormholeClass: 2},
+	31000555: {RegionID: 11000005, SystemID: 31000555, SystemName: "J145211", WormholeClass: 2},
+	31000556: {RegionID: 11000005, SystemID: 31000556, SystemName: "J174449", WormholeClass: 2},
+	31000557: {RegionID: 11000005, SystemID: 31000557, SystemName: "J133015", WormholeClass: 2},
+	31000558: {RegionID: 11000005, SystemID: 31000558, SystemName: "J134534", WormholeClass: 2},
+	31000559: {RegionID: 11000005, SystemID: 31000559, SystemName: "J153229", WormholeClass: 2},
+	31000560: {RegionID: 11000005, SystemID: 31000560, SystemName: "J133557", WormholeClass: 2},
+	31000561: {RegionID: 11000006, SystemID: 31000561, SystemName: "J150807", WormholeClass: 2},
+	31000562: {RegionID: 11000006, SystemID: 31000562, SystemName: "J110413", WormholeClass: 2},
+	31000563: {RegionID: 11000006, SystemID: 31000563, SystemName: "J105352", WormholeClass: 2},
+	31000564: {RegionID: 11000006, SystemID: 31000564, SystemName: "J161246", WormholeClass: 2},
+	31000565: {RegionID: 11000006, SystemID: 31000565, SystemName: "J125927", WormholeClass: 2},
+	31000566: {RegionID: 11000006, SystemID: 31000566, SystemName: "J130650", WormholeClass: 2},
+	31000567: {RegionID: 11000006, SystemID: 31000567, SystemName: "J142826", WormholeClass: 2},
+	31000568: {RegionID: 11000006, SystemID: 31000568, SystemName: "J152737", WormholeClass: 2},
+	31000569: {RegionID: 11000006, SystemID: 31000569, SystemName: "J225316", WormholeClass: 2},
+	31000570: {RegionID: 11000006, SystemID: 31000570, SystemName: "J163146", WormholeClass: 2},
+	31000571: {RegionID: 11000006, SystemID: 31000571, SystemName: "J134107", WormholeClass: 2},
+	31000572: {RegionID: 11000006, SystemID: 31000572, SystemName: "J104850", WormholeClass: 2},
+	31000573: {RegionID: 11000006, SystemID: 31000573, SystemName: "J131124", WormholeClass: 2},
+	31000574: {RegionID: 11000006, SystemID: 31000574, SystemName: "J123435", WormholeClass: 2},
+	31000575: {RegionID: 11000006, SystemID: 31000575, SystemName: "J150853", WormholeClass: 2},
+	31000576: {RegionID: 11000006, SystemID: 31000576, SystemName: "J170558", WormholeClass: 2},
+	31000577: {RegionID: 11000006, SystemID: 31000577, SystemName: "J214238", WormholeClass: 2},
+	31000578: {RegionID: 11000006, SystemID: 31000578, SystemName: "J110538", WormholeClass: 2},
+	31000579: {RegionID: 11000006, SystemID: 31000579, SystemName: "J143336", WormholeClass: 2},
+	31000580: {RegionID: 11000006, SystemID: 31000580, SystemName: "J232044", WormholeClass: 2},
+	31000581: {RegionID: 11000006, SystemID: 31000581, SystemName: "J145225", WormholeClass: 2},
+	31000582: {RegionID: 11000006, SystemID: 31000582, SystemName: "J231210", WormholeClass: 2},
+	31000583: {RegionID: 11000006, SystemID: 31000583, SystemName: "J223853", WormholeClass: 2},
+	31000584: {RegionID: 11000006, SystemID: 31000584, SystemName: "J234542", WormholeClass: 2},
+	31000585: {RegionID: 11000006, SystemID: 31000585, SystemName: "J115911", WormholeClass: 2},
+	31000586: {RegionID: 11000006, SystemID: 31000586, SystemName: "J135155", WormholeClass: 2},
+	31000587: {RegionID: 11000006, SystemID: 31000587, SystemName: "J152825", WormholeClass: 2},
+	31000588: {RegionID: 11000006, SystemID: 31000588, SystemName: "J115334", WormholeClass: 2},
+	31000589: {RegionID: 11000006, SystemID: 31000589, SystemName: "J114749", WormholeClass: 2},
+	31000590: {RegionID: 11000006, SystemID: 31000590, SystemName: "J134730", WormholeClass: 2},
+	31000591: {RegionID: 11000006, SystemID: 31000591, SystemName: "J105544", WormholeClass: 2},
+	31000592: {RegionID: 11000006, SystemID: 31000592, SystemName: "J233129", WormholeClass: 2},
+	31000593: {RegionID: 11000006, SystemID: 31000593, SystemName: "J114528", WormholeClass: 2},
+	31000594: {RegionID: 11000006, SystemID: 31000594, SystemName: "J103351", WormholeClass: 2},
+	31000595: {RegionID: 11000006, SystemID: 31000595, SystemName: "J152322", WormholeClass: 2},
+	31000596: {RegionID: 11000006, SystemID: 31000596, SystemName: "J132617", WormholeClass: 2},
+	31000597: {RegionID: 11000006, SystemID: 31000597, SystemName: "J104517", WormholeClass: 2},
+	31000598: {RegionID: 11000006, SystemID: 31000598, SystemName: "J132401", WormholeClass: 2},
+	31000599: {RegionID: 11000006, SystemID: 31000599, SystemName: "J142918", WormholeClass: 2},
+	31000600: {RegionID: 11000006, SystemID: 31000600, SystemName: "J163902", WormholeClass: 2},
+	31000601: {RegionID: 11000006, SystemID: 31000601, SystemName: "J214843", WormholeClass: 2},
+	31000602: {RegionID: 11000006, SystemID: 31000602, SystemName: "J173322", WormholeClass: 2},
+	31000603: {RegionID: 11000006, SystemID: 31000603, SystemName: "J120252", WormholeClass: 2},
+	31000604: {RegionID: 11000006, SystemID: 31000604, SystemName: "J232305", WormholeClass: 2},
+	31000605: {RegionID: 11000006, SystemID: 31000605, SystemName: "J123907", WormholeClass: 2},
+	31000606: {RegionID: 11000006, SystemID: 31000606, SystemName: "J144057", WormholeClass: 2},
+	31000607: {RegionID: 11000006, SystemID: 31000607, SystemName: "J223320", WormholeClass: 2},
+	31000608: {RegionID: 11000006, SystemID: 31000608, SystemName: "J153247", WormholeClass: 2},
+	31000609: {RegionID: 11000006, SystemID: 31000609, SystemName: "J204623", WormholeClass: 2},
+	31000610: {RegionID: 11000006, SystemID: 31000610, SystemName: "J223348", WormholeClass: 2},
+	31000611: {RegionID: 11000006, SystemID: 31000611, SystemName: "J115734", WormholeClass: 2},
+	31000612: {RegionID: 11000006, SystemID: 31000612, SystemName: "J154735", WormholeClass: 2},
+	31000613: {RegionID: 11000006, SystemID: 31000613, SystemName: "J115314", WormholeClass: 2},
+	31000614: {RegionID: 11000006, SystemID: 31000614, SystemName: "J164816", WormholeClass: 2},
+	31000615: {RegionID: 11000006, SystemID: 31000615, SystemName: "J132712", WormholeClass: 2},
+	31000616: {RegionID: 11000006, SystemID: 31000616, SystemName: "J124530", WormholeClass: 2},
+	31000617: {RegionID: 11000006, SystemID: 31000617, SystemName: "J134949", WormholeClass: 2},
+	31000618: {RegionID: 11000006, SystemID: 31000618, SystemName: "J132458", WormholeClass: 2},
+	31000619: {RegionID: 11000006, SystemID: 31000619, SystemName: "J160927", WormholeClass: 2},
+	31000620: {RegionID: 11000006, SystemID: 31000620, SystemName: "J150836", WormholeClass: 2},
+	31000621: {RegionID: 11000006, SystemID: 31000621, SystemName: "J102222", WormholeClass: 2},
+	31000622: {RegionID: 11000006, SystemID: 31000622, SystemName: "J103144", WormholeClass: 2},
+	31000623: {RegionID: 11000006, SystemID: 31000623, SystemName: "J135629", WormholeClass: 2},
+	31000624: {RegionID: 11000006, SystemID: 31000624, SystemName: "J115530", WormholeClass: 2},
+	31000625: {RegionID: 11000006, SystemID: 31000625, SystemName: "J133111", WormholeClass: 2},
+	31000626: {RegionID: 11000006, SystemID: 31000626, SystemName: "J150904", WormholeClass: 2},
+	31000627: {RegionID: 11000006, SystemID: 31000627, SystemName: "J111846", WormholeClass: 2},
+	31000628: {RegionID: 11000006, SystemID: 31000628, SystemName: "J152950", WormholeClass: 2},
+	31000629: {RegionID: 11000006, SystemID: 31000629, SystemName: "J120704", WormholeClass: 2},
+	31000630: {RegionID: 11000006, SystemID: 31000630, SystemName: "J104718", WormholeClass: 2},
+	31000631: {RegionID: 11000006, SystemID: 31000631, SystemName: "J131808", WormholeClass: 2},
+	31000632: {RegionID: 11000006, SystemID: 31000632, SystemName: "J231644", WormholeClass: 2},
+	31000633: {RegionID: 11000006, SystemID: 31000633, SystemName: "J232147", WormholeClass: 2},
+	31000634: {RegionID: 11000006, SystemID: 31000634, SystemName: "J132048", WormholeClass: 2},
+	31000635: {RegionID: 11000006, SystemID: 31000635, SystemName: "J172350", WormholeClass: 2},
+	31000636: {RegionID: 11000006, SystemID: 31000636, SystemName: "J145619", WormholeClass: 2},
+	31000637: {RegionID: 11000006, SystemID: 31000637, SystemName: "J163446", WormholeClass: 2},
+	31000638: {RegionID: 11000006, SystemID: 31000638, SystemName: "J140816", WormholeClass: 2},
+	31000639: {RegionID: 11000006, SystemID: 31000639, SystemName: "J141015", WormholeClass: 2},
+	31000640: {RegionID: 11000006, SystemID: 31000640, SystemName: "J101346", WormholeClass: 2},
+	31000641: {RegionID: 11000006, SystemID: 31000641, SystemName: "J212238", WormholeClass: 2},
+	31000642: {RegionID: 11000006, SystemID: 31000642, SystemName: "J235852", WormholeClass: 2},
+	31000643: {RegionID: 11000006, SystemID: 31000643, SystemName: "J134349", WormholeClass: 2},
+	31000644: {RegionID: 11000006, SystemID: 31000644, SystemName: "J204221", WormholeClass: 2},
+	31000645: {RegionID: 11000006, SystemID: 31000645, SystemName: "J110910", WormholeClass: 2},
+	31000646: {RegionID: 11000006, SystemID: 31000646, SystemName: "J131753", WormholeClass: 2},
+	31000647: {RegionID: 11000006, SystemID: 31000647, SystemName: "J113221", WormholeClass: 2},
+	31000648: {RegionID: 11000006, SystemID: 31000648, SystemName: "J152941", WormholeClass: 2},
+	31000649: {RegionID: 11000006, SystemID: 31000649, SystemName: "J144727", WormholeClass: 2},
+	31000650: {RegionID: 11000006, SystemID: 31000650, SystemName: "J143320", WormholeClass: 2},
+	31000651: {RegionID: 11000006, SystemID: 31000651, SystemName: "J155551", WormholeClass: 2},
+	31000652: {RegionID: 11000006, SystemID: 31000652, SystemName: "J162516", WormholeClass: 2},
+	31000653: {RegionID: 11000006, SystemID: 31000653, SystemName: "J135827", WormholeClass: 2},
+	31000654: {RegionID: 11000006, SystemID: 31000654, SystemName: "J131706", WormholeClass: 2},
+	31000655: {RegionID: 11000006, SystemID: 31000655, SystemName: "J214854", WormholeClass: 2},
+	31000656: {RegionID: 11000006, SystemID: 31000656, SystemName: "J150109", WormholeClass: 2},
+	31000657: {RegionID: 11000006, SystemID: 31000657, SystemName: "J103512", WormholeClass: 2},
+	31000658: {RegionID: 11000006, SystemID: 31000658, SystemName: "J121658", WormholeClass: 2},
+	31000659: {RegionID: 11000006, SystemID: 31000659, SystemName: "J143706", WormholeClass: 2},
+	31000660: {RegionID: 11000006, SystemID: 31000660, SystemName: "J235924", WormholeClass: 2},
+	31000661: {RegionID: 11000006, SystemID: 31000661, SystemName: "J103854", WormholeClass: 2},
+	31000662: {RegionID: 11000006, SystemID: 31000662, SystemName: "J214725", WormholeClass: 2},
+	31000663: {RegionID: 11000006, SystemID: 31000663, SystemName: "J101415", WormholeClass: 2},
+	31000664: {RegionID: 11000006, SystemID: 31000664, SystemName: "J122837", WormholeClass: 2},
+	31000665: {RegionID: 11000006, SystemID: 31000665, SystemName: "J125727", WormholeClass: 2},
+	31000666: {RegionID: 11000006, SystemID: 31000666, SystemName: "J142847", WormholeClass: 2},
+	31000667: {RegionID: 11000006, SystemID: 31000667, SystemName: "J155035", WormholeClass: 2},
+	31000668: {RegionID: 11000006, SystemID: 31000668, SystemName: "J102504", WormholeClass: 2},
+	31000669: {RegionID: 11000006, SystemID: 31000669, SystemName: "J160305", WormholeClass: 2},
+	31000670: {RegionID: 11000006, SystemID: 31000670, SystemName: "J151503", WormholeClass: 2},
+	31000671: {RegionID: 11000006, SystemID: 31000671, SystemName: "J142838", WormholeClass: 2},
+	31000672: {RegionID: 11000006, SystemID: 31000672, SystemName: "J131034", WormholeClass: 2},
+	31000673: {RegionID: 11000006, SystemID: 31000673, SystemName: "J214901", WormholeClass: 2},
+	31000674: {RegionID: 11000006, SystemID: 31000674, SystemName: "J113323", WormholeClass: 2},
+	31000675: {RegionID: 11000006, SystemID: 31000675, SystemName: "J112956", WormholeClass: 2},
+	31000676: {RegionID: 11000006, SystemID: 31000676, SystemName: "J104802", WormholeClass: 2},
+	31000677: {RegionID: 11000006, SystemID: 31000677, SystemName: "J100808", WormholeClass: 2},
+	31000678: {RegionID: 11000006, SystemID: 31000678, SystemName: "J100509", WormholeClass: 2},
+	31000679: {RegionID: 11000006, SystemID: 31000679, SystemName: "J142055", WormholeClass: 2},
+	31000680: {RegionID: 11000006, SystemID: 31000680, SystemName: "J135141", WormholeClass: 2},
+	31000681: {RegionID: 11000006, SystemID: 31000681, SystemName: "J151902", WormholeClass: 2},
+	31000682: {RegionID: 11000006, SystemID: 31000682, SystemName: "J235759", WormholeClass: 2},
+	31000683: {RegionID: 11000006, SystemID: 31000683, SystemName: "J164613", WormholeClass: 2},
+	31000684: {RegionID: 11000006, SystemID: 31000684, SystemName: "J225805", WormholeClass: 2},
+	31000685: {RegionID: 11000006, SystemID: 31000685, SystemName: "J112916", WormholeClass: 2},
+	31000686: {RegionID: 11000006, SystemID: 31000686, SystemName: "J115347", WormholeClass: 2},
+	31000687: {RegionID: 11000006, SystemID: 31000687, SystemName: "J224217", WormholeClass: 2},
+	31000688: {RegionID: 11000006, SystemID: 31000688, SystemName: "J110555", WormholeClass: 2},
+	31000689: {RegionID: 11000006, SystemID: 31000689, SystemName: "J143455", WormholeClass: 2},
+	31000690: {RegionID: 11000006, SystemID: 31000690, SystemName: "J111141", WormholeClass: 2},
+	31000691: {RegionID: 11000006, SystemID: 31000691, SystemName: "J104606", WormholeClass: 2},
+	31000692: {RegionID: 11000006, SystemID: 31000692, SystemName: "J222830", WormholeClass: 2},
+	31000693: {RegionID: 11000006, SystemID: 31000693, SystemName: "J123249", WormholeClass: 2},
+	31000694: {RegionID: 11000006, SystemID: 31000694, SystemName: "J115310", WormholeClass: 2},
+	31000695: {RegionID: 11000006, SystemID: 31000695, SystemName: "J143702", WormholeClass: 2},
+	31000696: {RegionID: 11000006, SystemID: 31000696, SystemName: "J223538", WormholeClass: 2},
+	31000697: {RegionID: 11000006, SystemID: 31000697, SystemName: "J131618", WormholeClass: 2},
+	31000698: {RegionID: 11000006, SystemID: 31000698, SystemName: "J215944", WormholeClass: 2},
+	31000699: {RegionID: 11000006, SystemID: 31000699, SystemName: "J121952", WormholeClass: 2},
+	31000700: {RegionID: 11000006, SystemID: 31000700, SystemName: "J121928", WormholeClass: 2},
+	31000701: {RegionID: 11000006, SystemID: 31000701, SystemName: "J105439", WormholeClass: 2},
+	31000702: {RegionID: 11000007, SystemID: 31000702, SystemName: "J150627", WormholeClass: 2},
+	31000703: {RegionID: 11000007, SystemID: 31000703, SystemName: "J121745", WormholeClass: 2},
+	31000704: {RegionID: 11000007, SystemID: 31000704, SystemName: "J141438", WormholeClass: 2},
+	31000705: {RegionID: 11000007, SystemID: 31000705, SystemName: "J214929", WormholeClass: 2},
+	31000706: {RegionID: 11000007, SystemID: 31000706, SystemName: "J130709", WormholeClass: 2},
+	31000707: {RegionID: 11000007, SystemID: 31000707, SystemName: "J103924", WormholeClass: 2},
+	31000708: {RegionID: 11000007, SystemID: 31000708, SystemName: "J211000", WormholeClass: 2},
+	31000709: {RegionID: 11000007, SystemID: 31000709, SystemName: "J123555", WormholeClass: 2},
+	31000710: {RegionID: 11000007, SystemID: 31000710, SystemName: "J151125", WormholeClass: 2},
+	31000711: {RegionID: 11000007, SystemID: 31000711, SystemName: "J123412", WormholeClass: 2},
+	31000712: {RegionID: 11000007, SystemID: 31000712, SystemName: "J112309", WormholeClass: 2},
+	31000713: {RegionID: 11000007, SystemID: 31000713, SystemName: "J123850", WormholeClass: 2},
+	31000714: {RegionID: 11000007, SystemID: 31000714, SystemName: "J163923", WormholeClass: 2},
+	31000715: {RegionID: 11000007, SystemID: 31000715, SystemName: "J143546", WormholeClass: 2},
+	31000716: {RegionID: 11000007, SystemID: 31000716, SystemName: "J130832", WormholeClass: 2},
+	31000717: {RegionID: 11000007, SystemID: 31000717, SystemName: "J221337", WormholeClass: 2},
+	31000718: {RegionID: 11000007, SystemID: 31000718, SystemName: "J113449", WormholeClass: 2},
+	31000719: {RegionID: 11000007, SystemID: 31000719, SystemName: "J212607", WormholeClass: 2},
+	31000720: {RegionID: 11000007, SystemID: 31000720, SystemName: "J165157", WormholeClass: 2},
+	31000721: {RegionID: 11000007, SystemID: 31000721, SystemName: "J142937", WormholeClass: 2},
+	31000722: {RegionID: 11000007, SystemID: 31000722, SystemName: "J120455", WormholeClass: 2},
+	31000723: {RegionID: 11000007, SystemID: 31000723, SystemName: "J122638", WormholeClass: 2},
+	31000724: {RegionID: 11000007, SystemID: 31000724, SystemName: "J161411", WormholeClass: 2},
+	31000725: {RegionID: 11000007, SystemID: 31000725, SystemName: "J124028", WormholeClass: 2},
+	31000726: {RegionID: 11000007, SystemID: 31000726, SystemName: "J134735", WormholeClass: 2},
+	31000727: {RegionID: 11000007, SystemID: 31000727, SystemName: "J142845", WormholeClass: 2},
+	31000728: {RegionID: 11000007, SystemID: 31000728, SystemName: "J140750", WormholeClass: 2},
+	31000729: {RegionID: 11000007, SystemID: 31000729, SystemName: "J104515", WormholeClass: 2},
+	31000730: {RegionID: 11000007, SystemID: 31000730, SystemName: "J113039", WormholeClass: 2},
+	31000731: {RegionID: 11000007, SystemID: 31000731, SystemName: "J111159", WormholeClass: 2},
+	31000732: {RegionID: 11000007, SystemID: 31000732, SystemName: "J105342", WormholeClass: 2},
+	31000733: {RegionID: 11000007, SystemID: 31000733, SystemName: "J121728", WormholeClass: 2},
+	31000734: {RegionID: 11000007, SystemID: 31000734, SystemName: "J115700", WormholeClass: 2},
+	31000735: {RegionID: 11000007, SystemID: 31000735, SystemName: "J143234", WormholeClass: 2},
+	31000736: {RegionID: 11000007, SystemID: 31000736, SystemName: "J122524", WormholeClass: 2},
+	31000737: {RegionID: 11000007, SystemID: 31000737, SystemName: "J124007", WormholeClass: 2},
+	31000738: {RegionID: 11000007, SystemID: 31000738, SystemName: "J150533", WormholeClass: 2},
+	31000739: {RegionID: 11000007, SystemID: 31000739, SystemName: "J143933", WormholeClass: 2},
+	31000740: {RegionID: 11000007, SystemID: 31000740, SystemName: "J225128", WormholeClass: 2},
+	31000741: {RegionID: 11000007, SystemID: 31000741, SystemName: "J133632", WormholeClass: 2},
+	31000742: {RegionID: 11000007, SystemID: 31000742, SystemName: "J131744", WormholeClass: 2},
+	31000743: {RegionID: 11000007, SystemID: 31000743, SystemName: "J125923", WormholeClass: 2},
+	31000744: {RegionID: 11000007, SystemID: 31000744, SystemName: "J161138", WormholeClass: 2},
+	31000745: {RegionID: 11000007, SystemID: 31000745, SystemName: "J125824", WormholeClass: 2},
+	31000746: {RegionID: 11000007, SystemID: 31000746, SystemName: "J223511", WormholeClass: 2},
+	31000747: {RegionID: 11000007, SystemID: 31000747, SystemName: "J134401", WormholeClass: 2},
+	31000748: {RegionID: 11000007, SystemID: 31000748, SystemName: "J214739", WormholeClass: 2},
+	31000749: {RegionID: 11000007, SystemID: 31000749, SystemName: "J104321", WormholeClass: 2},
+	31000750: {RegionID: 11000007, SystemID: 31000750, SystemName: "J115327", WormholeClass: 2},
+	31000751: {RegionID: 11000007, SystemID: 31000751, SystemName: "J154937", WormholeClass: 2},
+	31000752: {RegionID: 11000008, SystemID: 31000752, SystemName: "J112505", WormholeClass: 2},
+	31000753: {RegionID: 11000008, SystemID: 31000753, SystemName: "J102739", WormholeClass: 2},
+	31000754: {RegionID: 11000008, SystemID: 31000754, SystemName: "J171309", WormholeClass: 2},
+	31000755: {RegionID: 11000008, SystemID: 31000755, SystemName: "J133417", WormholeClass: 2},
+	31000756: {RegionID: 11000008, SystemID: 31000756, SystemName: "J124749", WormholeClass: 2},
+	31000757: {RegionID: 11000008, SystemID: 31000757, SystemName: "J122137", WormholeClass: 2},
+	31000758: {RegionID: 11000008, SystemID: 31000758, SystemName: "J140353", WormholeClass: 2},
+	31000759: {RegionID: 11000008, SystemID: 31000759, SystemName: "J104029", WormholeClass: 2},
+	31000760: {RegionID: 11000008, SystemID: 31000760, SystemName: "J151348", WormholeClass: 2},
+	31000761: {RegionID: 11000008, SystemID: 31000761, SystemName: "J163522", WormholeClass: 2},
+	31000762: {RegionID: 11000008, SystemID: 31000762, SystemName: "J131315", WormholeClass: 2},
+	31000763: {RegionID: 11000008, SystemID: 31000763, SystemName: "J114008", WormholeClass: 2},
+	31000764: {RegionID: 11000008, SystemID: 31000764, SystemName: "J233839", WormholeClass: 2},
+	31000765: {RegionID: 11000008, SystemID: 31000765, SystemName: "J112558", WormholeClass: 2},
+	31000766: {RegionID: 11000008, SystemID: 31000766, SystemName: "J114308", WormholeClass: 2},
+	31000767: {RegionID: 11000008, SystemID: 31000767, SystemName: "J130125", WormholeClass: 2},
+	31000768: {RegionID: 11000008, SystemID: 31000768, SystemName: "J173213", WormholeClass: 2},
+	31000769: {RegionID: 11000008, SystemID: 31000769, SystemName: "J160126", WormholeClass: 2},
+	31000770: {RegionID: 11000008, SystemID: 31000770, SystemName: "J154813", WormholeClass: 2},
+	31000771: {RegionID: 11000008, SystemID: 31000771, SystemName: "J215151", WormholeClass: 2},
+	31000772: {RegionID: 11000008, SystemID: 31000772, SystemName: "J144739", WormholeClass: 2},
+	31000773: {RegionID: 11000008, SystemID: 31000773, SystemName: "J102602", WormholeClass: 2},
+	31000774: {RegionID: 11000008, SystemID: 31000774, SystemName: "J120924", WormholeClass: 2},
+	31000775: {RegionID: 11000008, SystemID: 31000775, SystemName: "J212906", WormholeClass: 2},
+	31000776: {RegionID: 11000008, SystemID: 31000776, SystemName: "J101719", WormholeClass: 2},
+	31000777: {RegionID: 11000008, SystemID: 31000777, SystemName: "J101824", WormholeClass: 2},
+	31000778: {RegionID: 11000008, SystemID: 31000778, SystemName: "J153003", WormholeClass: 2},
+	31000779: {RegionID: 11000008, SystemID: 31000779, SystemName: "J105123", WormholeClass: 2},
+	31000780: {RegionID: 11000008, SystemID: 31000780, SystemName: "J122635", WormholeClass: 2},
+	31000781: {RegionID: 11000008, SystemID: 31000781, SystemName: "J152624", WormholeClass: 2},
+	31000782: {RegionID: 11000008, SystemID: 31000782, SystemName: "J224145", WormholeClass: 2},
+	31000783: {RegionID: 11000008, SystemID: 31000783, SystemName: "J163318", WormholeClass: 2},
+	31000784: {RegionID: 11000008, SystemID: 31000784, SystemName: "J144004", WormholeClass: 2},
+	31000785: {RegionID: 11000008, SystemID: 31000785, SystemName: "J122931", WormholeClass: 2},
+	31000786: {RegionID: 11000008, SystemID: 31000786, SystemName: "J173245", WormholeClass: 2},
+	31000787: {RegionID: 11000008, SystemID: 31000787, SystemName: "J145246", WormholeClass: 2},
+	31000788: {RegionID: 11000008, SystemID: 31000788, SystemName: "J124526", WormholeClass: 2},
+	31000789: {RegionID: 11000008, SystemID: 31000789, SystemName: "J165648", WormholeClass: 2},
+	31000790: {RegionID: 11000008, SystemID: 31000790, SystemName: "J134405", WormholeClass: 2},
+	31000791: {RegionID: 11000008, SystemID: 31000791, SystemName: "J213111", WormholeClass: 2},
+	31000792: {RegionID: 11000008, SystemID: 31000792, SystemName: "J135422", WormholeClass: 2},
+	31000793: {RegionID: 11000008, SystemID: 31000793, SystemName: "J153030", WormholeClass: 2},
+	31000794: {RegionID: 11000008, SystemID: 31000794, SystemName: "J120634", WormholeClass: 2},
+	31000795: {RegionID: 11000008, SystemID: 31000795, SystemName: "J102209", WormholeClass: 2},
+	31000796: {RegionID: 11000008, SystemID: 31000796, SystemName: "J123540", WormholeClass: 2},
+	31000797: {RegionID: 11000008, SystemID: 31000797, SystemName: "J112715", WormholeClass: 2},
+	31000798: {RegionID: 11000008, SystemID: 31000798, SystemName: "J162159", WormholeClass: 2},
+	31000799: {RegionID: 11000008, SystemID: 31000799, SystemName: "J122118", WormholeClass: 2},
+	31000800: {RegionID: 11000008, SystemID: 31000800, SystemName: "J105244", WormholeClass: 2},
+	31000801: {RegionID: 11000008, SystemID: 31000801, SystemName: "J123958", WormholeClass: 2},
+	31000802: {RegionID: 11000008, SystemID: 31000802, SystemName: "J223552", WormholeClass: 2},
+	31000803: {RegionID: 11000008, SystemID: 31000803, SystemName: "J232359", WormholeClass: 2},
+	31000804: {RegionID: 11000008, SystemID: 31000804, SystemName: "J171539", WormholeClass: 2},
+	31000805: {RegionID: 11000008, SystemID: 31000805, SystemName: "J100102", WormholeClass: 2},
+	31000806: {RegionID: 11000008, SystemID: 31000806, SystemName: "J154249", WormholeClass: 2},
+	31000807: {RegionID: 11000008, SystemID: 31000807, SystemName: "J105311", WormholeClass: 2},
+	31000808: {RegionID: 11000008, SystemID: 31000808, SystemName: "J210536", WormholeClass: 2},
+	31000809: {RegionID: 11000008, SystemID: 31000809, SystemName: "J151248", WormholeClass: 2},
+	31000810: {RegionID: 11000008, SystemID: 31000810, SystemName: "J131852", WormholeClass: 2},
+	31000811: {RegionID: 11000008, SystemID: 31000811, SystemName: "J141611", WormholeClass: 2},
+	31000812: {RegionID: 11000008, SystemID: 31000812, SystemName: "J113723", WormholeClass: 2},
+	31000813: {RegionID: 11000008, SystemID: 31000813, SystemName: "J121323", WormholeClass: 2},
+	31000814: {RegionID: 11000008, SystemID: 31000814, SystemName: "J144422", WormholeClass: 2},
+	31000815: {RegionID: 11000008, SystemID: 31000815, SystemName: "J140722", WormholeClass: 2},
+	31000816: {RegionID: 11000008, SystemID: 31000816, SystemName: "J141637", WormholeClass: 2},
+	31000817: {RegionID: 11000008, SystemID: 31000817, SystemName: "J112617", WormholeClass: 2},
+	31000818: {RegionID: 11000008, SystemID: 31000818, SystemName: "J150754", WormholeClass: 2},
+	31000819: {RegionID: 11000008, SystemID: 31000819, SystemName: "J102946", WormholeClass: 2},
+	31000820: {RegionID: 11000008, SystemID: 31000820, SystemName: "J115234", WormholeClass: 2},
+	31000821: {RegionID: 11000008, SystemID: 31000821, SystemName: "J145145", WormholeClass: 2},
+	31000822: {RegionID: 11000008, SystemID: 31000822, SystemName: "J145426", WormholeClass: 2},
+	31000823: {RegionID: 11000008, SystemID: 31000823, SystemName: "J122732", WormholeClass: 2},
+	31000824: {RegionID: 11000008, SystemID: 31000824, SystemName: "J144203", WormholeClass: 2},
+	31000825: {RegionID: 11000008, SystemID: 31000825, SystemName: "J140843", WormholeClass: 2},
+	31000826: {RegionID: 11000008, SystemID: 31000826, SystemName: "J155631", WormholeClass: 2},
+	31000827: {RegionID: 11000008, SystemID: 31000827, SystemName: "J235525", WormholeClass: 2},
+	31000828: {RegionID: 11000008, SystemID: 31000828, SystemName: "J121749", WormholeClass: 2},
+	31000829: {RegionID: 11000008, SystemID: 31000829, SystemName: "J162518", WormholeClass: 2},
+	31000830: {RegionID: 11000008, SystemID: 31000830, SystemName: "J132012", WormholeClass: 2},
+	31000831: {RegionID: 11000008, SystemID: 31000831, SystemName: "J102038", WormholeClass: 2},
+	31000832: {RegionID: 11000008, SystemID: 31000832, SystemName: "J150234", WormholeClass: 2},
+	31000833: {RegionID: 11000008, SystemID: 31000833, SystemName: "J112520", WormholeClass: 2},
+	31000834: {RegionID: 11000008, SystemID: 31000834, SystemName: "J165412", WormholeClass: 2},
+	31000835: {RegionID: 11000008, SystemID: 31000835, SystemName: "J151102", WormholeClass: 2},
+	31000836: {RegionID: 11000008, SystemID: 31000836, SystemName: "J133049", WormholeClass: 2},
+	31000837: {RegionID: 11000008, SystemID: 31000837, SystemName: "J161628", WormholeClass: 2},
+	31000838: {RegionID: 11000008, SystemID: 31000838, SystemName: "J134306", WormholeClass: 2},
+	31000839: {RegionID: 11000008, SystemID: 31000839, SystemName: "J131842", WormholeClass: 2},
+	31000840: {RegionID: 11000008, SystemID: 31000840, SystemName: "J232329", WormholeClass: 2},
+	31000841: {RegionID: 11000008, SystemID: 31000841, SystemName: "J164921", WormholeClass: 2},
+	31000842: {RegionID: 11000008, SystemID: 31000842, SystemName: "J203814", WormholeClass: 2},
+	31000843: {RegionID: 11000008, SystemID: 31000843, SystemName: "J151311", WormholeClass: 2},
+	31000844: {RegionID: 11000008, SystemID: 31000844, SystemName: "J101650", WormholeClass: 2},
+	31000845: {RegionID: 11000008, SystemID: 31000845, SystemName: "J165006", WormholeClass: 2},
+	31000846: {RegionID: 11000008, SystemID: 31000846, SystemName: "J103533", WormholeClass: 2},
+	31000847: {RegionID: 11000008, SystemID: 31000847, SystemName: "J101441", WormholeClass: 2},
+	31000848: {RegionID: 11000008, SystemID: 31000848, SystemName: "J124253", WormholeClass: 2},
+	31000849: {RegionID: 11000008, SystemID: 31000849, SystemName: "J232200", WormholeClass: 2},
+	31000850: {RegionID: 11000008, SystemID: 31000850, SystemName: "J144326", WormholeClass: 2},
+	31000851: {RegionID: 11000008, SystemID: 31000851, SystemName: "J124449", WormholeClass: 2},
+	31000852: {RegionID: 11000008, SystemID: 31000852, SystemName: "J100642", WormholeClass: 2},
+	31000853: {RegionID: 11000008, SystemID: 31000853, SystemName: "J133150", WormholeClass: 2},
+	31000854: {RegionID: 11000008, SystemID: 31000854, SystemName: "J164126", WormholeClass: 2},
+	31000855: {RegionID: 11000008, SystemID: 31000855, SystemName: "J171142", WormholeClass: 2},
+	31000856: {RegionID: 11000008, SystemID: 31000856, SystemName: "J145634", WormholeClass: 2},
+	31000857: {RegionID: 11000008, SystemID: 31000857, SystemName: "J151431", WormholeClass: 2},
+	31000858: {RegionID: 11000008, SystemID: 31000858, SystemName: "J233555", WormholeClass: 2},
+	31000859: {RegionID: 11000008, SystemID: 31000859, SystemName: "J100651", WormholeClass: 2},
+	31000860: {RegionID: 11000008, SystemID: 31000860, SystemName: "J132103", WormholeClass: 2},
+	31000861: {RegionID: 11000008, SystemID: 31000861, SystemName: "J121720", WormholeClass: 2},
+	31000862: {RegionID: 11000008, SystemID: 31000862, SystemName: "J210355", WormholeClass: 2},
+	31000863: {RegionID: 11000008, SystemID: 31000863, SystemName: "J125029", WormholeClass: 2},
+	31000864: {RegionID: 11000008, SystemID: 31000864, SystemName: "J103619", WormholeClass: 2},
+	31000865: {RegionID: 11000008, SystemID: 31000865, SystemName: "J165014", WormholeClass: 2},
+	31000866: {RegionID: 11000008, SystemID: 31000866, SystemName: "J124611", WormholeClass: 2},
+	31000867: {RegionID: 11000008, SystemID: 31000867, SystemName: "J150418", WormholeClass: 2},
+	31000868: {RegionID: 11000008, SystemID: 31000868, SystemName: "J145440", WormholeClass: 2},
+	31000869: {RegionID: 11000008, SystemID: 31000869, SystemName: "J113223", WormholeClass: 2},
+	31000870: {RegionID: 11000008, SystemID: 31000870, SystemName: "J234915", WormholeClass: 2},
+	31000871: {RegionID: 11000008, SystemID: 31000871, SystemName: "J162042", WormholeClass: 2},
+	31000872: {RegionID: 11000008, SystemID: 31000872, SystemName: "J112019", WormholeClass: 2},
+	31000873: {RegionID: 11000008, SystemID: 31000873, SystemName: "J162332", WormholeClass: 2},
+	31000874: {RegionID: 11000008, SystemID: 31000874, SystemName: "J125350", WormholeClass: 2},
+	31000875: {RegionID: 11000008, SystemID: 31000875, SystemName: "J101710", WormholeClass: 2},
+	31000876: {RegionID: 11000008, SystemID: 31000876, SystemName: "J223029", WormholeClass: 2},
+	31000877: {RegionID: 11000008, SystemID: 31000877, SystemName: "J234810", WormholeClass: 2},
+	31000878: {RegionID: 11000008, SystemID: 31000878, SystemName: "J135230", WormholeClass: 2},
+	31000879: {RegionID: 11000008, SystemID: 31000879, SystemName: "J111038", WormholeClass: 2},
+	31000880: {RegionID: 11000009, SystemID: 31000880, SystemName: "J160650", WormholeClass: 3},
+	31000881: {RegionID: 11000009, SystemID: 31000881, SystemName: "J114342", WormholeClass: 3},
+	31000882: {RegionID: 11000009, SystemID: 31000882, SystemName: "J152031", WormholeClass: 3},
+	31000883: {RegionID: 11000009, SystemID: 31000883, SystemName: "J113629", WormholeClass: 3},
+	31000884: {RegionID: 11000009, SystemID: 31000884, SystemName: "J220301", WormholeClass: 3},
+	31000885: {RegionID: 11000009, SystemID: 31000885, SystemName: "J102057", WormholeClass: 3},
+	31000886: {RegionID: 11000009, SystemID: 31000886, SystemName: "J124753", WormholeClass: 3},
+	31000887: {RegionID: 11000009, SystemID: 31000887, SystemName: "J114225", WormholeClass: 3},
+	31000888: {RegionID: 11000009, SystemID: 31000888, SystemName: "J105002", WormholeClass: 3},
+	31000889: {RegionID: 11000009, SystemID: 31000889, SystemName: "J111218", WormholeClass: 3},
+	31000890: {RegionID: 11000009, SystemID: 31000890, SystemName: "J111634", WormholeClass: 3},
+	31000891: {RegionID: 11000009, SystemID: 31000891, SystemName: "J105007", WormholeClass: 3},
+	31000892: {RegionID: 11000009, SystemID: 31000892, SystemName: "J205027", WormholeClass: 3},
+	31000893: {RegionID: 11000009, SystemID: 31000893, SystemName: "J111355", WormholeClass: 3},
+	31000894: {RegionID: 11000009, SystemID: 31000894, SystemName: "J101012", WormholeClass: 3},
+	31000895: {RegionID: 11000009, SystemID: 31000895, SystemName: "J161338", WormholeClass: 3},
+	31000896: {RegionID: 11000009, SystemID: 31000896, SystemName: "J170002", WormholeClass: 3},
+	31000897: {RegionID: 11000009, SystemID: 31000897, SystemName: "J104654", WormholeClass: 3},
+	31000898: {RegionID: 11000009, SystemID: 31000898, SystemName: "J124329", WormholeClass: 3},
+	31000899: {RegionID: 11000009, SystemID: 31000899, SystemName: "J114648", WormholeClass: 3},
+	31000900: {RegionID: 11000009, SystemID: 31000900, SystemName: "J131228", WormholeClass: 3},
+	31000901: {RegionID: 11000009, SystemID: 31000901, SystemName: "J110448", WormholeClass: 3},
+	31000902: {RegionID: 11000009, SystemID: 31000902, SystemName: "J144621", WormholeClass: 3},
+	31000903: {RegionID: 11000009, SystemID: 31000903, SystemName: "J153104", WormholeClass: 3},
+	31000904: {RegionID: 11000009, SystemID: 31000904, SystemName: "J100932", WormholeClass: 3},
+	31000905: {RegionID: 11000009, SystemID: 31000905, SystemName: "J114048", WormholeClass: 3},
+	31000906: {RegionID: 11000009, SystemID: 31000906, SystemName: "J115706", WormholeClass: 3},
+	31000907: {RegionID: 11000009, SystemID: 31000907, SystemName: "J123230", WormholeClass: 3},
+	31000908: {RegionID: 11000009, SystemID: 31000908, SystemName: "J152218", WormholeClass: 3},
+	31000909: {RegionID: 11000009, SystemID: 31000909, SystemName: "J123303", WormholeClass: 3},
+	31000910: {RegionID: 11000009, SystemID: 31000910, SystemName: "J102856", WormholeClass: 3},
+	31000911: {RegionID: 11000009, SystemID: 31000911, SystemName: "J151900", WormholeClass: 3},
+	31000912: {RegionID: 11000009, SystemID: 31000912, SystemName: "J124646", WormholeClass: 3},
+	31000913: {RegionID: 11000009, SystemID: 31000913, SystemName: "J153528", WormholeClass: 3},
+	31000914: {RegionID: 11000009, SystemID: 31000914, SystemName: "J112850", WormholeClass: 3},
+	31000915: {RegionID: 11000009, SystemID: 31000915, SystemName: "J102257", WormholeClass: 3},
+	31000916: {RegionID: 11000009, SystemID: 31000916, SystemName: "J142414", WormholeClass: 3},
+	31000917: {RegionID: 11000009, SystemID: 31000917, SystemName: "J165803", WormholeClass: 3},
+	31000918: {RegionID: 11000009, SystemID: 31000918, SystemName: "J115918", WormholeClass: 3},
+	31000919: {RegionID: 11000009, SystemID: 31000919, SystemName: "J222104", WormholeClass: 3},
+	31000920: {RegionID: 11000009, SystemID: 31000920, SystemName: "J124203", WormholeClass: 3},
+	31000921: {RegionID: 11000009, SystemID: 31000921, SystemName: "J112608", WormholeClass: 3},
+	31000922: {RegionID: 11000009, SystemID: 31000922, SystemName: "J161657", WormholeClass: 3},
+	31000923: {RegionID: 11000009, SystemID: 31000923, SystemName: "J111805", WormholeClass: 3},
+	31000924: {RegionID: 11000009, SystemID: 31000924, SystemName: "J113451", WormholeClass: 3},
+	31000925: {RegionID: 11000009, SystemID: 31000925, SystemName: "J141007", WormholeClass: 3},
+	31000926: {RegionID: 11000009, SystemID: 31000926, SystemName: "J164034", WormholeClass: 3},
+	31000927: {RegionID: 11000009, SystemID: 31000927, SystemName: "J101750", WormholeClass: 3},
+	31000928: {RegionID: 11000009, SystemID: 31000928, SystemName: "J165001", WormholeClass: 3},
+	31000929: {RegionID: 11000009, SystemID: 31000929, SystemName: "J111619", WormholeClass: 3},
+	31000930: {RegionID: 11000009, SystemID: 31000930, SystemName: "J140521", WormholeClass: 3},
+	31000931: {RegionID: 11000009, SystemID: 31000931, SystemName: "J140752", WormholeClass: 3},
+	31000932: {RegionID: 11000009, SystemID: 31000932, SystemName: "J143447", WormholeClass: 3},
+	31000933: {RegionID: 11000009, SystemID: 31000933, SystemName: "J142018", WormholeClass: 3},
+	31000934: {RegionID: 11000009, SystemID: 31000934, SystemName: "J105102", WormholeClass: 3},
+	31000935: {RegionID: 11000009, SystemID: 31000935, SystemName: "J124630", WormholeClass: 3},
+	31000936: {RegionID: 11000010, SystemID: 31000936, SystemName: "J165302", WormholeClass: 3},
+	31000937: {RegionID: 11000010, SystemID: 31000937, SystemName: "J143916", WormholeClass: 3},
+	31000938: {RegionID: 11000010, SystemID: 31000938, SystemName: "J221414", WormholeClass: 3},
+	31000939: {RegionID: 11000010, SystemID: 31000939, SystemName: "J153903", WormholeClass: 3},
+	31000940: {RegionID: 11000010, SystemID: 31000940, SystemName: "J103955", WormholeClass: 3},
+	31000941: {RegionID: 11000010, SystemID: 31000941, SystemName: "J210952", WormholeClass: 3},
+	31000942: {RegionID: 11000010, SystemID: 31000942, SystemName: "J134102", WormholeClass: 3},
+	31000943: {RegionID: 11000010, SystemID: 31000943, SystemName: "J140050", WormholeClass: 3},
+	31000944: {RegionID: 11000010, SystemID: 31000944, SystemName: "J131757", WormholeClass: 3},
+	31000945: {RegionID: 11000010, SystemID: 31000945, SystemName: "J140341", WormholeClass: 3},
+	31000946: {RegionID: 11000010, SystemID: 31000946, SystemName: "J111856", WormholeClass: 3},
+	31000947: {RegionID: 11000010, SystemID: 31000947, SystemName: "J102407", WormholeClass: 3},
+	31000948: {RegionID: 11000010, SystemID: 31000948, SystemName: "J151341", WormholeClass: 3},
+	31000949: {RegionID: 11000010, SystemID: 31000949, SystemName: "J110706", WormholeClass: 3},
+	31000950: {RegionID: 11000010, SystemID: 31000950, SystemName: "J133651", WormholeClass: 3},
+	31000951: {RegionID: 11000010, SystemID: 31000951, SystemName: "J123452", WormholeClass: 3},
+	31000952: {RegionID: 11000010, SystemID: 31000952, SystemName: "J102143", WormholeClass: 3},
+	31000953: {RegionID: 11000010, SystemID: 31000953, SystemName: "J220832", WormholeClass: 3},
+	31000954: {RegionID: 11000010, SystemID: 31000954, SystemName: "J160847", WormholeClass: 3},
+	31000955: {RegionID: 11000010, SystemID: 31000955, SystemName: "J125949", WormholeClass: 3},
+	31000956: {RegionID: 11000010, SystemID: 31000956, SystemName: "J164250", WormholeClass: 3},
+	31000957: {RegionID: 11000010, SystemID: 31000957, SystemName: "J212329", WormholeClass: 3},
+	31000958: {RegionID: 11000010, SystemID: 31000958, SystemName: "J112905", WormholeClass: 3},
+	31000959: {RegionID: 11000010, SystemID: 31000959, SystemName: "J144447", WormholeClass: 3},
+	31000960: {RegionID: 11000010, SystemID: 31000960, SystemName: "J113530", WormholeClass: 3},
+	31000961: {RegionID: 11000010, SystemID: 31000961, SystemName: "J103604", WormholeClass: 3},
+	31000962: {RegionID: 11000010, SystemID: 31000962, SystemName: "J142038", WormholeClass: 3},
+	31000963: {RegionID: 11000010, SystemID: 31000963, SystemName: "J130403", WormholeClass: 3},
+	31000964: {RegionID: 11000010, SystemID: 31000964, SystemName: "J132525", WormholeClass: 3},
+	31000965: {RegionID: 11000010, SystemID: 31000965, SystemName: "J103653", WormholeClass: 3},
+	31000966: {RegionID: 11000010, SystemID: 31000966, SystemName: "J154642", WormholeClass: 3},
+	31000967: {RegionID: 11000010, SystemID: 31000967, SystemName: "J163526", WormholeClass: 3},
+	31000968: {RegionID: 11000010, SystemID: 31000968, SystemName: "J212713", WormholeClass: 3},
+	31000969: {RegionID: 11000010, SystemID: 31000969, SystemName: "J132635", WormholeClass: 3},
+	31000970: {RegionID: 11000010, SystemID: 31000970, SystemName: "J114330", WormholeClass: 3},
+	31000971: {RegionID: 11000010, SystemID: 31000971, SystemName: "J224926", WormholeClass: 3},
+	31000972: {RegionID: 11000010, SystemID: 31000972, SystemName: "J113619", WormholeClass: 3},
+	31000973: {RegionID: 11000010, SystemID: 31000973, SystemName: "J214654", WormholeClass: 3},
+	31000974: {RegionID: 11000010, SystemID: 31000974, SystemName: "J151429", WormholeClass: 3},
+	31000975: {RegionID: 11000010, SystemID: 31000975, SystemName: "J111214", WormholeClass: 3},
+	31000976: {RegionID: 11000010, SystemID: 31000976, SystemName: "J113420", WormholeClass: 3},
+	31000977: {RegionID: 11000010, SystemID: 31000977, SystemName: "J135526", WormholeClass: 3},
+	31000978: {RegionID: 11000010, SystemID: 31000978, SystemName: "J164116", WormholeClass: 3},
+	31000979: {RegionID: 11000010, SystemID: 31000979, SystemName: "J214227", WormholeClass: 3},
+	31000980: {RegionID: 11000010, SystemID: 31000980, SystemName: "J164342", WormholeClass: 3},
+	31000981: {RegionID: 11000010, SystemID: 31000981, SystemName: "J151538", WormholeClass: 3},
+	31000982: {RegionID: 11000010, SystemID: 31000982, SystemName: "J125938", WormholeClass: 3},
+	31000983: {RegionID: 11000010, SystemID: 31000983, SystemName: "J165806", WormholeClass: 3},
+	31000984: {RegionID: 11000010, SystemID: 31000984, SystemName: "J122803", WormholeClass: 3},
+	31000985: {RegionID: 11000010, SystemID: 31000985, SystemName: "J113243", WormholeClass: 3},
+	31000986: {RegionID: 11000010, SystemID: 31000986, SystemName: "J134022", WormholeClass: 3},
+	31000987: {RegionID: 11000011, SystemID: 31000987, SystemName: "J152143", WormholeClass: 3},
+	31000988: {RegionID: 11000011, SystemID: 31000988, SystemName: "J170717", WormholeClass: 3},
+	31000989: {RegionID: 11000011, SystemID: 31000989, SystemName: "J121452", WormholeClass: 3},
+	31000990: {RegionID: 11000011, SystemID: 31000990, SystemName: "J124126", WormholeClass: 3},
+	31000991: {RegionID: 11000011, SystemID: 31000991, SystemName: "J173230", WormholeClass: 3},
+	31000992: {RegionID: 11000011, SystemID: 31000992, SystemName: "J164457", WormholeClass: 3},
+	31000993: {RegionID: 11000011, SystemID: 31000993, SystemName: "J112944", WormholeClass: 3},
+	31000994: {RegionID: 11000011, SystemID: 31000994, SystemName: "J115253", WormholeClass: 3},
+	31000995: {RegionID: 11000011, SystemID: 31000995, SystemName: "J100118", WormholeClass: 3},
+	31000996: {RegionID: 11000011, SystemID: 31000996, SystemName: "J100620", WormholeClass: 3},
+	31000997: {RegionID: 11000011, SystemID: 31000997, SystemName: "J145916", WormholeClass: 3},
+	31000998: {RegionID: 11000011, SystemID: 31000998, SystemName: "J223207", WormholeClass: 3},
+	31000999: {RegionID: 11000011, SystemID: 31000999, SystemName: "J144646", WormholeClass: 3},
+	31001000: {RegionID: 11000011, SystemID: 31001000, SystemName: "J142520", WormholeClass: 3},
+	31001001: {RegionID: 11000011, SystemID: 31001001, SystemName: "J230226", WormholeClass: 3},
+	31001002: {RegionID: 11000011, SystemID: 31001002, SystemName: "J150704", WormholeClass: 3},
+	31001003: {RegionID: 11000011, SystemID: 31001003, SystemName: "J225524", WormholeClass: 3},
+	31001004: {RegionID: 11000011, SystemID: 31001004, SystemName: "J133234", WormholeClass: 3},
+	31001005: {RegionID: 11000011, SystemID: 31001005, SystemName: "J125833", WormholeClass: 3},
+	31001006: {RegionID: 11000011, SystemID: 31001006, SystemName: "J104459", WormholeClass: 3},
+	31001007: {RegionID: 11000011, SystemID: 31001007, SystemName: "J164713", WormholeClass: 3},
+	31001008: {RegionID: 11000011, SystemID: 31001008, SystemName: "J135355", WormholeClass: 3},
+	31001009: {RegionID: 11000011, SystemID: 31001009, SystemName: "J220838", WormholeClass: 3},
+	31001010: {RegionID: 11000011, SystemID: 31001010, SystemName: "J225949", WormholeClass: 3},
+	31001011: {RegionID: 11000011, SystemID: 31001011, SystemName: "J130535", WormholeClass: 3},
+	31001012: {RegionID: 11000011, SystemID: 31001012, SystemName: "J111255", WormholeClass: 3},
+	31001013: {RegionID: 11000011, SystemID: 31001013, SystemName: "J121628", WormholeClass: 3},
+	31001014: {RegionID: 11000011, SystemID: 31001014, SystemName: "J221117", WormholeClass: 3},
+	31001015: {RegionID: 11000011, SystemID: 31001015, SystemName: "J115015", WormholeClass: 3},
+	31001016: {RegionID: 11000011, SystemID: 31001016, SystemName: "J172751", WormholeClass: 3},
+	31001017: {RegionID: 11000011, SystemID: 31001017, SystemName: "J134301", WormholeClass: 3},
+	31001018: {RegionID: 11000011, SystemID: 31001018, SystemName: "J104351", WormholeClass: 3},
+	31001019: {RegionID: 11000011, SystemID: 31001019, SystemName: "J144408", WormholeClass: 3},
+	31001020: {RegionID: 11000011, SystemID: 31001020, SystemName: "J220950", WormholeClass: 3},
+	31001021: {RegionID: 11000011, SystemID: 31001021, SystemName: "J165815", WormholeClass: 3},
+	31001022: {RegionID: 11000011, SystemID: 31001022, SystemName: "J120452", WormholeClass: 3},
+	31001023: {RegionID: 11000011, SystemID: 31001023, SystemName: "J142026", WormholeClass: 3},
+	31001024: {RegionID: 11000011, SystemID: 31001024, SystemName: "J111031", WormholeClass: 3},
+	31001025: {RegionID: 11000011, SystemID: 31001025, SystemName: "J130116", WormholeClass: 3},
+	31001026: {RegionID: 11000011, SystemID: 31001026, SystemName: "J111309", WormholeClass: 3},
+	31001027: {RegionID: 11000011, SystemID: 31001027, SystemName: "J122213", WormholeClass: 3},
+	31001028: {RegionID: 11000011, SystemID: 31001028, SystemName: "J170740", WormholeClass: 3},
+	31001029: {RegionID: 11000011, SystemID: 31001029, SystemName: "J113543", WormholeClass: 3},
+	31001030: {RegionID: 11000011, SystemID: 31001030, SystemName: "J170817", WormholeClass: 3},
+	31001031: {RegionID: 11000011, SystemID: 31001031, SystemName: "J123405", WormholeClass: 3},
+	31001032: {RegionID: 11000011, SystemID: 31001032, SystemName: "J125741", WormholeClass: 3},
+	31001033: {RegionID: 11000011, SystemID: 31001033, SystemName: "J114627", WormholeClass: 3},
+	31001034: {RegionID: 11000011, SystemID: 31001034, SystemName: "J155117", WormholeClass: 3},
+	31001035: {RegionID: 11000011, SystemID: 31001035, SystemName: "J165118", WormholeClass: 3},
+	31001036: {RegionID: 11000011, SystemID: 31001036, SystemName: "J141004", WormholeClass: 3},
+	31001037: {RegionID: 11000011, SystemID: 31001037, SystemName: "J174317", WormholeClass: 3},
+	31001038: {RegionID: 11000011, SystemID: 31001038, SystemName: "J145040", WormholeClass: 3},
+	31001039: {RegionID: 11000011, SystemID: 31001039, SystemName: "J171549", WormholeClass: 3},
+	31001040: {RegionID: 11000011, SystemID: 31001040, SystemName: "J100009", WormholeClass: 3},
+	31001041: {RegionID: 11000011, SystemID: 31001041, SystemName: "J124257", WormholeClass: 3},
+	31001042: {RegionID: 11000011, SystemID: 31001042, SystemName: "J154530", WormholeClass: 3},
+	31001043: {RegionID: 11000011, SystemID: 31001043, SystemName: "J152636", WormholeClass: 3},
+	31001044: {RegionID: 11000011, SystemID: 31001044, SystemName: "J100728", WormholeClass: 3},
+	31001045: {RegionID: 11000011, SystemID: 31001045, SystemName: "J115504", WormholeClass: 3},
+	31001046: {RegionID: 11000011, SystemID: 31001046, SystemName: "J104905", WormholeClass: 3},
+	31001047: {RegionID: 11000011, SystemID: 31001047, SystemName: "J125149", WormholeClass: 3},
+	31001048: {RegionID: 11000011, SystemID: 31001048, SystemName: "J172743", WormholeClass: 3},
+	31001049: {RegionID: 11000011, SystemID: 31001049, SystemName: "J111421", WormholeClass: 3},
+	31001050: {RegionID: 11000011, SystemID: 31001050, SystemName: "J152146", WormholeClass: 3},
+	31001051: {RegionID: 11000011, SystemID: 31001051, SystemName: "J111603", WormholeClass: 3},
+	31001052: {RegionID: 11000011, SystemID: 31001052, SystemName: "J114127", WormholeClass: 3},
+	31001053: {RegionID: 11000011, SystemID: 31001053, SystemName: "J113923", WormholeClass: 3},
+	31001054: {RegionID: 11000011, SystemID: 31001054, SystemName: "J123837", WormholeClass: 3},
+	31001055: {RegionID: 11000011, SystemID: 31001055, SystemName: "J125049", WormholeClass: 3},
+	31001056: {RegionID: 11000011, SystemID: 31001056, SystemName: "J161455", WormholeClass: 3},
+	31001057: {RegionID: 11000011, SystemID: 31001057, SystemName: "J124715", WormholeClass: 3},
+	31001058: {RegionID: 11000011, SystemID: 31001058, SystemName: "J124813", WormholeClass: 3},
+	31001059: {RegionID: 11000011, SystemID: 31001059, SystemName: "J120945", WormholeClass: 3},
+	31001060: {RegionID: 11000011, SystemID: 31001060, SystemName: "J155541", WormholeClass: 3},
+	31001061: {RegionID: 11000011, SystemID: 31001061, SystemName: "J105017", WormholeClass: 3},
+	31001062: {RegionID: 11000011, SystemID: 31001062, SystemName: "J154900", WormholeClass: 3},
+	31001063: {RegionID: 11000011, SystemID: 31001063, SystemName: "J164951", WormholeClass: 3},
+	31001064: {RegionID: 11000011, SystemID: 31001064, SystemName: "J162459", WormholeClass: 3},
+	31001065: {RegionID: 11000011, SystemID: 31001065, SystemName: "J141832", WormholeClass: 3},
+	31001066: {RegionID: 11000011, SystemID: 31001066, SystemName: "J115136", WormholeClass: 3},
+	31001067: {RegionID: 11000011, SystemID: 31001067, SystemName: "J112854", WormholeClass: 3},
+	31001068: {RegionID: 11000011, SystemID: 31001068, SystemName: "J222222", WormholeClass: 3},
+	31001069: {RegionID: 11000011, SystemID: 31001069, SystemName: "J124654", WormholeClass: 3},
+	31001070: {RegionID: 11000011, SystemID: 31001070, SystemName: "J132750", WormholeClass: 3},
+	31001071: {RegionID: 11000011, SystemID: 31001071, SystemName: "J170511", WormholeClass: 3},
+	31001072: {RegionID: 11000011, SystemID: 31001072, SystemName: "J155852", WormholeClass: 3},
+	31001073: {RegionID: 11000012, SystemID: 31001073, SystemName: "J121856", WormholeClass: 3},
+	31001074: {RegionID: 11000012, SystemID: 31001074, SystemName: "J131025", WormholeClass: 3},
+	31001075: {RegionID: 11000012, SystemID: 31001075, SystemName: "J122854", WormholeClass: 3},
+	31001076: {RegionID: 11000012, SystemID: 31001076, SystemName: "J132222", WormholeClass: 3},
+	31001077: {RegionID: 11000012, SystemID: 31001077, SystemName: "J203753", WormholeClass: 3},
+	31001078: {RegionID: 11000012, SystemID: 31001078, SystemName: "J130835", WormholeClass: 3},
+	31001079: {RegionID: 11000012, SystemID: 31001079, SystemName: "J134123", WormholeClass: 3},
+	31001080: {RegionID: 11000012, SystemID: 31001080, SystemName: "J133857", WormholeClass: 3},
+	31001081: {RegionID: 11000012, SystemID: 31001081, SystemName: "J171813", WormholeClass: 3},
+	31001082: {RegionID: 11000012, SystemID: 31001082, SystemName: "J143513", WormholeClass: 3},
+	31001083: {RegionID: 11000012, SystemID: 31001083, SystemName: "J112656", WormholeClass: 3},
+	31001084: {RegionID: 11000012, SystemID: 31001084, SystemName: "J154357", WormholeClass: 3},
+	31001085: {RegionID: 11000012, SystemID: 31001085, SystemName: "J154145", WormholeClass: 3},
+	31001086: {RegionID: 11000012, SystemID: 31001086, SystemName: "J100129", WormholeClass: 3},
+	31001087: {RegionID: 11000012, SystemID: 31001087, SystemName: "J155124", WormholeClass: 3},
+	31001088: {RegionID: 11000012, SystemID: 31001088, SystemName: "J133207", WormholeClass: 3},
+	31001089: {RegionID: 11000012, SystemID: 31001089, SystemName: "J124830", WormholeClass: 3},
+	31001090: {RegionID: 11000012, SystemID: 31001090, SystemName: "J144725", WormholeClass: 3},
+	31001091: {RegionID: 11000012, SystemID: 31001091, SystemName: "J170409", WormholeClass: 3},
+	31001092: {RegionID: 11000012, SystemID: 31001092, SystemName: "J103326", WormholeClass: 3},
+	31001093: {RegionID: 11000012, SystemID: 31001093, SystemName: "J160739", WormholeClass: 3},
+	31001094: {RegionID: 11000012, SystemID: 31001094, SystemName: "J133041", WormholeClass: 3},
+	31001095: {RegionID: 11000012, SystemID: 31001095, SystemName: "J205412", WormholeClass: 3},
+	31001096: {RegionID: 11000012, SystemID: 31001096, SystemName: "J145851", WormholeClass: 3},
+	31001097: {RegionID: 11000012, SystemID: 31001097, SystemName: "J132737", WormholeClass: 3},
+	31001098: {RegionID: 11000012, SystemID: 31001098, SystemName: "J130247", WormholeClass: 3},
+	31001099: {RegionID: 11000012, SystemID: 31001099, SystemName: "J151405", WormholeClass: 3},
+	31001100: {RegionID: 11000012, SystemID: 31001100, SystemName: "J131802", WormholeClass: 3},
+	31001101: {RegionID: 11000012, SystemID: 31001101, SystemName: "J221515", WormholeClass: 3},
+	31001102: {RegionID: 11000012, SystemID: 31001102, SystemName: "J142951", WormholeClass: 3},
+	31001103: {RegionID: 11000012, SystemID: 31001103, SystemName: "J101736", WormholeClass: 3},
+	31001104: {RegionID: 11000012, SystemID: 31001104, SystemName: "J233739", WormholeClass: 3},
+	31001105: {RegionID: 11000012, SystemID: 31001105, SystemName: "J221447", WormholeClass: 3},
+	31001106: {RegionID: 11000012, SystemID: 31001106, SystemName: "J145645", WormholeClass: 3},
+	31001107: {RegionID: 11000012, SystemID: 31001107, SystemName: "J153051", WormholeClass: 3},
+	31001108: {RegionID: 11000012, SystemID: 31001108, SystemName: "J141451", WormholeClass: 3},
+	31001109: {RegionID: 11000012, SystemID: 31001109, SystemName: "J131859", WormholeClass: 3},
+	31001110: {RegionID: 11000012, SystemID: 31001110, SystemName: "J144553", WormholeClass: 3},
+	31001111: {RegionID: 11000012, SystemID: 31001111, SystemName: "J145615", WormholeClass: 3},
+	31001112: {RegionID: 11000012, SystemID: 31001112, SystemName: "J223109", WormholeClass: 3},
+	31001113: {RegionID: 11000012, SystemID: 31001113, SystemName: "J132721", WormholeClass: 3},
+	31001114: {RegionID: 11000012, SystemID: 31001114, SystemName: "J103353", WormholeClass: 3},
+	31001115: {RegionID: 11000012, SystemID: 31001115, SystemName: "J173223", WormholeClass: 3},
+	31001116: {RegionID: 11000012, SystemID: 31001116, SystemName: "J104837", WormholeClass: 3},
+	31001117: {RegionID: 11000012, SystemID: 31001117, SystemName: "J163745", WormholeClass: 3},
+	31001118: {RegionID: 11000012, SystemID: 31001118, SystemName: "J101500", WormholeClass: 3},
+	31001119: {RegionID: 11000012, SystemID: 31001119, SystemName: "J224826", WormholeClass: 3},
+	31001120: {RegionID: 11000012, SystemID: 31001120, SystemName: "J230905", WormholeClass: 3},
+	31001121: {RegionID: 11000012, SystemID: 31001121, SystemName: "J110924", WormholeClass: 3},
+	31001122: {RegionID: 11000012, SystemID: 31001122, SystemName: "J122220", WormholeClass: 3},
+	31001123: {RegionID: 11000012, SystemID: 31001123, SystemName: "J165153", WormholeClass: 3},
+	31001124: {RegionID: 11000012, SystemID: 31001124, SystemName: "J204030", WormholeClass: 3},
+	31001125: {RegionID: 11000012, SystemID: 31001125, SystemName: "J134136", WormholeClass: 3},
+	31001126: {RegionID: 11000012, SystemID: 31001126, SystemName: "J113334", WormholeClass: 3},
+	31001127: {RegionID: 11000012, SystemID: 31001127, SystemName: "J125403", WormholeClass: 3},
+	31001128: {RegionID: 11000012, SystemID: 31001128, SystemName: "J162437", WormholeClass: 3},
+	31001129: {RegionID: 11000012, SystemID: 31001129, SystemName: "J235630", WormholeClass: 3},
+	31001130: {RegionID: 11000012, SystemID: 31001130, SystemName: "J105409", WormholeClass: 3},
+	31001131: {RegionID: 11000012, SystemID: 31001131, SystemName: "J163529", WormholeClass: 3},
+	31001132: {RegionID: 11000012, SystemID: 31001132, SystemName: "J152931", WormholeClass: 3},
+	31001133: {RegionID: 11000012, SystemID: 31001133, SystemName: "J131559", WormholeClass: 3},
+	31001134: {RegionID: 11000012, SystemID: 31001134, SystemName: "J143918", WormholeClass: 3},
+	31001135: {RegionID: 11000012, SystemID: 31001135, SystemName: "J104643", WormholeClass: 3},
+	31001136: {RegionID: 11000012, SystemID: 31001136, SystemName: "J212304", WormholeClass: 3},
+	31001137: {RegionID: 11000012, SystemID: 31001137, SystemName: "J154634", WormholeClass: 3},
+	31001138: {RegionID: 11000012, SystemID: 31001138, SystemName: "J172052", WormholeClass: 3},
+	31001139: {RegionID: 11000012, SystemID: 31001139, SystemName: "J135036", WormholeClass: 3},
+	31001140: {RegionID: 11000012, SystemID: 31001140, SystemName: "J134431", WormholeClass: 3},
+	31001141: {RegionID: 11000012, SystemID: 31001141, SystemName: "J121105", WormholeClass: 3},
+	31001142: {RegionID: 11000012, SystemID: 31001142, SystemName: "J120357", WormholeClass: 3},
+	31001143: {RegionID: 11000012, SystemID: 31001143, SystemName: "J101854", WormholeClass: 3},
+	31001144: {RegionID: 11000012, SystemID: 31001144, SystemName: "J232826", WormholeClass: 3},
+	31001145: {RegionID: 11000012, SystemID: 31001145, SystemName: "J154934", WormholeClass: 3},
+	31001146: {RegionID: 11000012, SystemID: 31001146, SystemName: "J170132", WormholeClass: 3},
+	31001147: {RegionID: 11000012, SystemID: 31001147, SystemName: "J114916", WormholeClass: 3},
+	31001148: {RegionID: 11000012, SystemID: 31001148, SystemName: "J142400", WormholeClass: 3},
+	31001149: {RegionID: 11000012, SystemID: 31001149, SystemName: "J115502", WormholeClass: 3},
+	31001150: {RegionID: 11000012, SystemID: 31001150, SystemName: "J121300", WormholeClass: 3},
+	31001151: {RegionID: 11000012, SystemID: 31001151, SystemName: "J223658", WormholeClass: 3},
+	31001152: {RegionID: 11000012, SystemID: 31001152, SystemName: "J124327", WormholeClass: 3},
+	31001153: {RegionID: 11000012, SystemID: 31001153, SystemName: "J220438", WormholeClass: 3},
+	31001154: {RegionID: 11000012, SystemID: 31001154, SystemName: "J144636", WormholeClass: 3},
+	31001155: {RegionID: 11000012, SystemID: 31001155, SystemName: "J113347", WormholeClass: 3},
+	31001156: {RegionID: 11000012, SystemID: 31001156, SystemName: "J150737", WormholeClass: 3},
+	31001157: {RegionID: 11000012, SystemID: 31001157, SystemName: "J152044", WormholeClass: 3},
+	31001158: {RegionID: 11000012, SystemID: 31001158, SystemName: "J142443", WormholeClass: 3},
+	31001159: {RegionID: 11000012, SystemID: 31001159, SystemName: "J164104", WormholeClass: 3},
+	31001160: {RegionID: 11000012, SystemID: 31001160, SystemName: "J155008", WormholeClass: 3},
+	31001161: {RegionID: 11000012, SystemID: 31001161, SystemName: "J114358", WormholeClass: 3},
+	31001162: {RegionID: 11000012, SystemID: 31001162, SystemName: "J122259", WormholeClass: 3},
+	31001163: {RegionID: 11000012, SystemID: 31001163, SystemName: "J125243", WormholeClass: 3},
+	31001164: {RegionID: 11000012, SystemID: 31001164, SystemName: "J114508", WormholeClass: 3},
+	31001165: {RegionID: 11000012, SystemID: 31001165, SystemName: "J125216", WormholeClass: 3},
+	31001166: {RegionID: 11000012, SystemID: 31001166, SystemName: "J113143", WormholeClass: 3},
+	31001167: {RegionID: 11000012, SystemID: 31001167, SystemName: "J101612", WormholeClass: 3},
+	31001168: {RegionID: 11000012, SystemID: 31001168, SystemName: "J101354", WormholeClass: 3},
+	31001169: {RegionID: 11000012, SystemID: 31001169, SystemName: "J100156", WormholeClass: 3},
+	31001170: {RegionID: 11000012, SystemID: 31001170, SystemName: "J110425", WormholeClass: 3},
+	31001171: {RegionID: 11000012, SystemID: 31001171, SystemName: "J133121", WormholeClass: 3},
+	31001172: {RegionID: 11000012, SystemID: 31001172, SystemName: "J165936", WormholeClass: 3},
+	31001173: {RegionID: 11000012, SystemID: 31001173, SystemName: "J213411", WormholeClass: 3},
+	31001174: {RegionID: 11000012, SystemID: 31001174, SystemName: "J231545", WormholeClass: 3},
+	31001175: {RegionID: 11000012, SystemID: 31001175, SystemName: "J121603", WormholeClass: 3},
+	31001176: {RegionID: 11000012, SystemID: 31001176, SystemName: "J222120", WormholeClass: 3},
+	31001177: {RegionID: 11000012, SystemID: 31001177, SystemName: "J231240", WormholeClass: 3},
+	31001178: {RegionID: 11000013, SystemID: 31001178, SystemName: "J164338", WormholeClass: 3},
+	31001179: {RegionID: 11000013, SystemID: 31001179, SystemName: "J142603", WormholeClass: 3},
+	31001180: {RegionID: 11000013, SystemID: 31001180, SystemName: "J212812", WormholeClass: 3},
+	31001181: {RegionID: 11000013, SystemID: 31001181, SystemName: "J164501", WormholeClass: 3},
+	31001182: {RegionID: 11000013, SystemID: 31001182, SystemName: "J144751", WormholeClass: 3},
+	31001183: {RegionID: 11000013, SystemID: 31001183, SystemName: "J214744", WormholeClass: 3},
+	31001184: {RegionID: 11000013, SystemID: 31001184, SystemName: "J135304", WormholeClass: 3},
+	31001185: {RegionID: 11000013, SystemID: 31001185, SystemName: "J223601", WormholeClass: 3},
+	31001186: {RegionID: 11000013, SystemID: 31001186, SystemName: "J103529", WormholeClass: 3},
+	31001187: {RegionID: 11000013, SystemID: 31001187, SystemName: "J161051", WormholeClass: 3},
+	31001188: {RegionID: 11000013, SystemID: 31001188, SystemName: "J224352", WormholeClass: 3},
+	31001189: {RegionID: 11000013, SystemID: 31001189, SystemName: "J113048", WormholeClass: 3},
+	31001190: {RegionID: 11000013, SystemID: 31001190, SystemName: "J140322", WormholeClass: 3},
+	31001191: {RegionID: 11000013, SystemID: 31001191, SystemName: "J152741", WormholeClass: 3},
+	31001192: {RegionID: 11000013, SystemID: 31001192, SystemName: "J222732", WormholeClass: 3},
+	31001193: {RegionID: 11000013, SystemID: 31001193, SystemName: "J171542", WormholeClass: 3},
+	31001194: {RegionID: 11000013, SystemID: 31001194, SystemName: "J205136", WormholeClass: 3},
+	31001195: {RegionID: 11000013, SystemID: 31001195, SystemName: "J121706", WormholeClass: 3},
+	31001196: {RegionID: 11000013, SystemID: 31001196, SystemName: "J143110", WormholeClass: 3},
+	31001197: {RegionID: 11000013, SystemID: 31001197, SystemName: "J110121", WormholeClass: 3},
+	31001198: {RegionID: 11000013, SystemID: 31001198, SystemName: "J104626", WormholeClass: 3},
+	31001199: {RegionID: 11000013, SystemID: 31001199, SystemName: "J212159", WormholeClass: 3},
+	31001200: {RegionID: 11000013, SystemID: 31001200, SystemName: "J151733", WormholeClass: 3},
+	31001201: {RegionID: 11000013, SystemID: 31001201, SystemName: "J103032", WormholeClass: 3},
+	31001202: {RegionID: 11000013, SystemID: 31001202, SystemName: "J150048", WormholeClass: 3},
+	31001203: {RegionID: 11000013, SystemID: 31001203, SystemName: "J113432", WormholeClass: 3},
+	31001204: {RegionID: 11000013, SystemID: 31001204, SystemName: "J134629", WormholeClass: 3},
+	31001205: {RegionID: 11000013, SystemID: 31001205, SystemName: "J113339", WormholeClass: 3},
+	31001206: {RegionID: 11000013, SystemID: 31001206, SystemName: "J122041", WormholeClass: 3},
+	31001207: {RegionID: 11000013, SystemID: 31001207, SystemName: "J130719", WormholeClass: 3},
+	31001208: {RegionID: 11000013, SystemID: 31001208, SystemName: "J204039", WormholeClass: 3},
+	31001209: {RegionID: 11000013, SystemID: 31001209, SystemName: "J101048", WormholeClass: 3},
+	31001210: {RegionID: 11000013, SystemID: 31001210, SystemName: "J114119", WormholeClass: 3},
+	31001211: {RegionID: 11000013, SystemID: 31001211, SystemName: "J110411", WormholeClass: 3},
+	31001212: {RegionID: 11000013, SystemID: 31001212, SystemName: "J104903", WormholeClass: 3},
+	31001213: {RegionID: 11000013, SystemID: 31001213, SystemName: "J151433", WormholeClass: 3},
+	31001214: {RegionID: 11000013, SystemID: 31001214, SystemName: "J143040", WormholeClass: 3},
+	31001215: {RegionID: 11000013, SystemID: 31001215, SystemName: "J133521", WormholeClass: 3},
+	31001216: {RegionID: 11000013, SystemID: 31001216, SystemName: "J163911", WormholeClass: 3},
+	31001217: {RegionID: 11000013, SystemID: 31001217, SystemName: "J204842", WormholeClass: 3},
+	31001218: {RegionID: 11000013, SystemID: 31001218, SystemName: "J135214", WormholeClass: 3},
+	31001219: {RegionID: 11000013, SystemID: 31001219, SystemName: "J131716", WormholeClass: 3},
+	31001220: {RegionID: 11000013, SystemID: 31001220, SystemName: "J225350", WormholeClass: 3},
+	31001221: {RegionID: 11000014, SystemID: 31001221, SystemName: "J105447", WormholeClass: 3},
+	31001222: {RegionID: 11000014, SystemID: 31001222, SystemName: "J144103", WormholeClass: 3},
+	31001223: {RegionID: 11000014, SystemID: 31001223, SystemName: "J165847", WormholeClass: 3},
+	31001224: {RegionID: 11000014, SystemID: 31001224, SystemName: "J121921", WormholeClass: 3},
+	31001225: {RegionID: 11000014, SystemID: 31001225, SystemName: "J212025", WormholeClass: 3},
+	31001226: {RegionID: 11000014, SystemID: 31001226, SystemName: "J145717", WormholeClass: 3},
+	31001227: {RegionID: 11000014, SystemID: 31001227, SystemName: "J113705", WormholeClass: 3},
+	31001228: {RegionID: 11000014, SystemID: 31001228, SystemName: "J132037", WormholeClass: 3},
+	31001229: {RegionID: 11000014, SystemID: 31001229, SystemName: "J224401", WormholeClass: 3},
+	31001230: {RegionID: 11000014, SystemID: 31001230, SystemName: "J152544", WormholeClass: 3},
+	31001231: {RegionID: 11000014, SystemID: 31001231, SystemName: "J114506", WormholeClass: 3},
+	31001232: {RegionID: 11000014, SystemID: 31001232, SystemName: "J231245", WormholeClass: 3},
+	31001233: {RegionID: 11000014, SystemID: 31001233, SystemName: "J151804", WormholeClass: 3},
+	31001234: {RegionID: 11000014, SystemID: 31001234, SystemName: "J105033", WormholeClass: 3},
+	31001235: {RegionID: 11000014, SystemID: 31001235, SystemName: "J214600", WormholeClass: 3},
+	31001236: {RegionID: 11000014, SystemID: 31001236, SystemName: "J152333", WormholeClass: 3},
+	31001237: {RegionID: 11000014, SystemID: 31001237, SystemName: "J131245", WormholeClass: 3},
+	31001238: {RegionID: 11000014, SystemID: 31001238, SystemName: "J151045", WormholeClass: 3},
+	31001239: {RegionID: 11000014, SystemID: 31001239, SystemName: "J160822", WormholeClass: 3},
+	31001240: {RegionID: 11000014, SystemID: 31001240, SystemName: "J125944", WormholeClass: 3},
+	31001241: {RegionID: 11000014, SystemID: 31001241, SystemName: "J173330", WormholeClass: 3},
+	31001242: {RegionID: 11000014, SystemID: 31001242, SystemName: "J115512", WormholeClass: 3},
+	31001243: {RegionID: 11000014, SystemID: 31001243, SystemName: "J161854", WormholeClass: 3},
+	31001244: {RegionID: 11000014, SystemID: 31001244, SystemName: "J122442", WormholeClass: 3},
+	31001245: {RegionID: 11000014, SystemID: 31001245, SystemName: "J114010", WormholeClass: 3},
+	31001246: {RegionID: 11000014, SystemID: 31001246, SystemName: "J153546", WormholeClass: 3},
+	31001247: {RegionID: 11000014, SystemID: 31001247, SystemName: "J141046", WormholeClass: 3},
+	31001248: {RegionID: 11000014, SystemID: 31001248, SystemName: "J160111", WormholeClass: 3},
+	31001249: {RegionID: 11000014, SystemID: 31001249, SystemName: "J101243", WormholeClass: 3},
+	31001250: {RegionID: 11000014, SystemID: 31001250, SystemName: "J214009", WormholeClass: 3},
+	31001251: {RegionID: 11000014, SystemID: 31001251, SystemName: "J152757", WormholeClass: 3},
+	31001252: {RegionID: 11000014, SystemID: 31001252, SystemName: "J164729", WormholeClass: 3},
+	31001253: {RegionID: 11000014, SystemID: 31001253, SystemName: "J171420", WormholeClass: 3},
+	31001254: {RegionID: 11000014, SystemID: 31001254, SystemName: "J232441", WormholeClass: 3},
+	31001255: {RegionID: 11000014, SystemID: 31001255, SystemName: "J131656", WormholeClass: 3},
+	31001256: {RegionID: 11000014, SystemID: 31001256, SystemName: "J135406", WormholeClass: 3},
+	31001257: {RegionID: 11000014, SystemID: 31001257, SystemName: "J230708", WormholeClass: 3},
+	31001258: {RegionID: 11000014, SystemID: 31001258, SystemName: "J120124", WormholeClass: 3},
+	31001259: {RegionID: 11000014, SystemID: 31001259, SystemName: "J132746", WormholeClass: 3},
+	31001260: {RegionID: 11000014, SystemID: 31001260, SystemName: "J212207", WormholeClass: 3},
+	31001261: {RegionID: 11000014, SystemID: 31001261, SystemName: "J104416", WormholeClass: 3},
+	31001262: {RegionID: 11000014, SystemID: 31001262, SystemName: "J104841", WormholeClass: 3},
+	31001263: {RegionID: 11000014, SystemID: 31001263, SystemName: "J172701", WormholeClass: 3},
+	31001264: {RegionID: 11000014, SystemID: 31001264, SystemName: "J104835", WormholeClass: 3},
+	31001265: {RegionID: 11000014, SystemID: 31001265, SystemName: "J113647", WormholeClass: 3},
+	31001266: {RegionID: 11000014, SystemID: 31001266, SystemName: "J144316", WormholeClass: 3},
+	31001267: {RegionID: 11000014, SystemID: 31001267, SystemName: "J114612", WormholeClass: 3},
+	31001268: {RegionID: 11000014, SystemID: 31001268, SystemName: "J130759", WormholeClass: 3},
+	31001269: {RegionID: 11000014, SystemID: 31001269, SystemName: "J164751", WormholeClass: 3},
+	31001270: {RegionID: 11000014, SystemID: 31001270, SystemName: "J131324", WormholeClass: 3},
+	31001271: {RegionID: 11000014, SystemID: 31001271, SystemName: "J230722", WormholeClass: 3},
+	31001272: {RegionID: 11000014, SystemID: 31001272, SystemName: "J134118", WormholeClass: 3},
+	31001273: {RegionID: 11000014, SystemID: 31001273, SystemName: "J164223", WormholeClass: 3},
+	31001274: {RegionID: 11000014, SystemID: 31001274, SystemName: "J154449", WormholeClass: 3},
+	31001275: {RegionID: 11000014, SystemID: 31001275, SystemName: "J172815", WormholeClass: 3},
+	31001276: {RegionID: 11000014, SystemID: 31001276, SystemName: "J151242", WormholeClass: 3},
+	31001277: {RegionID: 11000014, SystemID: 31001277, SystemName: "J213423", WormholeClass: 3},
+	31001278: {RegionID: 11000014, SystemID: 31001278, SystemName: "J145555", WormholeClass: 3},
+	31001279: {RegionID: 11000014, SystemID: 31001279, SystemName: "J101323", WormholeClass: 3},
+	31001280: {RegionID: 11000014, SystemID: 31001280, SystemName: "J112954", WormholeClass: 3},
+	31001281: {RegionID: 11000014, SystemID: 31001281, SystemName: "J133951", WormholeClass: 3},
+	31001282: {RegionID: 11000014, SystemID: 31001282, SystemName: "J150444", WormholeClass: 3},
+	31001283: {RegionID: 11000014, SystemID: 31001283, SystemName: "J153858", WormholeClass: 3},
+	31001284: {RegionID: 11000014, SystemID: 31001284, SystemName: "J172422", WormholeClass: 3},
+	31001285: {RegionID: 11000014, SystemID: 31001285, SystemName: "J155545", WormholeClass: 3},
+	31001286: {RegionID: 11000014, SystemID: 31001286, SystemName: "J161441", WormholeClass: 3},
+	31001287: {RegionID: 11000014, SystemID: 31001287, SystemName: "J125449", WormholeClass: 3},
+	31001288: {RegionID: 11000014, SystemID: 31001288, SystemName: "J210445", WormholeClass: 3},
+	31001289: {RegionID: 11000014, SystemID: 31001289, SystemName: "J123443", WormholeClass: 3},
+	31001290: {RegionID: 11000014, SystemID: 31001290, SystemName: "J121422", WormholeClass: 3},
+	31001291: {RegionID: 11000014, SystemID: 31001291, SystemName: "J155403", WormholeClass: 3},
+	31001292: {RegionID: 11000014, SystemID: 31001292, SystemName: "J103724", WormholeClass: 3},
+	31001293: {RegionID: 11000014, SystemID: 31001293, SystemName: "J112948", WormholeClass: 3},
+	31001294: {RegionID: 11000014, SystemID: 31001294, SystemName: "J235330", WormholeClass: 3},
+	31001295: {RegionID: 11000014, SystemID: 31001295, SystemName: "J121507", WormholeClass: 3},
+	31001296: {RegionID: 11000014, SystemID: 31001296, SystemName: "J235712", WormholeClass: 3},
+	31001297: {RegionID: 11000014, SystemID: 31001297, SystemName: "J125016", WormholeClass: 3},
+	31001298: {RegionID: 11000014, SystemID: 31001298, SystemName: "J151516", WormholeClass: 3},
+	31001299: {RegionID: 11000014, SystemID: 31001299, SystemName: "J132226", WormholeClass: 3},
+	31001300: {RegionID: 11000014, SystemID: 31001300, SystemName: "J170656", WormholeClass: 3},
+	31001301: {RegionID: 11000014, SystemID: 31001301, SystemName: "J155722", WormholeClass: 3},
+	31001302: {RegionID: 11000014, SystemID: 31001302, SystemName: "J150135", WormholeClass: 3},
+	31001303: {RegionID: 11000014, SystemID: 31001303, SystemName: "J151645", WormholeClass: 3},
+	31001304: {RegionID: 11000014, SystemID: 31001304, SystemName: "J211151", WormholeClass: 3},
+	31001305: {RegionID: 11000014, SystemID: 31001305, SystemName: "J213109", WormholeClass: 3},
+	31001306: {RegionID: 11000014, SystemID: 31001306, SystemName: "J233628", WormholeClass: 3},
+	31001307: {RegionID: 11000014, SystemID: 31001307, SystemName: "J102433", WormholeClass: 3},
+	31001308: {RegionID: 11000014, SystemID: 31001308, SystemName: "J111823", WormholeClass: 3},
+	31001309: {RegionID: 11000014, SystemID: 31001309, SystemName: "J211805", WormholeClass: 3},
+	31001310: {RegionID: 11000014, SystemID: 31001310, SystemName: "J172028", WormholeClass: 3},
+	31001311: {RegionID: 11000014, SystemID: 31001311, SystemName: "J101833", WormholeClass: 3},
+	31001312: {RegionID: 11000014, SystemID: 31001312, SystemName: "J104815", WormholeClass: 3},
+	31001313: {RegionID: 11000014, SystemID: 31001313, SystemName: "J124635", WormholeClass: 3},
+	31001314: {RegionID: 11000014, SystemID: 31001314, SystemName: "J110738", WormholeClass: 3},
+	31001315: {RegionID: 11000014, SystemID: 31001315, SystemName: "J170550", WormholeClass: 3},
+	31001316: {RegionID: 11000014, SystemID: 31001316, SystemName: "J143704", WormholeClass: 3},
+	31001317: {RegionID: 11000015, SystemID: 31001317, SystemName: "J143614", WormholeClass: 3},
+	31001318: {RegionID: 11000015, SystemID: 31001318, SystemName: "J170236", WormholeClass: 3},
+	31001319: {RegionID: 11000015, SystemID: 31001319, SystemName: "J153034", WormholeClass: 3},
+	31001320: {RegionID: 11000015, SystemID: 31001320, SystemName: "J220338", WormholeClass: 3},
+	31001321: {RegionID: 11000015, SystemID: 31001321, SystemName: "J171828", WormholeClass: 3},
+	31001322: {RegionID: 11000015, SystemID: 31001322, SystemName: "J112942", WormholeClass: 3},
+	31001323: {RegionID: 11000015, SystemID: 31001323, SystemName: "J112709", WormholeClass: 3},
+	31001324: {RegionID: 11000015, SystemID: 31001324, SystemName: "J161213", WormholeClass: 3},
+	31001325: {RegionID: 11000015, SystemID: 31001325, SystemName: "J140739", WormholeClass: 3},
+	31001326: {RegionID: 11000015, SystemID: 31001326, SystemName: "J213534", WormholeClass: 3},
+	31001327: {RegionID: 11000015, SystemID: 31001327, SystemName: "J165224", WormholeClass: 3},
+	31001328: {RegionID: 11000015, SystemID: 31001328, SystemName: "J170144", WormholeClass: 3},
+	31001329: {RegionID: 11000015, SystemID: 31001329, SystemName: "J162010", WormholeClass: 3},
+	31001330: {RegionID: 11000015, SystemID: 31001330, SystemName: "J110226", WormholeClass: 3},
+	31001331: {RegionID: 11000015, SystemID: 31001331, SystemName: "J104421", WormholeClass: 3},
+	31001332: {RegionID: 11000015, SystemID: 31001332, SystemName: "J104330", WormholeClass: 3},
+	31001333: {RegionID: 11000015, SystemID: 31001333, SystemName: "J170376", WormholeClass: 3},
+	31001334: {RegionID: 11000015, SystemID: 31001334, SystemName: "J100338", WormholeClass: 3},
+	31001335: {RegionID: 11000015, SystemID: 31001335, SystemName: "J110034", WormholeClass: 3},
+	31001336: {RegionID: 11000015, SystemID: 31001336, SystemName: "J124207", WormholeClass: 3},
+	31001337: {RegionID: 11000015, SystemID: 31001337, SystemName: "J132907", WormholeClass: 3},
+	31001338: {RegionID: 11000015, SystemID: 31001338, SystemName: "J100015", WormholeClass: 3},
+	31001339: {RegionID: 11000015, SystemID: 31001339, SystemName: "J144024", WormholeClass: 3},
+	31001340: {RegionID: 11000015, SystemID: 31001340, SystemName: "J122806", WormholeClass: 3},
+	31001341: {RegionID: 11000015, SystemID: 31001341, SystemName: "J133250", WormholeClass: 3},
+	31001342: {RegionID: 11000015, SystemID: 31001342, SystemName: "J135852", WormholeClass: 3},
+	31001343: {RegionID: 11000015, SystemID: 31001343, SystemName: "J130616", WormholeClass: 3},
+	31001344: {RegionID: 11000015, SystemID: 31001344, SystemName: "J204323", WormholeClass: 3},
+	31001345: {RegionID: 11000015, SystemID: 31001345, SystemName: "J120742", WormholeClass: 3},
+	31001346: {RegionID: 11000015, SystemID: 31001346, SystemName: "J105549", WormholeClass: 3},
+	31001347: {RegionID: 11000015, SystemID: 31001347, SystemName: "J103237", WormholeClass: 3},
+	31001348: {RegionID: 11000015, SystemID: 31001348, SystemName: "J215326", WormholeClass: 3},
+	31001349: {RegionID: 11000015, SystemID: 31001349, SystemName: "J121700", WormholeClass: 3},
+	31001350: {RegionID: 11000015, SystemID: 31001350, SystemName: "J124358", WormholeClass: 3},
+	31001351: {RegionID: 11000015, SystemID: 31001351, SystemName: "J112420", WormholeClass: 3},
+	31001352: {RegionID: 11000015, SystemID: 31001352, SystemName: "J130022", WormholeClass: 3},
+	31001353: {RegionID: 11000015, SystemID: 31001353, SystemName: "J155419", WormholeClass: 3},
+	31001354: {RegionID: 11000015, SystemID: 31001354, SystemName: "J135723", WormholeClass: 3},
+	31001355: {RegionID: 11000015, SystemID: 31001355, SystemName: "J105201", WormholeClass: 3},
+	31001356: {RegionID: 11000015, SystemID: 31001356, SystemName: "J133218", WormholeClass: 3},
+	31001357: {RegionID: 11000015, SystemID: 31001357, SystemName: "J143345", WormholeClass: 3},
+	31001358: {RegionID: 11000015, SystemID: 31001358, SystemName: "J115448", WormholeClass: 3},
+	31001359: {RegionID: 11000015, SystemID: 31001359, SystemName: "J135642", WormholeClass: 3},
+	31001360: {RegionID: 11000015, SystemID: 31001360, SystemName: "J205900", WormholeClass: 3},
+	31001361: {RegionID: 11000015, SystemID: 31001361, SystemName: "J112747", WormholeClass: 3},
+	31001362: {RegionID: 11000015, SystemID: 31001362, SystemName: "J121027", WormholeClass: 3},
+	31001363: {RegionID: 11000015, SystemID: 31001363, SystemName: "J124744", WormholeClass: 3},
+	31001364: {RegionID: 11000015, SystemID: 31001364, SystemName: "J161303", WormholeClass: 3},
+	31001365: {RegionID: 11000015, SystemID: 31001365, SystemName: "J141848", WormholeClass: 3},
+	31001366: {RegionID: 11000015, SystemID: 31001366, SystemName: "J104001", WormholeClass: 3},
+	31001367: {RegionID: 11000015, SystemID: 31001367, SystemName: "J102304", WormholeClass: 3},
+	31001368: {RegionID: 11000015, SystemID: 31001368, SystemName: "J172852", WormholeClass: 3},
+	31001369: {RegionID: 11000015, SystemID: 31001369, SystemName: "J131304", WormholeClass: 3},
+	31001370: {RegionID: 11000015, SystemID: 31001370, SystemName: "J145632", WormholeClass: 3},
+	31001371: {RegionID: 11000015, SystemID: 31001371, SystemName: "J102515", WormholeClass: 3},
+	31001372: {RegionID: 11000015, SystemID: 31001372, SystemName: "J160645", WormholeClass: 3},
+	31001373: {RegionID: 11000015, SystemID: 31001373, SystemName: "J135129", WormholeClass: 3},
+	31001374: {RegionID: 11000015, SystemID: 31001374, SystemName: "J115909", WormholeClass: 3},
+	31001375: {RegionID: 11000016, SystemID: 31001375, SystemName: "J160225", WormholeClass: 4},
+	31001376: {RegionID: 11000016, SystemID: 31001376, SystemName: "J210458", WormholeClass: 4},
+	31001377: {RegionID: 11000016, SystemID: 31001377, SystemName: "J113925", WormholeClass: 4},
+	31001378: {RegionID: 11000016, SystemID: 31001378, SystemName: "J204815", WormholeClass: 4},
+	31001379: {RegionID: 11000016, SystemID: 31001379, SystemName: "J104754", WormholeClass: 4},
+	31001380: {RegionID: 11000016, SystemID: 31001380, SystemName: "J130518", WormholeClass: 4},
+	31001381: {RegionID: 11000016, SystemID: 31001381, SystemName: "J142200", WormholeClass: 4},
+	31001382: {RegionID: 11000016, SystemID: 31001382, SystemName: "J103731", WormholeClass: 4},
+	31001383: {RegionID: 11000016, SystemID: 31001383, SystemName: "J100046", WormholeClass: 4},
+	31001384: {RegionID: 11000016, SystemID: 31001384, SystemName: "J230301", WormholeClass: 4},
+	31001385: {RegionID: 11000016, SystemID: 31001385, SystemName: "J165839", WormholeClass: 4},
+	31001386: {RegionID: 11000016, SystemID: 31001386, SystemName: "J235953", WormholeClass: 4},
+	31001387: {RegionID: 11000016, SystemID: 31001387, SystemName: "J115018", WormholeClass: 4},
+	31001388: {RegionID: 11000016, SystemID: 31001388, SystemName: "J133458", WormholeClass: 4},
+	31001389: {RegionID: 11000016, SystemID: 31001389, SystemName: "J221859", WormholeClass: 4},
+	31001390: {RegionID: 11000016, SystemID: 31001390, SystemName: "J170215", WormholeClass: 4},
+	31001391: {RegionID: 11000016, SystemID: 31001391, SystemName: "J165820", WormholeClass: 4},
+	31001392: {RegionID: 11000016, SystemID: 31001392, SystemName: "J130253", WormholeClass: 4},
+	31001393: {RegionID: 11000016, SystemID: 31001393, SystemName: "J120937", WormholeClass: 4},
+	31001394: {RegionID: 11000016, SystemID: 31001394, SystemName: "J214811", WormholeClass: 4},
+	31001395: {RegionID: 11000016, SystemID: 31001395, SystemName: "J160419", WormholeClass: 4},
+	31001396: {RegionID: 11000016, SystemID: 31001396, SystemName: "J161752", WormholeClass: 4},
+	31001397: {RegionID: 11000016, SystemID: 31001397, SystemName: "J112610", WormholeClass: 4},
+	31001398: {RegionID: 11000016, SystemID: 31001398, SystemName: "J123726", WormholeClass: 4},
+	31001399: {RegionID: 11000016, SystemID: 31001399, SystemName: "J124837", WormholeClass: 4},
+	31001400: {RegionID: 11000016, SystemID: 31001400, SystemName: "J151643", WormholeClass: 4},
+	31001401: {RegionID: 11000016, SystemID: 31001401, SystemName: "J160321", WormholeClass: 4},
+	31001402: {RegionID: 11000016, SystemID: 31001402, SystemName: "J165308", WormholeClass: 4},
+	31001403: {RegionID: 11000016, SystemID: 31001403, SystemName: "J171359", WormholeClass: 4},
+	31001404: {RegionID: 11000016, SystemID: 31001404, SystemName: "J115547", WormholeClass: 4},
+	31001405: {RegionID: 11000016, SystemID: 31001405, SystemName: "J141055", WormholeClass: 4},
+	31001406: {RegionID: 11000016, SystemID: 31001406, SystemName: "J153202", WormholeClass: 4},
+	31001407: {RegionID: 11000016, SystemID: 31001407, SystemName: "J121845", WormholeClass: 4},
+	31001408: {RegionID: 11000016, SystemID: 31001408, SystemName: "J113456", WormholeClass: 4},
+	31001409: {RegionID: 11000016, SystemID: 31001409, SystemName: "J144746", WormholeClass: 4},
+	31001410: {RegionID: 11000016, SystemID: 31001410, SystemName: "J171554", WormholeClass: 4},
+	31001411: {RegionID: 11000016, SystemID: 31001411, SystemName: "J111029", WormholeClass: 4},
+	31001412: {RegionID: 11000016, SystemID: 31001412, SystemName: "J145129", WormholeClass: 4},
+	31001413: {RegionID: 11000016, SystemID: 31001413, SystemName: "J113730", WormholeClass: 4},
+	31001414: {RegionID: 11000016, SystemID: 31001414, SystemName: "J100956", WormholeClass: 4},
+	31001415: {RegionID: 11000016, SystemID: 31001415, SystemName: "J134407", WormholeClass: 4},
+	31001416: {RegionID: 11000016, SystemID: 31001416, SystemName: "J101507", WormholeClass: 4},
+	31001417: {RegionID: 11000016, SystemID: 31001417, SystemName: "J105546", WormholeClass: 4},
+	31001418: {RegionID: 11000016, SystemID: 31001418, SystemName: "J123528", WormholeClass: 4},
+	31001419: {RegionID: 11000016, SystemID: 31001419, SystemName: "J145318", WormholeClass: 4},
+	31001420: {RegionID: 11000016, SystemID: 31001420, SystemName: "J132611", WormholeClass: 4},
+	31001421: {RegionID: 11000016, SystemID: 31001421, SystemName: "J153338", WormholeClass: 4},
+	31001422: {RegionID: 11000016, SystemID: 31001422, SystemName: "J102534", WormholeClass: 4},
+	31001423: {RegionID: 11000016, SystemID: 31001423, SystemName: "J144519", WormholeClass: 4},
+	31001424: {RegionID: 11000016, SystemID: 31001424, SystemName: "J141218", WormholeClass: 4},
+	31001425: {RegionID: 11000016, SystemID: 31001425, SystemName: "J152255", WormholeClass: 4},
+	31001426: {RegionID: 11000016, SystemID: 31001426, SystemName: "J142241", WormholeClass: 4},
+	31001427: {RegionID: 11000016, SystemID: 31001427, SystemName: "J131047", WormholeClass: 4},
+	31001428: {RegionID: 11000016, SystemID: 31001428, SystemName: "J100150", WormholeClass: 4},
+	31001429: {RegionID: 11000016, SystemID: 31001429, SystemName: "J104311", WormholeClass: 4},
+	31001430: {RegionID: 11000016, SystemID: 31001430, SystemName: "J111950", WormholeClass: 4},
+	31001431: {RegionID: 11000016, SystemID: 31001431, SystemName: "J132754", WormholeClass: 4},
+	31001432: {RegionID: 11000016, SystemID: 31001432, SystemName: "J101551", WormholeClass: 4},
+	31001433: {RegionID: 11000016, SystemID: 31001433, SystemName: "J211027", WormholeClass: 4},
+	31001434: {RegionID: 11000016, SystemID: 31001434, SystemName: "J105415", WormholeClass: 4},
+	31001435: {RegionID: 11000017, SystemID: 31001435, SystemName: "J224148", WormholeClass: 4},
+	31001436: {RegionID: 11000017, SystemID: 31001436, SystemName: "J100806", WormholeClass: 4},
+	31001437: {RegionID: 11000017, SystemID: 31001437, SystemName: "J133638", WormholeClass: 4},
+	31001438: {RegionID: 11000017, SystemID: 31001438, SystemName: "J231614", WormholeClass: 4},
+	31001439: {RegionID: 11000017, SystemID: 31001439, SystemName: "J103110", WormholeClass: 4},
+	31001440: {RegionID: 11000017, SystemID: 31001440, SystemName: "J101706", WormholeClass: 4},
+	31001441: {RegionID: 11000017, SystemID: 31001441, SystemName: "J142214", WormholeClass: 4},
+	31001442: {RegionID: 11000017, SystemID: 31001442, SystemName: "J155340", WormholeClass: 4},
+	31001443: {RegionID: 11000017, SystemID: 31001443, SystemName: "J165326", WormholeClass: 4},
+	31001444: {RegionID: 11000017, SystemID: 31001444, SystemName: "J133113", WormholeClass: 4},
+	31001445: {RegionID: 11000017, SystemID: 31001445, SystemName: "J122656", WormholeClass: 4},
+	31001446: {RegionID: 11000017, SystemID: 31001446, SystemName: "J134716", WormholeClass: 4},
+	31001447: {RegionID: 11000017, SystemID: 31001447, SystemName: "J171344", WormholeClass: 4},
+	31001448: {RegionID: 11000017, SystemID: 31001448, SystemName: "J161119", WormholeClass: 4},
+	31001449: {RegionID: 11000017, SystemID: 31001449, SystemName: "J111404", WormholeClass: 4},
+	31001450: {RegionID: 11000017, SystemID: 31001450, SystemName: "J105346", WormholeClass: 4},
+	31001451: {RegionID: 11000017, SystemID: 31001451, SystemName: "J154631", WormholeClass: 4},
+	31001452: {RegionID: 11000017, SystemID: 31001452, SystemName: "J150112", WormholeClass: 4},
+	31001453: {RegionID: 11000017, SystemID: 31001453, SystemName: "J104649", WormholeClass: 4},
+	31001454: {RegionID: 11000017, SystemID: 31001454, SystemName: "J234421", WormholeClass: 4},
+	31001455: {RegionID: 11000017, SystemID: 31001455, SystemName: "J105726", WormholeClass: 4},
+	31001456: {RegionID: 11000017, SystemID: 31001456, SystemName: "J111758", WormholeClass: 4},
+	31001457: {RegionID: 11000017, SystemID: 31001457, SystemName: "J142923", WormholeClass: 4},
+	31001458: {RegionID: 11000017, SystemID: 31001458, SystemName: "J114026", WormholeClass: 4},
+	31001459: {RegionID: 11000017, SystemID: 31001459, SystemName: "J115118", WormholeClass: 4},
+	31001460: {RegionID: 11000018, SystemID: 31001460, SystemName: "J162226", WormholeClass: 4},
+	31001461: {RegionID: 11000018, SystemID: 31001461, SystemName: "J165020", WormholeClass: 4},
+	31001462: {RegionID: 11000018, SystemID: 31001462, SystemName: "J125302", WormholeClass: 4},
+	31001463: {RegionID: 11000018, SystemID: 31001463, SystemName: "J113754", WormholeClass: 4},
+	31001464: {RegionID: 11000018, SystemID: 31001464, SystemName: "J153215", WormholeClass: 4},
+	31001465: {RegionID: 11000018, SystemID: 31001465, SystemName: "J213820", WormholeClass: 4},
+	31001466: {RegionID: 11000018, SystemID: 31001466, SystemName: "J111555", WormholeClass: 4},
+	31001467: {RegionID: 11000018, SystemID: 31001467, SystemName: "J131553", WormholeClass: 4},
+	31001468: {RegionID: 11000018, SystemID: 31001468, SystemName: "J143841", WormholeClass: 4},
+	31001469: {RegionID: 11000018, SystemID: 31001469, SystemName: "J113250", WormholeClass: 4},
+	31001470: {RegionID: 11000018, SystemID: 31001470, SystemName: "J204635", WormholeClass: 4},
+	31001471: {RegionID: 11000018, SystemID: 31001471, SystemName: "J212954", WormholeClass: 4},
+	31001472: {RegionID: 11000018, SystemID: 31001472, SystemName: "J132309", WormholeClass: 4},
+	31001473: {RegionID: 11000018, SystemID: 31001473, SystemName: "J231341", WormholeClass: 4},
+	31001474: {RegionID: 11000018, SystemID: 31001474, SystemName: "J160800", WormholeClass: 4},
+	31001475: {RegionID: 11000018, SystemID: 31001475, SystemName: "J155338", WormholeClass: 4},
+	31001476: {RegionID: 11000018, SystemID: 31001476, SystemName: "J132149", WormholeClass: 4},
+	31001477: {RegionID: 11000018, SystemID: 31001477, SystemName: "J171937", WormholeClass: 4},
+	31001478: {RegionID: 11000018, SystemID: 31001478, SystemName: "J152443", WormholeClass: 4},
+	31001479: {RegionID: 11000018, SystemID: 31001479, SystemName: "J111329", WormholeClass: 4},
+	31001480: {RegionID: 11000018, SystemID: 31001480, SystemName: "J171334", WormholeClass: 4},
+	31001481: {RegionID: 11000018, SystemID: 31001481, SystemName: "J115552", WormholeClass: 4},
+	31001482: {RegionID: 11000018, SystemID: 31001482, SystemName: "J151520", WormholeClass: 4},
+	31001483: {RegionID: 11000018, SystemID: 31001483, SystemName: "J165920", WormholeClass: 4},
+	31001484: {RegionID: 11000018, SystemID: 31001484, SystemName: "J145838", WormholeClass: 4},
+	31001485: {RegionID: 11000018, SystemID: 31001485, SystemName: "J125031", WormholeClass: 4},
+	31001486: {RegionID: 11000018, SystemID: 31001486, SystemName: "J110736", WormholeClass: 4},
+	31001487: {RegionID: 11000018, SystemID: 31001487, SystemName: "J165423", WormholeClass: 4},
+	31001488: {RegionID: 11000018, SystemID: 31001488, SystemName: "J155521", WormholeClass: 4},
+	31001489: {RegionID: 11000018, SystemID: 31001489, SystemName: "J135508", WormholeClass: 4},
+	31001490: {RegionID: 11000018, SystemID: 31001490, SystemName: "J103008", WormholeClass: 4},
+	31001491: {RegionID: 11000018, SystemID: 31001491, SystemName: "J103704", WormholeClass: 4},
+	31001492: {RegionID: 11000018, SystemID: 31001492, SystemName: "J104603", WormholeClass: 4},
+	31001493: {RegionID: 11000018, SystemID: 31001493, SystemName: "J163641", WormholeClass: 4},
+	31001494: {RegionID: 11000018, SystemID: 31001494, SystemName: "J203952", WormholeClass: 4},
+	31001495: {RegionID: 11000018, SystemID: 31001495, SystemName: "J105411", WormholeClass: 4},
+	31001496: {RegionID: 11000018, SystemID: 31001496, SystemName: "J101126", WormholeClass: 4},
+	31001497: {RegionID: 11000018, SystemID: 31001497, SystemName: "J122155", WormholeClass: 4},
+	31001498: {RegionID: 11000018, SystemID: 31001498, SystemName: "J110016", WormholeClass: 4},
+	31001499: {RegionID: 11000018, SystemID: 31001499, SystemName: "J212417", WormholeClass: 4},
+	31001500: {RegionID: 11000018, SystemID: 31001500, SystemName: "J142355", WormholeClass: 4},
+	31001501: {RegionID: 11000018, SystemID: 31001501, SystemName: "J121347", WormholeClass: 4},
+	31001502: {RegionID: 11000018, SystemID: 31001502, SystemName: "J172915", WormholeClass: 4},
+	31001503: {RegionID: 11000018, SystemID: 31001503, SystemName: "J155416", WormholeClass: 4},
+	31001504: {RegionID: 11000018, SystemID: 31001504, SystemName: "J211258", WormholeClass: 4},
+	31001505: {RegionID: 11000018, SystemID: 31001505, SystemName: "J120643", WormholeClass: 4},
+	31001506: {RegionID: 11000019, SystemID: 31001506, SystemName: "J143946", WormholeClass: 4},
+	31001507: {RegionID: 11000019, SystemID: 31001507, SystemName: "J125247", WormholeClass: 4},
+	31001508: {RegionID: 11000019, SystemID: 31001508, SystemName: "J144732", WormholeClass: 4},
+	31001509: {RegionID: 11000019, SystemID: 31001509, SystemName: "J155711", WormholeClass: 4},
+	31001510: {RegionID: 11000019, SystemID: 31001510, SystemName: "J214308", WormholeClass: 4},
+	31001511: {RegionID: 11000019, SystemID: 31001511, SystemName: "J134851", WormholeClass: 4},
+	31001512: {RegionID: 11000019, SystemID: 31001512, SystemName: "J145452", WormholeClass: 4},
+	31001513: {RegionID: 11000019, SystemID: 31001513, SystemName: "J115026", WormholeClass: 4},
+	31001514: {RegionID: 11000019, SystemID: 31001514, SystemName: "J151720", WormholeClass: 4},
+	31001515: {RegionID: 11000019, SystemID: 31001515, SystemName: "J151353", WormholeClass: 4},
+	31001516: {RegionID: 11000019, SystemID: 31001516, SystemName: "J113727", WormholeClass: 4},
+	31001517: {RegionID: 11000019, SystemID: 31001517, SystemName: "J130944", WormholeClass: 4},
+	31001518: {RegionID: 11000019, SystemID: 31001518, SystemName: "J145238", WormholeClass: 4},
+	31001519: {RegionID: 11000019, SystemID: 31001519, SystemName: "J235219", WormholeClass: 4},
+	31001520: {RegionID: 11000019, SystemID: 31001520, SystemName: "J172512", WormholeClass: 4},
+	31001521: {RegionID: 11000019, SystemID: 31001521, SystemName: "J141322", WormholeClass: 4},
+	31001522: {RegionID: 11000019, SystemID: 31001522, SystemName: "J210333", WormholeClass: 4},
+	31001523: {RegionID: 11000019, SystemID: 31001523, SystemName: "J135836", WormholeClass: 4},
+	31001524: {RegionID: 11000019, SystemID: 31001524, SystemName: "J101915", WormholeClass: 4},
+	31001525: {RegionID: 11000019, SystemID: 31001525, SystemName: "J130203", WormholeClass: 4},
+	31001526: {RegionID: 11000019, SystemID: 31001526, SystemName: "J110719", WormholeClass: 4},
+	31001527: {RegionID: 11000019, SystemID: 31001527, SystemName: "J131208", WormholeClass: 4},
+	31001528: {RegionID: 11000019, SystemID: 31001528, SystemName: "J121623", WormholeClass: 4},
+	31001529: {RegionID: 11000019, SystemID: 31001529, SystemName: "J150637", WormholeClass: 4},
+	31001530: {RegionID: 11000019, SystemID: 31001530, SystemName: "J130332", WormholeClass: 4},
+	31001531: {RegionID: 11000019, SystemID: 31001531, SystemName: "J1226-0", WormholeClass: 4},
+	31001532: {RegionID: 11000019, SystemID: 31001532, SystemName: "J114355", WormholeClass: 4},
+	31001533: {RegionID: 11000019, SystemID: 31001533, SystemName: "J144401", WormholeClass: 4},
+	31001534: {RegionID: 11000019, SystemID: 31001534, SystemName: "J103242", WormholeClass: 4},
+	31001535: {RegionID: 11000019, SystemID: 31001535, SystemName: "J161815", WormholeClass: 4},
+	31001536: {RegionID: 11000019, SystemID: 31001536, SystemName: "J132256", WormholeClass: 4},
+	31001537: {RegionID: 11000019, SystemID: 31001537, SystemName: "J162819", WormholeClass: 4},
+	31001538: {RegionID: 11000019, SystemID: 31001538, SystemName: "J131004", WormholeClass: 4},
+	31001539: {RegionID: 11000019, SystemID: 31001539, SystemName: "J141931", WormholeClass: 4},
+	31001540: {RegionID: 11000019, SystemID: 31001540, SystemName: "J112630", WormholeClass: 4},
+	31001541: {RegionID: 11000019, SystemID: 31001541, SystemName: "J154109", WormholeClass: 4},
+	31001542: {RegionID: 11000019, SystemID: 31001542, SystemName: "J153116", WormholeClass: 4},
+	31001543: {RegionID: 11000019, SystemID: 31001543, SystemName: "J160455", WormholeClass: 4},
+	31001544: {RegionID: 11000019, SystemID: 31001544, SystemName: "J102439", WormholeClass: 4},
+	31001545: {RegionID: 11000019, SystemID: 31001545, SystemName: "J160459", WormholeClass: 4},
+	31001546: {RegionID: 11000019, SystemID: 31001546, SystemName: "J131712", WormholeClass: 4},
+	31001547: {RegionID: 11000019, SystemID: 31001547, SystemName: "J140244", WormholeClass: 4},
+	31001548: {RegionID: 11000019, SystemID: 31001548, SystemName: "J150635", WormholeClass: 4},
+	31001549: {RegionID: 11000019, SystemID: 31001549, SystemName: "J125213", WormholeClass: 4},
+	31001550: {RegionID: 11000019, SystemID: 31001550, SystemName: "J154541", WormholeClass: 4},
+	31001551: {RegionID: 11000019, SystemID: 31001551, SystemName: "J120409", WormholeClass: 4},
+	31001552: {RegionID: 11000019, SystemID: 31001552, SystemName: "J140545", WormholeClass: 4},
+	31001553: {RegionID: 11000019, SystemID: 31001553, SystemName: "J162251", WormholeClass: 4},
+	31001554: {RegionID: 11000019, SystemID: 31001554, SystemName: "J120450", WormholeClass: 4},
+	31001555: {RegionID: 11000019, SystemID: 31001555, SystemName: "J220151", WormholeClass: 4},
+	31001556: {RegionID: 11000019, SystemID: 31001556, SystemName: "J104502", WormholeClass: 4},
+	31001557: {RegionID: 11000019, SystemID: 31001557, SystemName: "J152654", WormholeClass: 4},
+	31001558: {RegionID: 11000019, SystemID: 31001558, SystemName: "J124727", WormholeClass: 4},
+	31001559: {RegionID: 11000019, SystemID: 31001559, SystemName: "J151940", WormholeClass: 4},
+	31001560: {RegionID: 11000019, SystemID: 31001560, SystemName: "J212129", WormholeClass: 4},
+	31001561: {RegionID: 11000019, SystemID: 31001561, SystemName: "J141633", WormholeClass: 4},
+	31001562: {RegionID: 11000019, SystemID: 31001562, SystemName: "J145604", WormholeClass: 4},
+	31001563: {RegionID: 11000019, SystemID: 31001563, SystemName: "J212851", WormholeClass: 4},
+	31001564: {RegionID: 11000019, SystemID: 31001564, SystemName: "J145659", WormholeClass: 4},
+	31001565: {RegionID: 11000019, SystemID: 31001565, SystemName: "J165719", WormholeClass: 4},
+	31001566: {RegionID: 11000019, SystemID: 31001566, SystemName: "J154706", WormholeClass: 4},
+	31001567: {RegionID: 11000019, SystemID: 31001567, SystemName: "J141252", WormholeClass: 4},
+	31001568: {RegionID: 11000019, SystemID: 31001568, SystemName: "J112110", WormholeClass: 4},
+	31001569: {RegionID: 11000019, SystemID: 31001569, SystemName: "J140912", WormholeClass: 4},
+	31001570: {RegionID: 11000019, SystemID: 31001570, SystemName: "J165532", WormholeClass: 4},
+	31001571: {RegionID: 11000019, SystemID: 31001571, SystemName: "J161037", WormholeClass: 4},
+	31001572: {RegionID: 11000019, SystemID: 31001572, SystemName: "J130828", WormholeClass: 4},
+	31001573: {RegionID: 11000019, SystemID: 31001573, SystemName: "J122934", WormholeClass: 4},
+	31001574: {RegionID: 11000019, SystemID: 31001574, SystemName: "J163435", WormholeClass: 4},
+	31001575: {RegionID: 11000019, SystemID: 31001575, SystemName: "J221855", WormholeClass: 4},
+	31001576: {RegionID: 11000019, SystemID: 31001576, SystemName: "J124109", WormholeClass: 4},
+	31001577: {RegionID: 11000019, SystemID: 31001577, SystemName: "J123458", WormholeClass: 4},
+	31001578: {RegionID: 11000019, SystemID: 31001578, SystemName: "J134527", WormholeClass: 4},
+	31001579: {RegionID: 11000019, SystemID: 31001579, SystemName: "J133157", WormholeClass: 4},
+	31001580: {RegionID: 11000019, SystemID: 31001580, SystemName: "J141342", WormholeClass: 4},
+	31001581: {RegionID: 11000019, SystemID: 31001581, SystemName: "J145757", WormholeClass: 4},
+	31001582: {RegionID: 11000019, SystemID: 31001582, SystemName: "J130343", WormholeClass: 4},
+	31001583: {RegionID: 11000019, SystemID: 31001583, SystemName: "J152628", WormholeClass: 4},
+	31001584: {RegionID: 11000019, SystemID: 31001584, SystemName: "J112928", WormholeClass: 4},
+	31001585: {RegionID: 11000019, SystemID: 31001585, SystemName: "J133358", WormholeClass: 4},
+	31001586: {RegionID: 11000019, SystemID: 31001586, SystemName: "J102844", WormholeClass: 4},
+	31001587: {RegionID: 11000019, SystemID: 31001587, SystemName: "J134732", WormholeClass: 4},
+	31001588: {RegionID: 11000019, SystemID: 31001588, SystemName: "J213615", WormholeClass: 4},
+	31001589: {RegionID: 11000019, SystemID: 31001589, SystemName: "J131037", WormholeClass: 4},
+	31001590: {RegionID: 11000019, SystemID: 31001590, SystemName: "J154724", WormholeClass: 4},
+	31001591: {RegionID: 11000019, SystemID: 31001591, SystemName: "J213139", WormholeClass: 4},
+	31001592: {RegionID: 11000019, SystemID: 31001592, SystemName: "J103104", WormholeClass: 4},
+	31001593: {RegionID: 11000019, SystemID: 31001593, SystemName: "J121116", WormholeClass: 4},
+	31001594: {RegionID: 11000019, SystemID: 31001594, SystemName: "J142822", WormholeClass: 4},
+	31001595: {RegionID: 11000019, SystemID: 31001595, SystemName: "J102521", WormholeClass: 4},
+	31001596: {RegionID: 11000019, SystemID: 31001596, SystemName: "J215935", WormholeClass: 4},
+	31001597: {RegionID: 11000019, SystemID: 31001597, SystemName: "J162303", WormholeClass: 4},
+	31001598: {RegionID: 11000019, SystemID: 31001598, SystemName: "J102409", WormholeClass: 4},
+	31001599: {RegionID: 11000019, SystemID: 31001599, SystemName: "J104714", WormholeClass: 4},
+	31001600: {RegionID: 11000020, SystemID: 31001600, SystemName: "J171225", WormholeClass: 4},
+	31001601: {RegionID: 11000020, SystemID: 31001601, SystemName: "J124306", WormholeClass: 4},
+	31001602: {RegionID: 11000020, SystemID: 31001602, SystemName: "J135908", WormholeClass: 4},
+	31001603: {RegionID: 11000020, SystemID: 31001603, SystemName: "J124930", WormholeClass: 4},
+	31001604: {RegionID: 11000020, SystemID: 31001604, SystemName: "J162632", WormholeClass: 4},
+	31001605: {RegionID: 11000020, SystemID: 31001605, SystemName: "J155313", WormholeClass: 4},
+	31001606: {RegionID: 11000020, SystemID: 31001606, SystemName: "J211328", WormholeClass: 4},
+	31001607: {RegionID: 11000020, SystemID: 31001607, SystemName: "J204506", WormholeClass: 4},
+	31001608: {RegionID: 11000020, SystemID: 31001608, SystemName: "J133957", WormholeClass: 4},
+	31001609: {RegionID: 11000020, SystemID: 31001609, SystemName: "J115304", WormholeClass: 4},
+	31001610: {RegionID: 11000020, SystemID: 31001610, SystemName: "J112150", WormholeClass: 4},
+	31001611: {RegionID: 11000020, SystemID: 31001611, SystemName: "J131252", WormholeClass: 4},
+	31001612: {RegionID: 11000020, SystemID: 31001612, SystemName: "J133936", WormholeClass: 4},
+	31001613: {RegionID: 11000020, SystemID: 31001613, SystemName: "J143140", WormholeClass: 4},
+	31001614: {RegionID: 11000020, SystemID: 31001614, SystemName: "J154833", WormholeClass: 4},
+	31001615: {RegionID: 11000020, SystemID: 31001615, SystemName: "J101331", WormholeClass: 4},
+	31001616: {RegionID: 11000020, SystemID: 31001616, SystemName: "J151359", WormholeClass: 4},
+	31001617: {RegionID: 11000020, SystemID: 31001617, SystemName: "J150951", WormholeClass: 4},
+	31001618: {RegionID: 11000020, SystemID: 31001618, SystemName: "J140215", WormholeClass: 4},
+	31001619: {RegionID: 11000020, SystemID: 31001619, SystemName: "J152421", WormholeClass: 4},
+	31001620: {RegionID: 11000020, SystemID: 31001620, SystemName: "J130554", WormholeClass: 4},
+	31001621: {RegionID: 11000020, SystemID: 31001621, SystemName: "J101604", WormholeClass: 4},
+	31001622: {RegionID: 11000020, SystemID: 31001622, SystemName: "J150320", WormholeClass: 4},
+	31001623: {RegionID: 11000020, SystemID: 31001623, SystemName: "J134414", WormholeClass: 4},
+	31001624: {RegionID: 11000020, SystemID: 31001624, SystemName: "J131113", WormholeClass: 4},
+	31001625: {RegionID: 11000020, SystemID: 31001625, SystemName: "J124933", WormholeClass: 4},
+	31001626: {RegionID: 11000020, SystemID: 31001626, SystemName: "J150044", WormholeClass: 4},
+	31001627: {RegionID: 11000020, SystemID: 31001627, SystemName: "J155200", WormholeClass: 4},
+	31001628: {RegionID: 11000020, SystemID: 31001628, SystemName: "J145944", WormholeClass: 4},
+	31001629: {RegionID: 11000020, SystemID: 31001629, SystemName: "J145535", WormholeClass: 4},
+	31001630: {RegionID: 11000020, SystemID: 31001630, SystemName: "J141021", WormholeClass: 4},
+	31001631: {RegionID: 11000020, SystemID: 31001631, SystemName: "J223312", WormholeClass: 4},
+	31001632: {RegionID: 11000020, SystemID: 31001632, SystemName: "J212028", WormholeClass: 4},
+	31001633: {RegionID: 11000020, SystemID: 31001633, SystemName: "J164835", WormholeClass: 4},
+	31001634: {RegionID: 11000020, SystemID: 31001634, SystemName: "J115528", WormholeClass: 4},
+	31001635: {RegionID: 11000020, SystemID: 31001635, SystemName: "J143922", WormholeClass: 4},
+	31001636: {RegionID: 11000020, SystemID: 31001636, SystemName: "J225234", WormholeClass: 4},
+	31001637: {RegionID: 11000020, SystemID: 31001637, SystemName: "J165645", WormholeClass: 4},
+	31001638: {RegionID: 11000020, SystemID: 31001638, SystemName: "J100142", WormholeClass: 4},
+	31001639: {RegionID: 11000020, SystemID: 31001639, SystemName: "J101129", WormholeClass: 4},
+	31001640: {RegionID: 11000020, SystemID: 31001640, SystemName: "J141220", WormholeClass: 4},
+	31001641: {RegionID: 11000020, SystemID: 31001641, SystemName: "J112450", WormholeClass: 4},
+	31001642: {RegionID: 11000020, SystemID: 31001642, SystemName: "J121558", WormholeClass: 4},
+	31001643: {RegionID: 11000020, SystemID: 31001643, SystemName: "J104404", WormholeClass: 4},
+	31001644: {RegionID: 11000020, SystemID: 31001644, SystemName: "J152633", WormholeClass: 4},
+	31001645: {RegionID: 11000020, SystemID: 31001645, SystemName: "J104624", WormholeClass: 4},
+	31001646: {RegionID: 11000020, SystemID: 31001646, SystemName: "J113227", WormholeClass: 4},
+	31001647: {RegionID: 11000020, SystemID: 31001647, SystemName: "J211817", WormholeClass: 4},
+	31001648: {RegionID: 11000020, SystemID: 31001648, SystemName: "J144329", WormholeClass: 4},
+	31001649: {RegionID: 11000020, SystemID: 31001649, SystemName: "J154102", WormholeClass: 4},
+	31001650: {RegionID: 11000021, SystemID: 31001650, SystemName: "J162231", WormholeClass: 4},
+	31001651: {RegionID: 11000021, SystemID: 31001651, SystemName: "J151303", WormholeClass: 4},
+	31001652: {RegionID: 11000021, SystemID: 31001652, SystemName: "J145848", WormholeClass: 4},
+	31001653: {RegionID: 11000021, SystemID: 31001653, SystemName: "J152720", WormholeClass: 4},
+	31001654: {RegionID: 11000021, SystemID: 31001654, SystemName: "J123753", WormholeClass: 4},
+	31001655: {RegionID: 11000021, SystemID: 31001655, SystemName: "J101042", WormholeClass: 4},
+	31001656: {RegionID: 11000021, SystemID: 31001656, SystemName: "J223824", WormholeClass: 4},
+	31001657: {RegionID: 11000021, SystemID: 31001657, SystemName: "J125929", WormholeClass: 4},
+	31001658: {RegionID: 11000021, SystemID: 31001658, SystemName: "J225111", WormholeClass: 4},
+	31001659: {RegionID: 11000021, SystemID: 31001659, SystemName: "J135346", WormholeClass: 4},
+	31001660: {RegionID: 11000021, SystemID: 31001660, SystemName: "J144120", WormholeClass: 4},
+	31001661: {RegionID: 11000021, SystemID: 31001661, SystemName: "J215743", WormholeClass: 4},
+	31001662: {RegionID: 11000021, SystemID: 31001662, SystemName: "J111007", WormholeClass: 4},
+	31001663: {RegionID: 11000021, SystemID: 31001663, SystemName: "J231710", WormholeClass: 4},
+	31001664: {RegionID: 11000021, SystemID: 31001664, SystemName: "J141001", WormholeClass: 4},
+	31001665: {RegionID: 11000021, SystemID: 31001665, SystemName: "J163444", WormholeClass: 4},
+	31001666: {RegionID: 11000021, SystemID: 31001666, SystemName: "J103141", WormholeClass: 4},
+	31001667: {RegionID: 11000021, SystemID: 31001667, SystemName: "J112215", WormholeClass: 4},
+	31001668: {RegionID: 11000021, SystemID: 31001668, SystemName: "J215758", WormholeClass: 4},
+	31001669: {RegionID: 11000021, SystemID: 31001669, SystemName: "J101145", WormholeClass: 4},
+	31001670: {RegionID: 11000021, SystemID: 31001670, SystemName: "J212838", WormholeClass: 4},
+	31001671: {RegionID: 11000021, SystemID: 31001671, SystemName: "J111458", WormholeClass: 4},
+	31001672: {RegionID: 11000021, SystemID: 31001672, SystemName: "J115554", WormholeClass: 4},
+	31001673: {RegionID: 11000021, SystemID: 31001673, SystemName: "J170445", WormholeClass: 4},
+	31001674: {RegionID: 11000021, SystemID: 31001674, SystemName: "J162831", WormholeClass: 4},
+	31001675: {RegionID: 11000021, SystemID: 31001675, SystemName: "J124236", WormholeClass: 4},
+	31001676: {RegionID: 11000021, SystemID: 31001676, SystemName: "J101535", WormholeClass: 4},
+	31001677: {RegionID: 11000021, SystemID: 31001677, SystemName: "J113551", WormholeClass: 4},
+	31001678: {RegionID: 11000021, SystemID: 31001678, SystemName: "J162656", WormholeClass: 4},
+	31001679: {RegionID: 11000021, SystemID: 31001679, SystemName: "J114530", WormholeClass: 4},
+	31001680: {RegionID: 11000021, SystemID: 31001680, SystemName: "J155214", WormholeClass: 4},
+	31001681: {RegionID: 11000021, SystemID: 31001681, SystemName: "J102336", WormholeClass: 4},
+	31001682: {RegionID: 11000021, SystemID: 31001682, SystemName: "J123352", WormholeClass: 4},
+	31001683: {RegionID: 11000021, SystemID: 31001683, SystemName: "J100937", WormholeClass: 4},
+	31001684: {RegionID: 11000021, SystemID: 31001684, SystemName: "J100107", WormholeClass: 4},
+	31001685: {RegionID: 11000021, SystemID: 31001685, SystemName: "J204230", WormholeClass: 4},
+	31001686: {RegionID: 11000021, SystemID: 31001686, SystemName: "J233909", WormholeClass: 4},
+	31001687: {RegionID: 11000021, SystemID: 31001687, SystemName: "J134354", WormholeClass: 4},
+	31001688: {RegionID: 11000021, SystemID: 31001688, SystemName: "J160046", WormholeClass: 4},
+	31001689: {RegionID: 11000021, SystemID: 31001689, SystemName: "J162816", WormholeClass: 4},
+	31001690: {RegionID: 11000021, SystemID: 31001690, SystemName: "J170305", WormholeClass: 4},
+	31001691: {RegionID: 11000021, SystemID: 31001691, SystemName: "J102045", WormholeClass: 4},
+	31001692: {RegionID: 11000021, SystemID: 31001692, SystemName: "J100830", WormholeClass: 4},
+	31001693: {RegionID: 11000021, SystemID: 31001693, SystemName: "J115658", WormholeClass: 4},
+	31001694: {RegionID: 11000021, SystemID: 31001694, SystemName: "J123947", WormholeClass: 4},
+	31001695: {RegionID: 11000021, SystemID: 31001695, SystemName: "J224754", WormholeClass: 4},
+	31001696: {RegionID: 11000021, SystemID: 31001696, SystemName: "J140246", WormholeClass: 4},
+	31001697: {RegionID: 11000021, SystemID: 31001697, SystemName: "J214440", WormholeClass: 4},
+	31001698: {RegionID: 11000021, SystemID: 31001698, SystemName: "J144135", WormholeClass: 4},
+	31001699: {RegionID: 11000021, SystemID: 31001699, SystemName: "J151021", WormholeClass: 4},
+	31001700: {RegionID: 11000021, SystemID: 31001700, SystemName: "J114133", WormholeClass: 4},
+	31001701: {RegionID: 11000021, SystemID: 31001701, SystemName: "J130810", WormholeClass: 4},
+	31001702: {RegionID: 11000021, SystemID: 31001702, SystemName: "J141425", WormholeClass: 4},
+	31001703: {RegionID: 11000021, SystemID: 31001703, SystemName: "J121847", WormholeClass: 4},
+	31001704: {RegionID: 11000021, SystemID: 31001704, SystemName: "J150805", WormholeClass: 4},
+	31001705: {RegionID: 11000021, SystemID: 31001705, SystemName: "J140810", WormholeClass: 4},
+	31001706: {RegionID: 11000021, SystemID: 31001706, SystemName: "J141104", WormholeClass: 4},
+	31001707: {RegionID: 11000021, SystemID: 31001707, SystemName: "J114735", WormholeClass: 4},
+	31001708: {RegionID: 11000021, SystemID: 31001708, SystemName: "J205922", WormholeClass: 4},
+	31001709: {RegionID: 11000021, SystemID: 31001709, SystemName: "J215101", WormholeClass: 4},
+	31001710: {RegionID: 11000021, SystemID: 31001710, SystemName: "J144131", WormholeClass: 4},
+	31001711: {RegionID: 11000021, SystemID: 31001711, SystemName: "J213429", WormholeClass: 4},
+	31001712: {RegionID: 11000021, SystemID: 31001712, SystemName: "J121006", WormholeClass: 4},
+	31001713: {RegionID: 11000021, SystemID: 31001713, SystemName: "J111644", WormholeClass: 4},
+	31001714: {RegionID: 11000021, SystemID: 31001714, SystemName: "J164031", WormholeClass: 4},
+	31001715: {RegionID: 11000021, SystemID: 31001715, SystemName: "J155249", WormholeClass: 4},
+	31001716: {RegionID: 11000021, SystemID: 31001716, SystemName: "J103228", WormholeClass: 4},
+	31001717: {RegionID: 11000021, SystemID: 31001717, SystemName: "J164025", WormholeClass: 4},
+	31001718: {RegionID: 11000021, SystemID: 31001718, SystemName: "J163217", WormholeClass: 4},
+	31001719: {RegionID: 11000021, SystemID: 31001719, SystemName: "J103631", WormholeClass: 4},
+	31001720: {RegionID: 11000021, SystemID: 31001720, SystemName: "J145739", WormholeClass: 4},
+	31001721: {RegionID: 11000021, SystemID: 31001721, SystemName: "J114408", WormholeClass: 4},
+	31001722: {RegionID: 11000021, SystemID: 31001722, SystemName: "J131704", WormholeClass: 4},
+	31001723: {RegionID: 11000021, SystemID: 31001723, SystemName: "J112404", WormholeClass: 4},
+	31001724: {RegionID: 11000021, SystemID: 31001724, SystemName: "J104723", WormholeClass: 4},
+	31001725: {RegionID: 11000021, SystemID: 31001725, SystemName: "J123746", WormholeClass: 4},
+	31001726: {RegionID: 11000021, SystemID: 31001726, SystemName: "J225441", WormholeClass: 4},
+	31001727: {RegionID: 11000021, SystemID: 31001727, SystemName: "J111629", WormholeClass: 4},
+	31001728: {RegionID: 11000021, SystemID: 31001728, SystemName: "J140154", WormholeClass: 4},
+	31001729: {RegionID: 11000021, SystemID: 31001729, SystemName: "J144743", WormholeClass: 4},
+	31001730: {RegionID: 11000021, SystemID: 31001730, SystemName: "J210247", WormholeClass: 4},
+	31001731: {RegionID: 11000021, SystemID: 31001731, SystemName: "J133013", WormholeClass: 4},
+	31001732: {RegionID: 11000021, SystemID: 31001732, SystemName: "J160032", WormholeClass: 4},
+	31001733: {RegionID: 11000021, SystemID: 31001733, SystemName: "J111009", WormholeClass: 4},
+	31001734: {RegionID: 11000021, SystemID: 31001734, SystemName: "J142117", WormholeClass: 4},
+	31001735: {RegionID: 11000021, SystemID: 31001735, SystemName: "J110417", WormholeClass: 4},
+	31001736: {RegionID: 11000021, SystemID: 31001736, SystemName: "J204853", WormholeClass: 4},
+	31001737: {RegionID: 11000021, SystemID: 31001737, SystemName: "J132144", WormholeClass: 4},
+	31001738: {RegionID: 11000021, SystemID: 31001738, SystemName: "J160016", WormholeClass: 4},
+	31001739: {RegionID: 11000021, SystemID: 31001739, SystemName: "J142335", WormholeClass: 4},
+	31001740: {RegionID: 11000021, SystemID: 31001740, SystemName: "J105835", WormholeClass: 4},
+	31001741: {RegionID: 11000021, SystemID: 31001741, SystemName: "J102055", WormholeClass: 4},
+	31001742: {RegionID: 11000021, SystemID: 31001742, SystemName: "J140932", WormholeClass: 4},
+	31001743: {RegionID: 11000021, SystemID: 31001743, SystemName: "J134333", WormholeClass: 4},
+	31001744: {RegionID: 11000021, SystemID: 31001744, SystemName: "J160156", WormholeClass: 4},
+	31001745: {RegionID: 11000021, SystemID: 31001745, SystemName: "J150944", WormholeClass: 4},
+	31001746: {RegionID: 11000021, SystemID: 31001746, SystemName: "J113508", WormholeClass: 4},
+	31001747: {RegionID: 11000021, SystemID: 31001747, SystemName: "J151757", WormholeClass: 4},
+	31001748: {RegionID: 11000021, SystemID: 31001748, SystemName: "J142800", WormholeClass: 4},
+	31001749: {RegionID: 11000021, SystemID: 31001749, SystemName: "J170642", WormholeClass: 4},
+	31001750: {RegionID: 11000021, SystemID: 31001750, SystemName: "J130451", WormholeClass: 4},
+	31001751: {RegionID: 11000021, SystemID: 31001751, SystemName: "J113158", WormholeClass: 4},
+	31001752: {RegionID: 11000021, SystemID: 31001752, SystemName: "J225133", WormholeClass: 4},
+	31001753: {RegionID: 11000021, SystemID: 31001753, SystemName: "J100001", WormholeClass: 4},
+	31001754: {RegionID: 11000021, SystemID: 31001754, SystemName: "J155831", WormholeClass: 4},
+	31001755: {RegionID: 11000021, SystemID: 31001755, SystemName: "J145749", WormholeClass: 4},
+	31001756: {RegionID: 11000021, SystemID: 31001756, SystemName: "J164659", WormholeClass: 4},
+	31001757: {RegionID: 11000021, SystemID: 31001757, SystemName: "J135543", WormholeClass: 4},
+	31001758: {RegionID: 11000021, SystemID: 31001758, SystemName: "J132735", WormholeClass: 4},
+	31001759: {RegionID: 11000021, SystemID: 31001759, SystemName: "J141740", WormholeClass: 4},
+	31001760: {RegionID: 11000021, SystemID: 31001760, SystemName: "J101315", WormholeClass: 4},
+	31001761: {RegionID: 11000021, SystemID: 31001761, SystemName: "J123345", WormholeClass: 4},
+	31001762: {RegionID: 11000021, SystemID: 31001762, SystemName: "J105621", WormholeClass: 4},
+	31001763: {RegionID: 11000021, SystemID: 31001763, SystemName: "J113813", WormholeClass: 4},
+	31001764: {RegionID: 11000021, SystemID: 31001764, SystemName: "J135807", WormholeClass: 4},
+	31001765: {RegionID: 11000022, SystemID: 31001765, SystemName: "J122728", WormholeClass: 4},
+	31001766: {RegionID: 11000022, SystemID: 31001766, SystemName: "J110915", WormholeClass: 4},
+	31001767: {RegionID: 11000022, SystemID: 31001767, SystemName: "J171430", WormholeClass: 4},
+	31001768: {RegionID: 11000022, SystemID: 31001768, SystemName: "J154321", WormholeClass: 4},
+	31001769: {RegionID: 11000022, SystemID: 31001769, SystemName: "J104729", WormholeClass: 4},
+	31001770: {RegionID: 11000022, SystemID: 31001770, SystemName: "J111753", WormholeClass: 4},
+	31001771: {RegionID: 11000022, SystemID: 31001771, SystemName: "J140823", WormholeClass: 4},
+	31001772: {RegionID: 11000022, SystemID: 31001772, SystemName: "J161357", WormholeClass: 4},
+	31001773: {RegionID: 11000022, SystemID: 31001773, SystemName: "J162205", WormholeClass: 4},
+	31001774: {RegionID: 11000022, SystemID: 31001774, SystemName: "J113950", WormholeClass: 4},
+	31001775: {RegionID: 11000022, SystemID: 31001775, SystemName: "J124224", WormholeClass: 4},
+	31001776: {RegionID: 11000022, SystemID: 31001776, SystemName: "J155959", WormholeClass: 4},
+	31001777: {RegionID: 11000022, SystemID: 31001777, SystemName: "J103213", WormholeClass: 4},
+	31001778: {RegionID: 11000022, SystemID: 31001778, SystemName: "J105858", WormholeClass: 4},
+	31001779: {RegionID: 11000022, SystemID: 31001779, SystemName: "J172502", WormholeClass: 4},
+	31001780: {RegionID: 11000022, SystemID: 31001780, SystemName: "J170151", WormholeClass: 4},
+	31001781: {RegionID: 11000022, SystemID: 31001781, SystemName: "J165058", WormholeClass: 4},
+	31001782: {RegionID: 11000022, SystemID: 31001782, SystemName: "J173638", WormholeClass: 4},
+	31001783: {RegionID: 11000022, SystemID: 31001783, SystemName: "J130330", WormholeClass: 4},
+	31001784: {RegionID: 11000022, SystemID: 31001784, SystemName: "J132740", WormholeClass: 4},
+	31001785: {RegionID: 11000022, SystemID: 31001785, SystemName: "J133931", WormholeClass: 4},
+	31001786: {RegionID: 11000022, SystemID: 31001786, SystemName: "J143902", WormholeClass: 4},
+	31001787: {RegionID: 11000022, SystemID: 31001787, SystemName: "J165105", WormholeClass: 4},
+	31001788: {RegionID: 11000022, SystemID: 31001788, SystemName: "J141316", WormholeClass: 4},
+	31001789: {RegionID: 11000022, SystemID: 31001789, SystemName: "J113152", WormholeClass: 4},
+	31001790: {RegionID: 11000022, SystemID: 31001790, SystemName: "J113453", WormholeClass: 4},
+	31001791: {RegionID: 11000022, SystemID: 31001791, SystemName: "J144845", WormholeClass: 4},
+	31001792: {RegionID: 11000022, SystemID: 31001792, SystemName: "J140053", WormholeClass: 4},
+	31001793: {RegionID: 11000022, SystemID: 31001793, SystemName: "J151416", WormholeClass: 4},
+	31001794: {RegionID: 11000022, SystemID: 31001794, SystemName: "J100846", WormholeClass: 4},
+	31001795: {RegionID: 11000022, SystemID: 31001795, SystemName: "J165943", WormholeClass: 4},
+	31001796: {RegionID: 11000022, SystemID: 31001796, SystemName: "J103538", WormholeClass: 4},
+	31001797: {RegionID: 11000022, SystemID: 31001797, SystemName: "J154854", WormholeClass: 4},
+	31001798: {RegionID: 11000022, SystemID: 31001798, SystemName: "J212627", WormholeClass: 4},
+	31001799: {RegionID: 11000022, SystemID: 31001799, SystemName: "J150656", WormholeClass: 4},
+	31001800: {RegionID: 11000022, SystemID: 31001800, SystemName: "J224031", WormholeClass: 4},
+	31001801: {RegionID: 11000022, SystemID: 31001801, SystemName: "J215455", WormholeClass: 4},
+	31001802: {RegionID: 11000022, SystemID: 31001802, SystemName: "J122503", WormholeClass: 4},
+	31001803: {RegionID: 11000022, SystemID: 31001803, SystemName: "J103120", WormholeClass: 4},
+	31001804: {RegionID: 11000022, SystemID: 31001804, SystemName: "J164528", WormholeClass: 4},
+	31001805: {RegionID: 11000022, SystemID: 31001805, SystemName: "J125316", WormholeClass: 4},
+	31001806: {RegionID: 11000022, SystemID: 31001806, SystemName: "J113131", WormholeClass: 4},
+	31001807: {RegionID: 11000022, SystemID: 31001807, SystemName: "J144038", WormholeClass: 4},
+	31001808: {RegionID: 11000022, SystemID: 31001808, SystemName: "J172926", WormholeClass: 4},
+	31001809: {RegionID: 11000022, SystemID: 31001809, SystemName: "J154610", WormholeClass: 4},
+	31001810: {RegionID: 11000022, SystemID: 31001810, SystemName: "J103412", WormholeClass: 4},
+	31001811: {RegionID: 11000022, SystemID: 31001811, SystemName: "J150318", WormholeClass: 4},
+	31001812: {RegionID: 11000022, SystemID: 31001812, SystemName: "J211504", WormholeClass: 4},
+	31001813: {RegionID: 11000022, SystemID: 31001813, SystemName: "J111617", WormholeClass: 4},
+	31001814: {RegionID: 11000022, SystemID: 31001814, SystemName: "J100346", WormholeClass: 4},
+	31001815: {RegionID: 11000022, SystemID: 31001815, SystemName: "J143557", WormholeClass: 4},
+	31001816: {RegionID: 11000022, SystemID: 31001816, SystemName: "J130001", WormholeClass: 4},
+	31001817: {RegionID: 11000022, SystemID: 31001817, SystemName: "J101142", WormholeClass: 4},
+	31001818: {RegionID: 11000022, SystemID: 31001818, SystemName: "J155002", WormholeClass: 4},
+	31001819: {RegionID: 11000022, SystemID: 31001819, SystemName: "J154232", WormholeClass: 4},
+	31001820: {RegionID: 11000022, SystemID: 31001820, SystemName: "J142506", WormholeClass: 4},
+	31001821: {RegionID: 11000022, SystemID: 31001821, SystemName: "J155506", WormholeClass: 4},
+	31001822: {RegionID: 11000022, SystemID: 31001822, SystemName: "J163754", WormholeClass: 4},
+	31001823: {RegionID: 11000022, SystemID: 31001823, SystemName: "J100357", WormholeClass: 4},
+	31001824: {RegionID: 11000022, SystemID: 31001824, SystemName: "J115031", WormholeClass: 4},
+	31001825: {RegionID: 11000022, SystemID: 31001825, SystemName: "J122706", WormholeClass: 4},
+	31001826: {RegionID: 11000022, SystemID: 31001826, SystemName: "J114318", WormholeClass: 4},
+	31001827: {RegionID: 11000022, SystemID: 31001827, SystemName: "J150625", WormholeClass: 4},
+	31001828: {RegionID: 11000022, SystemID: 31001828, SystemName: "J113437", WormholeClass: 4},
+	31001829: {RegionID: 11000022, SystemID: 31001829, SystemName: "J213924", WormholeClass: 4},
+	31001830: {RegionID: 11000022, SystemID: 31001830, SystemName: "J152711", WormholeClass: 4},
+	31001831: {RegionID: 11000022, SystemID: 31001831, SystemName: "J160929", WormholeClass: 4},
+	31001832: {RegionID: 11000022, SystemID: 31001832, SystemName: "J153110", WormholeClass: 4},
+	31001833: {RegionID: 11000022, SystemID: 31001833, SystemName: "J132532", WormholeClass: 4},
+	31001834: {RegionID: 11000022, SystemID: 31001834, SystemName: "J114031", WormholeClass: 4},
+	31001835: {RegionID: 11000022, SystemID: 31001835, SystemName: "J120316", WormholeClass: 4},
+	31001836: {RegionID: 11000022, SystemID: 31001836, SystemName: "J151610", WormholeClass: 4},
+	31001837: {RegionID: 11000022, SystemID: 31001837, SystemName: "J145937", WormholeClass: 4},
+	31001838: {RegionID: 11000022, SystemID: 31001838, SystemName: "J122056", WormholeClass: 4},
+	31001839: {RegionID: 11000022, SystemID: 31001839, SystemName: "J170127", WormholeClass: 4},
+	31001840: {RegionID: 11000022, SystemID: 31001840, SystemName: "J145424", WormholeClass: 4},
+	31001841: {RegionID: 11000022, SystemID: 31001841, SystemName: "J101835", WormholeClass: 4},
+	31001842: {RegionID: 11000022, SystemID: 31001842, SystemName: "J103907", WormholeClass: 4},
+	31001843: {RegionID: 11000022, SystemID: 31001843, SystemName: "J130237", WormholeClass: 4},
+	31001844: {RegionID: 11000022, SystemID: 31001844, SystemName: "J104948", WormholeClass: 4},
+	31001845: {RegionID: 11000022, SystemID: 31001845, SystemName: "J120844", WormholeClass: 4},
+	31001846: {RegionID: 11000022, SystemID: 31001846, SystemName: "J154824", WormholeClass: 4},
+	31001847: {RegionID: 11000022, SystemID: 31001847, SystemName: "J134216", WormholeClass: 4},
+	31001848: {RegionID: 11000022, SystemID: 31001848, SystemName: "J120131", WormholeClass: 4},
+	31001849: {RegionID: 11000022, SystemID: 31001849, SystemName: "J120134", WormholeClass: 4},
+	31001850: {RegionID: 11000022, SystemID: 31001850, SystemName: "J224558", WormholeClass: 4},
+	31001851: {RegionID: 11000022, SystemID: 31001851, SystemName: "J161029", WormholeClass: 4},
+	31001852: {RegionID: 11000023, SystemID: 31001852, SystemName: "J225530", WormholeClass: 4},
+	31001853: {RegionID: 11000023, SystemID: 31001853, SystemName: "J154407", WormholeClass: 4},
+	31001854: {RegionID: 11000023, SystemID: 31001854, SystemName: "J140720", WormholeClass: 4},
+	31001855: {RegionID: 11000023, SystemID: 31001855, SystemName: "J222125", WormholeClass: 4},
+	31001856: {RegionID: 11000023, SystemID: 31001856, SystemName: "J152502", WormholeClass: 4},
+	31001857: {RegionID: 11000023, SystemID: 31001857, SystemName: "J220215", WormholeClass: 4},
+	31001858: {RegionID: 11000023, SystemID: 31001858, SystemName: "J141647", WormholeClass: 4},
+	31001859: {RegionID: 11000023, SystemID: 31001859, SystemName: "J101957", WormholeClass: 4},
+	31001860: {RegionID: 11000023, SystemID: 31001860, SystemName: "J223808", WormholeClass: 4},
+	31001861: {RegionID: 11000023, SystemID: 31001861, SystemName: "J105433", WormholeClass: 4},
+	31001862: {RegionID: 11000023, SystemID: 31001862, SystemName: "J153722", WormholeClass: 4},
+	31001863: {RegionID: 11000023, SystemID: 31001863, SystemName: "J105348", WormholeClass: 4},
+	31001864: {RegionID: 11000023, SystemID: 31001864, SystemName: "J113133", WormholeClass: 4},
+	31001865: {RegionID: 11000023, SystemID: 31001865, SystemName: "J105319", WormholeClass: 4},
+	31001866: {RegionID: 11000023, SystemID: 31001866, SystemName: "J162828", WormholeClass: 4},
+	31001867: {RegionID: 11000023, SystemID: 31001867, SystemName: "J233449", WormholeClass: 4},
+	31001868: {RegionID: 11000023, SystemID: 31001868, SystemName: "J150026", WormholeClass: 4},
+	31001869: {RegionID: 11000023, SystemID: 31001869, SystemName: "J125629", WormholeClass: 4},
+	31001870: {RegionID: 11000023, SystemID: 31001870, SystemName: "J123246", WormholeClass: 4},
+	31001871: {RegionID: 11000023, SystemID: 31001871, SystemName: "J215615", WormholeClass: 4},
+	31001872: {RegionID: 11000023, SystemID: 31001872, SystemName: "J152404", WormholeClass: 4},
+	31001873: {RegionID: 11000023, SystemID: 31001873, SystemName: "J132152", WormholeClass: 4},
+	31001874: {RegionID: 11000023, SystemID: 31001874, SystemName: "J145335", WormholeClass: 4},
+	31001875: {RegionID: 11000023, SystemID: 31001875, SystemName: "J162255", WormholeClass: 4},
+	31001876: {RegionID: 11000023, SystemID: 31001876, SystemName: "J112722", WormholeClass: 4},
+	31001877: {RegionID: 11000023, SystemID: 31001877, SystemName: "J134459", WormholeClass: 4},
+	31001878: {RegionID: 11000023, SystemID: 31001878, SystemName: "J131624", WormholeClass: 4},
+	31001879: {RegionID: 11000023, SystemID: 31001879, SystemName: "J165611", WormholeClass: 4},
+	31001880: {RegionID: 11000024, SystemID: 31001880, SystemName: "J152820", WormholeClass: 5},
+	31001881: {RegionID: 11000024, SystemID: 31001881, SystemName: "J135100", WormholeClass: 5},
+	31001882: {RegionID: 11000024, SystemID: 31001882, SystemName: "J122515", WormholeClass: 5},
+	31001883: {RegionID: 11000024, SystemID: 31001883, SystemName: "J172943", WormholeClass: 5},
+	31001884: {RegionID: 11000024, SystemID: 31001884, SystemName: "J205738", WormholeClass: 5},
+	31001885: {RegionID: 11000024, SystemID: 31001885, SystemName: "J134242", WormholeClass: 5},
+	31001886: {RegionID: 11000024, SystemID: 31001886, SystemName: "J103251", WormholeClass: 5},
+	31001887: {RegionID: 11000024, SystemID: 31001887, SystemName: "J111220", WormholeClass: 5},
+	31001888: {RegionID: 11000024, SystemID: 31001888, SystemName: "J170540", WormholeClass: 5},
+	31001889: {RegionID: 11000024, SystemID: 31001889, SystemName: "J102446", WormholeClass: 5},
+	31001890: {RegionID: 11000024, SystemID: 31001890, SystemName: "J141137", WormholeClass: 5},
+	31001891: {RegionID: 11000024, SystemID: 31001891, SystemName: "J164147", WormholeClass: 5},
+	31001892: {RegionID: 11000024, SystemID: 31001892, SystemName: "J100702", WormholeClass: 5},
+	31001893: {RegionID: 11000024, SystemID: 31001893, SystemName: "J133119", WormholeClass: 5},
+	31001894: {RegionID: 11000024, SystemID: 31001894, SystemName: "J124730", WormholeClass: 5},
+	31001895: {RegionID: 11000024, SystemID: 31001895, SystemName: "J132823", WormholeClass: 5},
+	31001896: {RegionID: 11000024, SystemID: 31001896, SystemName: "J100724", WormholeClass: 5},
+	31001897: {RegionID: 11000024, SystemID: 31001897, SystemName: "J162638", WormholeClass: 5},
+	31001898: {RegionID: 11000024, SystemID: 31001898, SystemName: "J230257", WormholeClass: 5},
+	31001899: {RegionID: 11000024, SystemID: 31001899, SystemName: "J223018", WormholeClass: 5},
+	31001900: {RegionID: 11000024, SystemID: 31001900, SystemName: "J135449", WormholeClass: 5},
+	31001901: {RegionID: 11000024, SystemID: 31001901, SystemName: "J133537", WormholeClass: 5},
+	31001902: {RegionID: 11000024, SystemID: 31001902, SystemName: "J155714", WormholeClass: 5},
+	31001903: {RegionID: 11000024, SystemID: 31001903, SystemName: "J112325", WormholeClass: 5},
+	31001904: {RegionID: 11000024, SystemID: 31001904, SystemName: "J150606", WormholeClass: 5},
+	31001905: {RegionID: 11000024, SystemID: 31001905, SystemName: "J110550", WormholeClass: 5},
+	31001906: {RegionID: 11000024, SystemID: 31001906, SystemName: "J205659", WormholeClass: 5},
+	31001907: {RegionID: 11000024, SystemID: 31001907, SystemName: "J100120", WormholeClass: 5},
+	31001908: {RegionID: 11000024, SystemID: 31001908, SystemName: "J151057", WormholeClass: 5},
+	31001909: {RegionID: 11000024, SystemID: 31001909, SystemName: "J213245", WormholeClass: 5},
+	31001910: {RegionID: 11000024, SystemID: 31001910, SystemName: "J121230", WormholeClass: 5},
+	31001911: {RegionID: 11000024, SystemID: 31001911, SystemName: "J145203", WormholeClass: 5},
+	31001912: {RegionID: 11000024, SystemID: 31001912, SystemName: "J111106", WormholeClass: 5},
+	31001913: {RegionID: 11000024, SystemID: 31001913, SystemName: "J222834", WormholeClass: 5},
+	31001914: {RegionID: 11000024, SystemID: 31001914, SystemName: "J232336", WormholeClass: 5},
+	31001915: {RegionID: 11000024, SystemID: 31001915, SystemName: "J161032", WormholeClass: 5},
+	31001916: {RegionID: 11000024, SystemID: 31001916, SystemName: "J154733", WormholeClass: 5},
+	31001917: {RegionID: 11000024, SystemID: 31001917, SystemName: "J153144", WormholeClass: 5},
+	31001918: {RegionID: 11000024, SystemID: 31001918, SystemName: "J122721", WormholeClass: 5},
+	31001919: {RegionID: 11000024, SystemID: 31001919, SystemName: "J133440", WormholeClass: 5},
+	31001920: {RegionID: 11000024, SystemID: 31001920, SystemName: "J103727", WormholeClass: 5},
+	31001921: {RegionID: 11000024, SystemID: 31001921, SystemName: "J152739", WormholeClass: 5},
+	31001922: {RegionID: 11000024, SystemID: 31001922, SystemName: "J140717", WormholeClass: 5},
+	31001923: {RegionID: 11000024, SystemID: 31001923, SystemName: "J205205", WormholeClass: 5},
+	31001924: {RegionID: 11000024, SystemID: 31001924, SystemName: "J130026", WormholeClass: 5},
+	31001925: {RegionID: 11000024, SystemID: 31001925, SystemName: "J151035", WormholeClass: 5},
+	31001926: {RegionID: 11000024, SystemID: 31001926, SystemName: "J112603", WormholeClass: 5},
+	31001927: {RegionID: 11000024, SystemID: 31001927, SystemName: "J151811", WormholeClass: 5},
+	31001928: {RegionID: 11000024, SystemID: 31001928, SystemName: "J105441", WormholeClass: 5},
+	31001929: {RegionID: 11000024, SystemID: 31001929, SystemName: "J124949", WormholeClass: 5},
+	31001930: {RegionID: 11000024, SystemID: 31001930, SystemName: "J130711", WormholeClass: 5},
+	31001931: {RegionID: 11000024, SystemID: 31001931, SystemName: "J131948", WormholeClass: 5},
+	31001932: {RegionID: 11000024, SystemID: 31001932, SystemName: "J132814", WormholeClass: 5},
+	31001933: {RegionID: 11000024, SystemID: 31001933, SystemName: "J213734", WormholeClass: 5},
+	31001934: {RegionID: 11000024, SystemID: 31001934, SystemName: "J140608", WormholeClass: 5},
+	31001935: {RegionID: 11000024, SystemID: 31001935, SystemName: "J212336", WormholeClass: 5},
+	31001936: {RegionID: 11000024, SystemID: 31001936, SystemName: "J104448", WormholeClass: 5},
+	31001937: {RegionID: 11000024, SystemID: 31001937, SystemName: "J164846", WormholeClass: 5},
+	31001938: {RegionID: 11000024, SystemID: 31001938, SystemName: "J161354", WormholeClass: 5},
+	31001939: {RegionID: 11000024, SystemID: 31001939, SystemName: "J103959", WormholeClass: 5},
+	31001940: {RegionID: 11000024, SystemID: 31001940, SystemName: "J114353", WormholeClass: 5},
+	31001941: {RegionID: 11000024, SystemID: 31001941, SystemName: "J171622", WormholeClass: 5},
+	31001942: {RegionID: 11000024, SystemID: 31001942, SystemName: "J143123", WormholeClass: 5},
+	31001943: {RegionID: 11000024, SystemID: 31001943, SystemName: "J125023", WormholeClass: 5},
+	31001944: {RegionID: 11000024, SystemID: 31001944, SystemName: "J122116", WormholeClass: 5},
+	31001945: {RegionID: 11000024, SystemID: 31001945, SystemName: "J151718", WormholeClass: 5},
+	31001946: {RegionID: 11000024, SystemID: 31001946, SystemName: "J153335", WormholeClass: 5},
+	31001947: {RegionID: 11000024, SystemID: 31001947, SystemName: "J170122", WormholeClass: 5},
+	31001948: {RegionID: 11000024, SystemID: 31001948, SystemName: "J150515", WormholeClass: 5},
+	31001949: {RegionID: 11000024, SystemID: 31001949, SystemName: "J104216", WormholeClass: 5},
+	31001950: {RegionID: 11000024, SystemID: 31001950, SystemName: "J111000", WormholeClass: 5},
+	31001951: {RegionID: 11000024, SystemID: 31001951, SystemName: "J155600", WormholeClass: 5},
+	31001952: {RegionID: 11000024, SystemID: 31001952, SystemName: "J132601", WormholeClass: 5},
+	31001953: {RegionID: 11000024, SystemID: 31001953, SystemName: "J132258", WormholeClass: 5},
+	31001954: {RegionID: 11000024, SystemID: 31001954, SystemName: "J221512", WormholeClass: 5},
+	31001955: {RegionID: 11000024, SystemID: 31001955, SystemName: "J125853", WormholeClass: 5},
+	31001956: {RegionID: 11000024, SystemID: 31001956, SystemName: "J141609", WormholeClass: 5},
+	31001957: {RegionID: 11000024, SystemID: 31001957, SystemName: "J134096", WormholeClass: 5},
+	31001958: {RegionID: 11000024, SystemID: 31001958, SystemName: "J121146", WormholeClass: 5},
+	31001959: {RegionID: 11000024, SystemID: 31001959, SystemName: "J121649", WormholeClass: 5},
+	31001960: {RegionID: 11000024, SystemID: 31001960, SystemName: "J135559", WormholeClass: 5},
+	31001961: {RegionID: 11000024, SystemID: 31001961, SystemName: "J130735", WormholeClass: 5},
+	31001962: {RegionID: 11000024, SystemID: 31001962, SystemName: "J144115", WormholeClass: 5},
+	31001963: {RegionID: 11000024, SystemID: 31001963, SystemName: "J112241", WormholeClass: 5},
+	31001964: {RegionID: 11000024, SystemID: 31001964, SystemName: "J141204", WormholeClass: 5},
+	31001965: {RegionID: 11000024, SystemID: 31001965, SystemName: "J131401", WormholeClass: 5},
+	31001966: {RegionID: 11000024, SystemID: 31001966, SystemName: "J170231", WormholeClass: 5},
+	31001967: {RegionID: 11000024, SystemID: 31001967, SystemName: "J164430", WormholeClass: 5},
+	31001968: {RegionID: 11000025, SystemID: 31001968, SystemName: "J143740", WormholeClass: 5},
+	31001969: {RegionID: 11000025, SystemID: 31001969, SystemName: "J115517", WormholeClass: 5},
+	31001970: {RegionID: 11000025, SystemID: 31001970, SystemName: "J230936", WormholeClass: 5},
+	31001971: {RegionID: 11000025, SystemID: 31001971, SystemName: "J133525", WormholeClass: 5},
+	31001972: {RegionID: 11000025, SystemID: 31001972, SystemName: "J215338", WormholeClass: 5},
+	31001973: {RegionID: 11000025, SystemID: 31001973, SystemName: "J112003", WormholeClass: 5},
+	31001974: {RegionID: 11000025, SystemID: 31001974, SystemName: "J214318", WormholeClass: 5},
+	31001975: {RegionID: 11000025, SystemID: 31001975, SystemName: "J110938", WormholeClass: 5},
+	31001976: {RegionID: 11000025, SystemID: 31001976, SystemName: "J103453", WormholeClass: 5},
+	31001977: {RegionID: 11000025, SystemID: 31001977, SystemName: "J101000", WormholeClass: 5},
+	31001978: {RegionID: 11000025, SystemID: 31001978, SystemName: "J110117", WormholeClass: 5},
+	31001979: {RegionID: 11000025, SystemID: 31001979, SystemName: "J100328", WormholeClass: 5},
+	31001980: {RegionID: 11000025, SystemID: 31001980, SystemName: "J155845", WormholeClass: 5},
+	31001981: {RegionID: 11000025, SystemID: 31001981, SystemName: "J152703", WormholeClass: 5},
+	31001982: {RegionID: 11000025, SystemID: 31001982, SystemName: "J110628", WormholeClass: 5},
+	31001983: {RegionID: 11000025, SystemID: 31001983, SystemName: "J142438", WormholeClass: 5},
+	31001984: {RegionID: 11000025, SystemID: 31001984, SystemName: "J153054", WormholeClass: 5},
+	31001985: {RegionID: 11000025, SystemID: 31001985, SystemName: "J114019", WormholeClass: 5},
+	31001986: {RegionID: 11000025, SystemID: 31001986, SystemName: "J233255", WormholeClass: 5},
+	31001987: {RegionID: 11000025, SystemID: 31001987, SystemName: "J135046", WormholeClass: 5},
+	31001988: {RegionID: 11000025, SystemID: 31001988, SystemName: "J145322", WormholeClass: 5},
+	31001989: {RegionID: 11000025, SystemID: 31001989, SystemName: "J112844", WormholeClass: 5},
+	31001990: {RegionID: 11000025, SystemID: 31001990, SystemName: "J120816", WormholeClass: 5},
+	31001991: {RegionID: 11000025, SystemID: 31001991, SystemName: "J165340", WormholeClass: 5},
+	31001992: {RegionID: 11000025, SystemID: 31001992, SystemName: "J124409", WormholeClass: 5},
+	31001993: {RegionID: 11000025, SystemID: 31001993, SystemName: "J124733", WormholeClass: 5},
+	31001994: {RegionID: 11000025, SystemID: 31001994, SystemName: "J173645", WormholeClass: 5},
+	31001995: {RegionID: 11000025, SystemID: 31001995, SystemName: "J165743", WormholeClass: 5},
+	31001996: {RegionID: 11000025, SystemID: 31001996, SystemName: "J171013", WormholeClass: 5},
+	31001997: {RegionID: 11000025, SystemID: 31001997, SystemName: "J155905", WormholeClass: 5},
+	31001998: {RegionID: 11000025, SystemID: 31001998, SystemName: "J213055", WormholeClass: 5},
+	31001999: {RegionID: 11000025, SystemID: 31001999, SystemName: "J212224", WormholeClass: 5},
+	31002000: {RegionID: 11000025, SystemID: 31002000, SystemName: "J234252", WormholeClass: 5},
+	31002001: {RegionID: 11000025, SystemID: 31002001, SystemName: "J150036", WormholeClass: 5},
+	31002002: {RegionID: 11000025, SystemID: 31002002, SystemName: "J172431", WormholeClass: 5},
+	31002003: {RegionID: 11000025, SystemID: 31002003, SystemName: "J114154", WormholeClass: 5},
+	31002004: {RegionID: 11000025, SystemID: 31002004, SystemName: "J115127", WormholeClass: 5},
+	31002005: {RegionID: 11000025, SystemID: 31002005, SystemName: "J233658", WormholeClass: 5},
+	31002006: {RegionID: 11000025, SystemID: 31002006, SystemName: "J114403", WormholeClass: 5},
+	31002007: {RegionID: 11000025, SystemID: 31002007, SystemName: "J165946", WormholeClass: 5},
+	31002008: {RegionID: 11000025, SystemID: 31002008, SystemName: "J124046", WormholeClass: 5},
+	31002009: {RegionID: 11000025, SystemID: 31002009, SystemName: "J102736", WormholeClass: 5},
+	31002010: {RegionID: 11000025, SystemID: 31002010, SystemName: "J133252", WormholeClass: 5},
+	31002011: {RegionID: 11000025, SystemID: 31002011, SystemName: "J140600", WormholeClass: 5},
+	31002012: {RegionID: 11000025, SystemID: 31002012, SystemName: "J163701", WormholeClass: 5},
+	31002013: {RegionID: 11000025, SystemID: 31002013, SystemName: "J115041", WormholeClass: 5},
+	31002014: {RegionID: 11000025, SystemID: 31002014, SystemName: "J154726", WormholeClass: 5},
+	31002015: {RegionID: 11000025, SystemID: 31002015, SystemName: "J123602", WormholeClass: 5},
+	31002016: {RegionID: 11000025, SystemID: 31002016, SystemName: "J100549", WormholeClass: 5},
+	31002017: {RegionID: 11000025, SystemID: 31002017, SystemName: "J124100", WormholeClass: 5},
+	31002018: {RegionID: 11000025, SystemID: 31002018, SystemName: "J113701", WormholeClass: 5},
+	31002019: {RegionID: 11000025, SystemID: 31002019, SystemName: "J164327", WormholeClass: 5},
+	31002020: {RegionID: 11000025, SystemID: 31002020, SystemName: "J110018", WormholeClass: 5},
+	31002021: {RegionID: 11000025, SystemID: 31002021, SystemName: "J164511", WormholeClass: 5},
+	31002022: {RegionID: 11000025, SystemID: 31002022, SystemName: "J130037", WormholeClass: 5},
+	31002023: {RegionID: 11000025, SystemID: 31002023, SystemName: "J101028", WormholeClass: 5},
+	31002024: {RegionID: 11000025, SystemID: 31002024, SystemName: "J100858", WormholeClass: 5},
+	31002025: {RegionID: 11000025, SystemID: 31002025, SystemName: "J151813", WormholeClass: 5},
+	31002026: {RegionID: 11000025, SystemID: 31002026, SystemName: "J113758", WormholeClass: 5},
+	31002027: {RegionID: 11000025, SystemID: 31002027, SystemName: "J153802", WormholeClass: 5},
+	31002028: {RegionID: 11000025, SystemID: 31002028, SystemName: "J130401", WormholeClass: 5},
+	31002029: {RegionID: 11000025, SystemID: 31002029, SystemName: "J130241", WormholeClass: 5},
+	31002030: {RegionID: 11000025, SystemID: 31002030, SystemName: "J232934", WormholeClass: 5},
+	31002031: {RegionID: 11000025, SystemID: 31002031, SystemName: "J213642", WormholeClass: 5},
+	31002032: {RegionID: 11000025, SystemID: 31002032, SystemName: "J144107", WormholeClass: 5},
+	31002033: {RegionID: 11000025, SystemID: 31002033, SystemName: "J140336", WormholeClass: 5},
+	31002034: {RegionID: 11000025, SystemID: 31002034, SystemName: "J214712", WormholeClass: 5},
+	31002035: {RegionID: 11000025, SystemID: 31002035, SystemName: "J170809", WormholeClass: 5},
+	31002036: {RegionID: 11000025, SystemID: 31002036, SystemName: "J220924", WormholeClass: 5},
+	31002037: {RegionID: 11000025, SystemID: 31002037, SystemName: "J142624", WormholeClass: 5},
+	31002038: {RegionID: 11000025, SystemID: 31002038, SystemName: "J150306", WormholeClass: 5},
+	31002039: {RegionID: 11000025, SystemID: 31002039, SystemName: "J100425", WormholeClass: 5},
+	31002040: {RegionID: 11000025, SystemID: 31002040, SystemName: "J161745", WormholeClass: 5},
+	31002041: {RegionID: 11000025, SystemID: 31002041, SystemName: "J131718", WormholeClass: 5},
+	31002042: {RegionID: 11000025, SystemID: 31002042, SystemName: "J151319", WormholeClass: 5},
+	31002043: {RegionID: 11000025, SystemID: 31002043, SystemName: "J113059", WormholeClass: 5},
+	31002044: {RegionID: 11000025, SystemID: 31002044, SystemName: "J142234", WormholeClass: 5},
+	31002045: {RegionID: 11000025, SystemID: 31002045, SystemName: "J113636", WormholeClass: 5},
+	31002046: {RegionID: 11000025, SystemID: 31002046, SystemName: "J142858", WormholeClass: 5},
+	31002047: {RegionID: 11000025, SystemID: 31002047, SystemName: "J135540", WormholeClass: 5},
+	31002048: {RegionID: 11000025, SystemID: 31002048, SystemName: "J155739", WormholeClass: 5},
+	31002049: {RegionID: 11000025, SystemID: 31002049, SystemName: "J132427", WormholeClass: 5},
+	31002050: {RegionID: 11000025, SystemID: 31002050, SystemName: "J114337", WormholeClass: 5},
+	31002051: {RegionID: 11000025, SystemID: 31002051, SystemName: "J121842", WormholeClass: 5},
+	31002052: {RegionID: 11000025, SystemID: 31002052, SystemName: "J100415", WormholeClass: 5},
+	31002053: {RegionID: 11000025, SystemID: 31002053, SystemName: "J143057", WormholeClass: 5},
+	31002054: {RegionID: 11000025, SystemID: 31002054, SystemName: "J165953", WormholeClass: 5},
+	31002055: {RegionID: 11000025, SystemID: 31002055, SystemName: "J102753", WormholeClass: 5},
+	31002056: {RegionID: 11000025, SystemID: 31002056, SystemName: "J221325", WormholeClass: 5},
+	31002057: {RegionID: 11000025, SystemID: 31002057, SystemName: "J114443", WormholeClass: 5},
+	31002058: {RegionID: 11000025, SystemID: 31002058, SystemName: "J125990", WormholeClass: 5},
+	31002059: {RegionID: 11000025, SystemID: 31002059, SystemName: "J114518", WormholeClass: 5},
+	31002060: {RegionID: 11000025, SystemID: 31002060, SystemName: "J102623", WormholeClass: 5},
+	31002061: {RegionID: 11000026, SystemID: 31002061, SystemName: "J164900", WormholeClass: 5},
+	31002062: {RegionID: 11000026, SystemID: 31002062, SystemName: "J122049", WormholeClass: 5},
+	31002063: {RegionID: 11000026, SystemID: 31002063, SystemName: "J154846", WormholeClass: 5},
+	31002064: {RegionID: 11000026, SystemID: 31002064, SystemName: "J115522", WormholeClass: 5},
+	31002065: {RegionID: 11000026, SystemID: 31002065, SystemName: "J145844", WormholeClass: 5},
+	31002066: {RegionID: 11000026, SystemID: 31002066, SystemName: "J121704", WormholeClass: 5},
+	31002067: {RegionID: 11000026, SystemID: 31002067, SystemName: "J121959", WormholeClass: 5},
+	31002068: {RegionID: 11000026, SystemID: 31002068, SystemName: "J105849", WormholeClass: 5},
+	31002069: {RegionID: 11000026, SystemID: 31002069, SystemName: "J122610", WormholeClass: 5},
+	31002070: {RegionID: 11000026, SystemID: 31002070, SystemName: "J152912", WormholeClass: 5},
+	31002071: {RegionID: 11000026, SystemID: 31002071, SystemName: "J145359", WormholeClass: 5},
+	31002072: {RegionID: 11000026, SystemID: 31002072, SystemName: "J154906", WormholeClass: 5},
+	31002073: {RegionID: 11000026, SystemID: 31002073, SystemName: "J160547", WormholeClass: 5},
+	31002074: {RegionID: 11000026, SystemID: 31002074, SystemName: "J111150", WormholeClass: 5},
+	31002075: {RegionID: 11000026, SystemID: 31002075, SystemName: "J111935", WormholeClass: 5},
+	31002076: {RegionID: 11000026, SystemID: 31002076, SystemName: "J100237", WormholeClass: 5},
+	31002077: {RegionID: 11000026, SystemID: 31002077, SystemName: "J145735", WormholeClass: 5},
+	31002078: {RegionID: 11000026, SystemID: 31002078, SystemName: "J103600", WormholeClass: 5},
+	31002079: {RegionID: 11000026, SystemID: 31002079, SystemName: "J211517", WormholeClass: 5},
+	31002080: {RegionID: 11000026, SystemID: 31002080, SystemName: "J131128", WormholeClass: 5},
+	31002081: {RegionID: 11000026, SystemID: 31002081, SystemName: "J143107", WormholeClass: 5},
+	31002082: {RegionID: 11000026, SystemID: 31002082, SystemName: "J213125", WormholeClass: 5},
+	31002083: {RegionID: 11000026, SystemID: 31002083, SystemName: "J152325", WormholeClass: 5},
+	31002084: {RegionID: 11000026, SystemID: 31002084, SystemName: "J114014", WormholeClass: 5},
+	31002085: {RegionID: 11000026, SystemID: 31002085, SystemName: "J215215", WormholeClass: 5},
+	31002086: {RegionID: 11000026, SystemID: 31002086, SystemName: "J143718", WormholeClass: 5},
+	31002087: {RegionID: 11000026, SystemID: 31002087, SystemName: "J134141", WormholeClass: 5},
+	31002088: {RegionID: 11000026, SystemID: 31002088, SystemName: "J114842", WormholeClass: 5},
+	31002089: {RegionID: 11000026, SystemID: 31002089, SystemName: "J105504", WormholeClass: 5},
+	31002090: {RegionID: 11000026, SystemID: 31002090, SystemName: "J130302", WormholeClass: 5},
+	31002091: {RegionID: 11000026, SystemID: 31002091, SystemName: "J170327", WormholeClass: 5},
+	31002092: {RegionID: 11000026, SystemID: 31002092, SystemName: "J120823", WormholeClass: 5},
+	31002093: {RegionID: 11000026, SystemID: 31002093, SystemName: "J153449", WormholeClass: 5},
+	31002094: {RegionID: 11000026, SystemID: 31002094, SystemName: "J164621", WormholeClass: 5},
+	31002095: {RegionID: 11000026, SystemID: 31002095, SystemName: "J142631", WormholeClass: 5},
+	31002096: {RegionID: 11000026, SystemID: 31002096, SystemName: "J134652", WormholeClass: 5},
+	31002097: {RegionID: 11000026, SystemID: 31002097, SystemName: "J123628", WormholeClass: 5},
+	31002098: {RegionID: 11000026, SystemID: 31002098, SystemName: "J130621", WormholeClass: 5},
+	31002099: {RegionID: 11000026, SystemID: 31002099, SystemName: "J171158", WormholeClass: 5},
+	31002100: {RegionID: 11000026, SystemID: 31002100, SystemName: "J163930", WormholeClass: 5},
+	31002101: {RegionID: 11000026, SystemID: 31002101, SystemName: "J113907", WormholeClass: 5},
+	31002102: {RegionID: 11000026, SystemID: 31002102, SystemName: "J164610", WormholeClass: 5},
+	31002103: {RegionID: 11000026, SystemID: 31002103, SystemName: "J145512", WormholeClass: 5},
+	31002104: {RegionID: 11000026, SystemID: 31002104, SystemName: "J133833", WormholeClass: 5},
+	31002105: {RegionID: 11000026, SystemID: 31002105, SystemName: "J141250", WormholeClass: 5},
+	31002106: {RegionID: 11000026, SystemID: 31002106, SystemName: "J105623", WormholeClass: 5},
+	31002107: {RegionID: 11000026, SystemID: 31002107, SystemName: "J120010", WormholeClass: 5},
+	31002108: {RegionID: 11000026, SystemID: 31002108, SystemName: "J233630", WormholeClass: 5},
+	31002109: {RegionID: 11000026, SystemID: 31002109, SystemName: "J162007", WormholeClass: 5},
+	31002110: {RegionID: 11000026, SystemID: 31002110, SystemName: "J215124", WormholeClass: 5},
+	31002111: {RegionID: 11000026, SystemID: 31002111, SystemName: "J141615", WormholeClass: 5},
+	31002112: {RegionID: 11000026, SystemID: 31002112, SystemName: "J133222", WormholeClass: 5},
+	31002113: {RegionID: 11000026, SystemID: 31002113, SystemName: "J105822", WormholeClass: 5},
+	31002114: {RegionID: 11000026, SystemID: 31002114, SystemName: "J171136", WormholeClass: 5},
+	31002115: {RegionID: 11000026, SystemID: 31002115, SystemName: "J152111", WormholeClass: 5},
+	31002116: {RegionID: 11000026, SystemID: 31002116, SystemName: "J104846", WormholeClass: 5},
+	31002117: {RegionID: 11000026, SystemID: 31002117, SystemName: "J123440", WormholeClass: 5},
+	31002118: {RegionID: 11000026, SystemID: 31002118, SystemName: "J121416", WormholeClass: 5},
+	31002119: {RegionID: 11000026, SystemID: 31002119, SystemName: "J161635", WormholeClass: 5},
+	31002120: {RegionID: 11000026, SystemID: 31002120, SystemName: "J141043", WormholeClass: 5},
+	31002121: {RegionID: 11000026, SystemID: 31002121, SystemName: "J163408", WormholeClass: 5},
+	31002122: {RegionID: 11000026, SystemID: 31002122, SystemName: "J135204", WormholeClass: 5},
+	31002123: {RegionID: 11000026, SystemID: 31002123, SystemName: "J133913", WormholeClass: 5},
+	31002124: {RegionID: 11000026, SystemID: 31002124, SystemName: "J153447", WormholeClass: 5},
+	31002125: {RegionID: 11000026, SystemID: 31002125, SystemName: "J145313", WormholeClass: 5},
+	31002126: {RegionID: 11000027, SystemID: 31002126, SystemName: "J162753", WormholeClass: 5},
+	31002127: {RegionID: 11000027, SystemID: 31002127, SystemName: "J135301", WormholeClass: 5},
+	31002128: {RegionID: 11000027, SystemID: 31002128, SystemName: "J125254", WormholeClass: 5},
+	31002129: {RegionID: 11000027, SystemID: 31002129, SystemName: "J145320", WormholeClass: 5},
+	31002130: {RegionID: 11000027, SystemID: 31002130, SystemName: "J103116", WormholeClass: 5},
+	31002131: {RegionID: 11000027, SystemID: 31002131, SystemName: "J120437", WormholeClass: 5},
+	31002132: {RegionID: 11000027, SystemID: 31002132, SystemName: "J100252", WormholeClass: 5},
+	31002133: {RegionID: 11000027, SystemID: 31002133, SystemName: "J153919", WormholeClass: 5},
+	31002134: {RegionID: 11000027, SystemID: 31002134, SystemName: "J115520", WormholeClass: 5},
+	31002135: {RegionID: 11000027, SystemID: 31002135, SystemName: "J100919", WormholeClass: 5},
+	31002136: {RegionID: 11000027, SystemID: 31002136, SystemName: "J115933", WormholeClass: 5},
+	31002137: {RegionID: 11000027, SystemID: 31002137, SystemName: "J133529", WormholeClass: 5},
+	31002138: {RegionID: 11000027, SystemID: 31002138, SystemName: "J131549", WormholeClass: 5},
+	31002139: {RegionID: 11000027, SystemID: 31002139, SystemName: "J205517", WormholeClass: 5},
+	31002140: {RegionID: 11000027, SystemID: 31002140, SystemName: "J220546", WormholeClass: 5},
+	31002141: {RegionID: 11000027, SystemID: 31002141, SystemName: "J102853", WormholeClass: 5},
+	31002142: {RegionID: 11000027, SystemID: 31002142, SystemName: "J115418", WormholeClass: 5},
+	31002143: {RegionID: 11000027, SystemID: 31002143, SystemName: "J155040", WormholeClass: 5},
+	31002144: {RegionID: 11000027, SystemID: 31002144, SystemName: "J172937", WormholeClass: 5},
+	31002145: {RegionID: 11000027, SystemID: 31002145, SystemName: "J122757", WormholeClass: 5},
+	31002146: {RegionID: 11000027, SystemID: 31002146, SystemName: "J100759", WormholeClass: 5},
+	31002147: {RegionID: 11000027, SystemID: 31002147, SystemName: "J145416", WormholeClass: 5},
+	31002148: {RegionID: 11000027, SystemID: 31002148, SystemName: "J152034", WormholeClass: 5},
+	31002149: {RegionID: 11000027, SystemID: 31002149, SystemName: "J125544", WormholeClass: 5},
+	31002150: {RegionID: 11000027, SystemID: 31002150, SystemName: "J123235", WormholeClass: 5},
+	31002151: {RegionID: 11000027, SystemID: 31002151, SystemName: "J151204", WormholeClass: 5},
+	31002152: {RegionID: 11000027, SystemID: 31002152, SystemName: "J104537", WormholeClass: 5},
+	31002153: {RegionID: 11000027, SystemID: 31002153, SystemName: "J162047", WormholeClass: 5},
+	31002154: {RegionID: 11000027, SystemID: 31002154, SystemName: "J140514", WormholeClass: 5},
+	31002155: {RegionID: 11000027, SystemID: 31002155, SystemName: "J154152", WormholeClass: 5},
+	31002156: {RegionID: 11000027, SystemID: 31002156, SystemName: "J143626", WormholeClass: 5},
+	31002157: {RegionID: 11000027, SystemID: 31002157, SystemName: "J161737", WormholeClass: 5},
+	31002158: {RegionID: 11000027, SystemID: 31002158, SystemName: "J142110", WormholeClass: 5},
+	31002159: {RegionID: 11000027, SystemID: 31002159, SystemName: "J112042", WormholeClass: 5},
+	31002160: {RegionID: 11000027, SystemID: 31002160, SystemName: "J105719", WormholeClass: 5},
+	31002161: {RegionID: 11000027, SystemID: 31002161, SystemName: "J101556", WormholeClass: 5},
+	31002162: {RegionID: 11000027, SystemID: 31002162, SystemName: "J230047", WormholeClass: 5},
+	31002163: {RegionID: 11000027, SystemID: 31002163, SystemName: "J213226", WormholeClass: 5},
+	31002164: {RegionID: 11000027, SystemID: 31002164, SystemName: "J104218", WormholeClass: 5},
+	31002165: {RegionID: 11000027, SystemID: 31002165, SystemName: "J115308", WormholeClass: 5},
+	31002166: {RegionID: 11000027, SystemID: 31002166, SystemName: "J140418", WormholeClass: 5},
+	31002167: {RegionID: 11000027, SystemID: 31002167, SystemName: "J101343", WormholeClass: 5},
+	31002168: {RegionID: 11000027, SystemID: 31002168, SystemName: "J105135", WormholeClass: 5},
+	31002169: {RegionID: 11000027, SystemID: 31002169, SystemName: "J111818", WormholeClass: 5},
+	31002170: {RegionID: 11000027, SystemID: 31002170, SystemName: "J110841", WormholeClass: 5},
+	31002171: {RegionID: 11000027, SystemID: 31002171, SystemName: "J134024", WormholeClass: 5},
+	31002172: {RegionID: 11000027, SystemID: 31002172, SystemName: "J142349", WormholeClass: 5},
+	31002173: {RegionID: 11000027, SystemID: 31002173, SystemName: "J212203", WormholeClass: 5},
+	31002174: {RegionID: 11000027, SystemID: 31002174, SystemName: "J124942", WormholeClass: 5},
+	31002175: {RegionID: 11000027, SystemID: 31002175, SystemName: "J131747", WormholeClass: 5},
+	31002176: {RegionID: 11000027, SystemID: 31002176, SystemName: "J133553", WormholeClass: 5},
+	31002177: {RegionID: 11000027, SystemID: 31002177, SystemName: "J115422", WormholeClass: 5},
+	31002178: {RegionID: 11000027, SystemID: 31002178, SystemName: "J103448", WormholeClass: 5},
+	31002179: {RegionID: 11000027, SystemID: 31002179, SystemName: "J161301", WormholeClass: 5},
+	31002180: {RegionID: 11000027, SystemID: 31002180, SystemName: "J130334", WormholeClass: 5},
+	31002181: {RegionID: 11000027, SystemID: 31002181, SystemName: "J172125", WormholeClass: 5},
+	31002182: {RegionID: 11000027, SystemID: 31002182, SystemName: "J114537", WormholeClass: 5},
+	31002183: {RegionID: 11000027, SystemID: 31002183, SystemName: "J134440", WormholeClass: 5},
+	31002184: {RegionID: 11000027, SystemID: 31002184, SystemName: "J101912", WormholeClass: 5},
+	31002185: {RegionID: 11000027, SystemID: 31002185, SystemName: "J214006", WormholeClass: 5},
+	31002186: {RegionID: 11000027, SystemID: 31002186, SystemName: "J235108", WormholeClass: 5},
+	31002187: {RegionID: 11000027, SystemID: 31002187, SystemName: "J124538", WormholeClass: 5},
+	31002188: {RegionID: 11000027, SystemID: 31002188, SystemName: "J125641", WormholeClass: 5},
+	31002189: {RegionID: 11000027, SystemID: 31002189, SystemName: "J141728", WormholeClass: 5},
+	31002190: {RegionID: 11000027, SystemID: 31002190, SystemName: "J100616", WormholeClass: 5},
+	31002191: {RegionID: 11000027, SystemID: 31002191, SystemName: "J204350", WormholeClass: 5},
+	31002192: {RegionID: 11000027, SystemID: 31002192, SystemName: "J151300", WormholeClass: 5},
+	31002193: {RegionID: 11000027, SystemID: 31002193, SystemName: "J114041", WormholeClass: 5},
+	31002194: {RegionID: 11000028, SystemID: 31002194, SystemName: "J115644", WormholeClass: 5},
+	31002195: {RegionID: 11000028, SystemID: 31002195, SystemName: "J103328", WormholeClass: 5},
+	31002196: {RegionID: 11000028, SystemID: 31002196, SystemName: "J143127", WormholeClass: 5},
+	31002197: {RegionID: 11000028, SystemID: 31002197, SystemName: "J121459", WormholeClass: 5},
+	31002198: {RegionID: 11000028, SystemID: 31002198, SystemName: "J114441", WormholeClass: 5},
+	31002199: {RegionID: 11000028, SystemID: 31002199, SystemName: "J102345", WormholeClass: 5},
+	31002200: {RegionID: 11000028, SystemID: 31002200, SystemName: "J161609", WormholeClass: 5},
+	31002201: {RegionID: 11000028, SystemID: 31002201, SystemName: "J131646", WormholeClass: 5},
+	31002202: {RegionID: 11000028, SystemID: 31002202, SystemName: "J144543", WormholeClass: 5},
+	31002203: {RegionID: 11000028, SystemID: 31002203, SystemName: "J123658", WormholeClass: 5},
+	31002204: {RegionID: 11000028, SystemID: 31002204, SystemName: "J121450", WormholeClass: 5},
+	31002205: {RegionID: 11000028, SystemID: 31002205, SystemName: "J145753", WormholeClass: 5},
+	31002206: {RegionID: 11000028, SystemID: 31002206, SystemName: "J130510", WormholeClass: 5},
+	31002207: {RegionID: 11000028, SystemID: 31002207, SystemName: "J135402", WormholeClass: 5},
+	31002208: {RegionID: 11000028, SystemID: 31002208, SystemName: "J165220", WormholeClass: 5},
+	31002209: {RegionID: 11000028, SystemID: 31002209, SystemName: "J124152", WormholeClass: 5},
+	31002210: {RegionID: 11000028, SystemID: 31002210, SystemName: "J162349", WormholeClass: 5},
+	31002211: {RegionID: 11000028, SystemID: 31002211, SystemName: "J210750", WormholeClass: 5},
+	31002212: {RegionID: 11000028, SystemID: 31002212, SystemName: "J235305", WormholeClass: 5},
+	31002213: {RegionID: 11000028, SystemID: 31002213, SystemName: "J120546", WormholeClass: 5},
+	31002214: {RegionID: 11000028, SystemID: 31002214, SystemName: "J105059", WormholeClass: 5},
+	31002215: {RegionID: 11000028, SystemID: 31002215, SystemName: "J140249", WormholeClass: 5},
+	31002216: {RegionID: 11000028, SystemID: 31002216, SystemName: "J154551", WormholeClass: 5},
+	31002217: {RegionID: 11000028, SystemID: 31002217, SystemName: "J151530", WormholeClass: 5},
+	31002218: {RegionID: 11000028, SystemID: 31002218, SystemName: "J122520", WormholeClass: 5},
+	31002219: {RegionID: 11000028, SystemID: 31002219, SystemName: "J151200", WormholeClass: 5},
+	31002220: {RegionID: 11000028, SystemID: 31002220, SystemName: "J143245", WormholeClass: 5},
+	31002221: {RegionID: 11000028, SystemID: 31002221, SystemName: "J124722", WormholeClass: 5},
+	31002222: {RegionID: 11000028, SystemID: 31002222, SystemName: "J173506", WormholeClass: 5},
+	31002223: {RegionID: 11000028, SystemID: 31002223, SystemName: "J121747", WormholeClass: 5},
+	31002224: {RegionID: 11000028, SystemID: 31002224, SystemName: "J235456", WormholeClass: 5},
+	31002225: {RegionID: 11000028, SystemID: 31002225, SystemName: "J113712", WormholeClass: 5},
+	31002226: {RegionID: 11000028, SystemID: 31002226, SystemName: "J165940", WormholeClass: 5},
+	31002227: {RegionID: 11000028, SystemID: 31002227, SystemName: "J165520", WormholeClass: 5},
+	31002228: {RegionID: 11000028, SystemID: 31002228, SystemName: "J135653", WormholeClass: 5},
+	31002229: {RegionID: 11000028, SystemID: 31002229, SystemName: "J132946", WormholeClass: 5},
+	31002230: {RegionID: 11000028, SystemID: 31002230, SystemName: "J134702", WormholeClass: 5},
+	31002231: {RegionID: 11000028, SystemID: 31002231, SystemName: "J111249", WormholeClass: 5},
+	31002232: {RegionID: 11000028, SystemID: 31002232, SystemName: "J105607", WormholeClass: 5},
+	31002233: {RegionID: 11000028, SystemID: 31002233, SystemName: "J100551", WormholeClass: 5},
+	31002234: {RegionID: 11000028, SystemID: 31002234, SystemName: "J140524", WormholeClass: 5},
+	31002235: {RegionID: 11000028, SystemID: 31002235, SystemName: "J234152", WormholeClass: 5},
+	31002236: {RegionID: 11000028, SystemID: 31002236, SystemName: "J164732", WormholeClass: 5},
+	31002237: {RegionID: 11000028, SystemID: 31002237, SystemName: "J131505", WormholeClass: 5},
+	31002238: {RegionID: 11000028, SystemID: 31002238, SystemName: "J115405", WormholeClass: 5},
+	31002239: {RegionID: 11000028, SystemID: 31002239, SystemName: "J215009", WormholeClass: 5},
+	31002240: {RegionID: 11000028, SystemID: 31002240, SystemName: "J161940", WormholeClass: 5},
+	31002241: {RegionID: 11000028, SystemID: 31002241, SystemName: "J145825", WormholeClass: 5},
+	31002242: {RegionID: 11000028, SystemID: 31002242, SystemName: "J132605", WormholeClass: 5},
+	31002243: {RegionID: 11000028, SystemID: 31002243, SystemName: "J115907", WormholeClass: 5},
+	31002244: {RegionID: 11000028, SystemID: 31002244, SystemName: "J125634", WormholeClass: 5},
+	31002245: {RegionID: 11000028, SystemID: 31002245, SystemName: "J110530", WormholeClass: 5},
+	31002246: {RegionID: 11000028, SystemID: 31002246, SystemName: "J100854", WormholeClass: 5},
+	31002247: {RegionID: 11000028, SystemID: 31002247, SystemName: "J102053", WormholeClass: 5},
+	31002248: {RegionID: 11000028, SystemID: 31002248, SystemName: "J111518", WormholeClass: 5},
+	31002249: {RegionID: 11000028, SystemID: 31002249, SystemName: "J145349", WormholeClass: 5},
+	31002250: {RegionID: 11000028, SystemID: 31002250, SystemName: "J145846", WormholeClass: 5},
+	31002251: {RegionID: 11000028, SystemID: 31002251, SystemName: "J215554", WormholeClass: 5},
+	31002252: {RegionID: 11000028, SystemID: 31002252, SystemName: "J115950", WormholeClass: 5},
+	31002253: {RegionID: 11000028, SystemID: 31002253, SystemName: "J100109", WormholeClass: 5},
+	31002254: {RegionID: 11000028, SystemID: 31002254, SystemName: "J161838", WormholeClass: 5},
+	31002255: {RegionID: 11000028, SystemID: 31002255, SystemName: "J173052", WormholeClass: 5},
+	31002256: {RegionID: 11000028, SystemID: 31002256, SystemName: "J170807", WormholeClass: 5},
+	31002257: {RegionID: 11000028, SystemID: 31002257, SystemName: "J105246", WormholeClass: 5},
+	31002258: {RegionID: 11000028, SystemID: 31002258, SystemName: "J144944", WormholeClass: 5},
+	31002259: {RegionID: 11000028, SystemID: 31002259, SystemName: "J170930", WormholeClass: 5},
+	31002260: {RegionID: 11000028, SystemID: 31002260, SystemName: "J130039", WormholeClass: 5},
+	31002261: {RegionID: 11000028, SystemID: 31002261, SystemName: "J103615", WormholeClass: 5},
+	31002262: {RegionID: 11000028, SystemID: 31002262, SystemName: "J111227", WormholeClass: 5},
+	31002263: {RegionID: 11000028, SystemID: 31002263, SystemName: "J153222", WormholeClass: 5},
+	31002264: {RegionID: 11000028, SystemID: 31002264, SystemName: "J144434", WormholeClass: 5},
+	31002265: {RegionID: 11000028, SystemID: 31002265, SystemName: "J230842", WormholeClass: 5},
+	31002266: {RegionID: 11000028, SystemID: 31002266, SystemName: "J172139", WormholeClass: 5},
+	31002267: {RegionID: 11000028, SystemID: 31002267, SystemName: "J232741", WormholeClass: 5},
+	31002268: {RegionID: 11000028, SystemID: 31002268, SystemName: "J110634", WormholeClass: 5},
+	31002269: {RegionID: 11000028, SystemID: 31002269, SystemName: "J124058", WormholeClass: 5},
+	31002270: {RegionID: 11000028, SystemID: 31002270, SystemName: "J123432", WormholeClass: 5},
+	31002271: {RegionID: 11000028, SystemID: 31002271, SystemName: "J151909", WormholeClass: 5},
+	31002272: {RegionID: 11000028, SystemID: 31002272, SystemName: "J145706", WormholeClass: 5},
+	31002273: {RegionID: 11000028, SystemID: 31002273, SystemName: "J230242", WormholeClass: 5},
+	31002274: {RegionID: 11000028, SystemID: 31002274, SystemName: "J172840", WormholeClass: 5},
+	31002275: {RegionID: 11000028, SystemID: 31002275, SystemName: "J131450", WormholeClass: 5},
+	31002276: {RegionID: 11000028, SystemID: 31002276, SystemName: "J125101", WormholeClass: 5},
+	31002277: {RegionID: 11000028, SystemID: 31002277, SystemName: "J142910", WormholeClass: 5},
+	31002278: {RegionID: 11000028, SystemID: 31002278, SystemName: "J131142", WormholeClass: 5},
+	31002279: {RegionID: 11000028, SystemID: 31002279, SystemName: "J152827", WormholeClass: 5},
+	31002280: {RegionID: 11000028, SystemID: 31002280, SystemName: "J115901", WormholeClass: 5},
+	31002281: {RegionID: 11000028, SystemID: 31002281, SystemName: "J114100", WormholeClass: 5},
+	31002282: {RegionID: 11000028, SystemID: 31002282, SystemName: "J162132", WormholeClass: 5},
+	31002283: {RegionID: 11000029, SystemID: 31002283, SystemName: "J171722", WormholeClass: 5},
+	31002284: {RegionID: 11000029, SystemID: 31002284, SystemName: "J135554", WormholeClass: 5},
+	31002285: {RegionID: 11000029, SystemID: 31002285, SystemName: "J214212", WormholeClass: 5},
+	31002286: {RegionID: 11000029, SystemID: 31002286, SystemName: "J130305", WormholeClass: 5},
+	31002287: {RegionID: 11000029, SystemID: 31002287, SystemName: "J111543", WormholeClass: 5},
+	31002288: {RegionID: 11000029, SystemID: 31002288, SystemName: "J160623", WormholeClass: 5},
+	31002289: {RegionID: 11000029, SystemID: 31002289, SystemName: "J234722", WormholeClass: 5},
+	31002290: {RegionID: 11000029, SystemID: 31002290, SystemName: "J133513", WormholeClass: 5},
+	31002291: {RegionID: 11000029, SystemID: 31002291, SystemName: "J104704", WormholeClass: 5},
+	31002292: {RegionID: 11000029, SystemID: 31002292, SystemName: "J120354", WormholeClass: 5},
+	31002293: {RegionID: 11000029, SystemID: 31002293, SystemName: "J165312", WormholeClass: 5},
+	31002294: {RegionID: 11000029, SystemID: 31002294, SystemName: "J103812", WormholeClass: 5},
+	31002295: {RegionID: 11000029, SystemID: 31002295, SystemName: "J143525", WormholeClass: 5},
+	31002296: {RegionID: 11000029, SystemID: 31002296, SystemName: "J125716", WormholeClass: 5},
+	31002297: {RegionID: 11000029, SystemID: 31002297, SystemName: "J143845", WormholeClass: 5},
+	31002298: {RegionID: 11000029, SystemID: 31002298, SystemName: "J135626", WormholeClass: 5},
+	31002299: {RegionID: 11000029, SystemID: 31002299, SystemName: "J115905", WormholeClass: 5},
+	31002300: {RegionID: 11000029, SystemID: 31002300, SystemName: "J233917", WormholeClass: 5},
+	31002301: {RegionID: 11000029, SystemID: 31002301, SystemName: "J140343", WormholeClass: 5},
+	31002302: {RegionID: 11000029, SystemID: 31002302, SystemName: "J114315", WormholeClass: 5},
+	31002303: {RegionID: 11000029, SystemID: 31002303, SystemName: "J142653", WormholeClass: 5},
+	31002304: {RegionID: 11000029, SystemID: 31002304, SystemName: "J132546", WormholeClass: 5},
+	31002305: {RegionID: 11000029, SystemID: 31002305, SystemName: "J165205", WormholeClass: 5},
+	31002306: {RegionID: 11000029, SystemID: 31002306, SystemName: "J122518", WormholeClass: 5},
+	31002307: {RegionID: 11000029, SystemID: 31002307, SystemName: "J153825", WormholeClass: 5},
+	31002308: {RegionID: 11000029, SystemID: 31002308, SystemName: "J101435", WormholeClass: 5},
+	31002309: {RegionID: 11000029, SystemID: 31002309, SystemName: "J143819", WormholeClass: 5},
+	31002310: {RegionID: 11000029, SystemID: 31002310, SystemName: "J170417", WormholeClass: 5},
+	31002311: {RegionID: 11000029, SystemID: 31002311, SystemName: "J115727", WormholeClass: 5},
+	31002312: {RegionID: 11000029, SystemID: 31002312, SystemName: "J115124", WormholeClass: 5},
+	31002313: {RegionID: 11000029, SystemID: 31002313, SystemName: "J135533", WormholeClass: 5},
+	31002314: {RegionID: 11000029, SystemID: 31002314, SystemName: "J230745", WormholeClass: 5},
+	31002315: {RegionID: 11000029, SystemID: 31002315, SystemName: "J112918", WormholeClass: 5},
+	31002316: {RegionID: 11000029, SystemID: 31002316, SystemName: "J111640", WormholeClass: 5},
+	31002317: {RegionID: 11000029, SystemID: 31002317, SystemName: "J231137", WormholeClass: 5},
+	31002318: {RegionID: 11000029, SystemID: 31002318, SystemName: "J111613", WormholeClass: 5},
+	31002319: {RegionID: 11000029, SystemID: 31002319, SystemName: "J145131", WormholeClass: 5},
+	31002320: {RegionID: 11000029, SystemID: 31002320, SystemName: "J111003", WormholeClass: 5},
+	31002321: {RegionID: 11000029, SystemID: 31002321, SystemName: "J131520", WormholeClass: 5},
+	31002322: {RegionID: 11000029, SystemID: 31002322, SystemName: "J120922", WormholeClass: 5},
+	31002323: {RegionID: 11000029, SystemID: 31002323, SystemName: "J142138", WormholeClass: 5},
+	31002324: {RegionID: 11000029, SystemID: 31002324, SystemName: "J153106", WormholeClass: 5},
+	31002325: {RegionID: 11000029, SystemID: 31002325, SystemName: "J111939", WormholeClass: 5},
+	31002326: {RegionID: 11000029, SystemID: 31002326, SystemName: "J173550", WormholeClass: 5},
+	31002327: {RegionID: 11000029, SystemID: 31002327, SystemName: "J145310", WormholeClass: 5},
+	31002328: {RegionID: 11000029, SystemID: 31002328, SystemName: "J155928", WormholeClass: 5},
+	31002329: {RegionID: 11000029, SystemID: 31002329, SystemName: "J234208", WormholeClass: 5},
+	31002330: {RegionID: 11000029, SystemID: 31002330, SystemName: "J105722", WormholeClass: 5},
+	31002331: {RegionID: 11000029, SystemID: 31002331, SystemName: "J123546", WormholeClass: 5},
+	31002332: {RegionID: 11000029, SystemID: 31002332, SystemName: "J210235", WormholeClass: 5},
+	31002333: {RegionID: 11000029, SystemID: 31002333, SystemName: "J170736", WormholeClass: 5},
+	31002334: {RegionID: 11000029, SystemID: 31002334, SystemName: "J170240", WormholeClass: 5},
+	31002335: {RegionID: 11000029, SystemID: 31002335, SystemName: "J125532", WormholeClass: 5},
+	31002336: {RegionID: 11000029, SystemID: 31002336, SystemName: "J133923", WormholeClass: 5},
+	31002337: {RegionID: 11000029, SystemID: 31002337, SystemName: "J131509", WormholeClass: 5},
+	31002338: {RegionID: 11000029, SystemID: 31002338, SystemName: "J210519", WormholeClass: 5},
+	31002339: {RegionID: 11000029, SystemID: 31002339, SystemName: "J233517", WormholeClass: 5},
+	31002340: {RegionID: 11000029, SystemID: 31002340, SystemName: "J162612", WormholeClass: 5},
+	31002341: {RegionID: 11000029, SystemID: 31002341, SystemName: "J123055", WormholeClass: 5},
+	31002342: {RegionID: 11000029, SystemID: 31002342, SystemName: "J212302", WormholeClass: 5},
+	31002343: {RegionID: 11000029, SystemID: 31002343, SystemName: "J102103", WormholeClass: 5},
+	31002344: {RegionID: 11000029, SystemID: 31002344, SystemName: "J213956", WormholeClass: 5},
+	31002345: {RegionID: 11000029, SystemID: 31002345, SystemName: "J161944", WormholeClass: 5},
+	31002346: {RegionID: 11000029, SystemID: 31002346, SystemName: "J135705", WormholeClass: 5},
+	31002347: {RegionID: 11000029, SystemID: 31002347, SystemName: "J103215", WormholeClass: 5},
+	31002348: {RegionID: 11000029, SystemID: 31002348, SystemName: "J141032", WormholeClass: 5},
+	31002349: {RegionID: 11000029, SystemID: 31002349, SystemName: "J135306", WormholeClass: 5},
+	31002350: {RegionID: 11000029, SystemID: 31002350, SystemName: "J155256", WormholeClass: 5},
+	31002351: {RegionID: 11000029, SystemID: 31002351, SystemName: "J162853", WormholeClass: 5},
+	31002352: {RegionID: 11000029, SystemID: 31002352, SystemName: "J104809", WormholeClass: 5},
+	31002353: {RegionID: 11000029, SystemID: 31002353, SystemName: "J144454", WormholeClass: 5},
+	31002354: {RegionID: 11000029, SystemID: 31002354, SystemName: "J110759", WormholeClass: 5},
+	31002355: {RegionID: 11000029, SystemID: 31002355, SystemName: "J215304", WormholeClass: 5},
+	31002356: {RegionID: 11000029, SystemID: 31002356, SystemName: "J110946", WormholeClass: 5},
+	31002357: {RegionID: 11000029, SystemID: 31002357, SystemName: "J230221", WormholeClass: 5},
+	31002358: {RegionID: 11000029, SystemID: 31002358, SystemName: "J140133", WormholeClass: 5},
+	31002359: {RegionID: 11000029, SystemID: 31002359, SystemName: "J105531", WormholeClass: 5},
+	31002360: {RegionID: 11000029, SystemID: 31002360, SystemName: "J213502", WormholeClass: 5},
+	31002361: {RegionID: 11000029, SystemID: 31002361, SystemName: "J101647", WormholeClass: 5},
+	31002362: {RegionID: 11000029, SystemID: 31002362, SystemName: "J133023", WormholeClass: 5},
+	31002363: {RegionID: 11000029, SystemID: 31002363, SystemName: "J164553", WormholeClass: 5},
+	31002364: {RegionID: 11000029, SystemID: 31002364, SystemName: "J232959", WormholeClass: 5},
+	31002365: {RegionID: 11000029, SystemID: 31002365, SystemName: "J152106", WormholeClass: 5},
+	31002366: {RegionID: 11000029, SystemID: 31002366, SystemName: "J142535", WormholeClass: 5},
+	31002367: {RegionID: 11000030, SystemID: 31002367, SystemName: "J104859", WormholeClass: 6},
+	31002368: {RegionID: 11000030, SystemID: 31002368, SystemName: "J155838", WormholeClass: 6},
+	31002369: {RegionID: 11000030, SystemID: 31002369, SystemName: "J164745", WormholeClass: 6},
+	31002370: {RegionID: 11000030, SystemID: 31002370, SystemName: "J135504", WormholeClass: 6},
+	31002371: {RegionID: 11000030, SystemID: 31002371, SystemName: "J115923", WormholeClass: 6},
+	31002372: {RegionID: 11000030, SystemID: 31002372, SystemName: "J151325", WormholeClass: 6},
+	31002373: {RegionID: 11000030, SystemID: 31002373, SystemName: "J105705", WormholeClass: 6},
+	31002374: {RegionID: 11000030, SystemID: 31002374, SystemName: "J141434", WormholeClass: 6},
+	31002375: {RegionID: 11000030, SystemID: 31002375, SystemName: "J115844", WormholeClass: 6},
+	31002376: {RegionID: 11000030, SystemID: 31002376, SystemName: "J133906", WormholeClass: 6},
+	31002377: {RegionID: 11000030, SystemID: 31002377, SystemName: "J115855", WormholeClass: 6},
+	31002378: {RegionID: 11000030, SystemID: 31002378, SystemName: "J150020", WormholeClass: 6},
+	31002379: {RegionID: 11000030, SystemID: 31002379, SystemName: "J142528", WormholeClass: 6},
+	31002380: {RegionID: 11000030, SystemID: 31002380, SystemName: "J130900", WormholeClass: 6},
+	31002381: {RegionID: 11000030, SystemID: 31002381, SystemName: "J222045", WormholeClass: 6},
+	31002382: {RegionID: 11000030, SystemID: 31002382, SystemName: "J111450", WormholeClass: 6},
+	31002383: {RegionID: 11000030, SystemID: 31002383, SystemName: "J103800", WormholeClass: 6},
+	31002384: {RegionID: 11000030, SystemID: 31002384, SystemName: "J144420", WormholeClass: 6},
+	31002385: {RegionID: 11000030, SystemID: 31002385, SystemName: "J152550", WormholeClass: 6},
+	31002386: {RegionID: 11000030, SystemID: 31002386, SystemName: "J114003", WormholeClass: 6},
+	31002387: {RegionID: 11000030, SystemID: 31002387, SystemName: "J143751", WormholeClass: 6},
+	31002388: {RegionID: 11000030, SystemID: 31002388, SystemName: "J215930", WormholeClass: 6},
+	31002389: {RegionID: 11000030, SystemID: 31002389, SystemName: "J120512", WormholeClass: 6},
+	31002390: {RegionID: 11000030, SystemID: 31002390, SystemName: "J145939", WormholeClass: 6},
+	31002391: {RegionID: 11000030, SystemID: 31002391, SystemName: "J135623", WormholeClass: 6},
+	31002392: {RegionID: 11000030, SystemID: 31002392, SystemName: "J111918", WormholeClass: 6},
+	31002393: {RegionID: 11000030, SystemID: 31002393, SystemName: "J162614", WormholeClass: 6},
+	31002394: {RegionID: 11000030, SystemID: 31002394, SystemName: "J104921", WormholeClass: 6},
+	31002395: {RegionID: 11000030, SystemID: 31002395, SystemName: "J230049", WormholeClass: 6},
+	31002396: {RegionID: 11000030, SystemID: 31002396, SystemName: "J115935", WormholeClass: 6},
+	31002397: {RegionID: 11000030, SystemID: 31002397, SystemName: "J151920", WormholeClass: 6},
+	31002398: {RegionID: 11000030, SystemID: 31002398, SystemName: "J160855", WormholeClass: 6},
+	31002399: {RegionID: 11000030, SystemID: 31002399, SystemName: "J155311", WormholeClass: 6},
+	31002400: {RegionID: 11000030, SystemID: 31002400, SystemName: "J101553", WormholeClass: 6},
+	31002401: {RegionID: 11000030, SystemID: 31002401, SystemName: "J110421", WormholeClass: 6},
+	31002402: {RegionID: 11000030, SystemID: 31002402, SystemName: "J155207", WormholeClass: 6},
+	31002403: {RegionID: 11000030, SystemID: 31002403, SystemName: "J143200", WormholeClass: 6},
+	31002404: {RegionID: 11000030, SystemID: 31002404, SystemName: "J110834", WormholeClass: 6},
+	31002405: {RegionID: 11000030, SystemID: 31002405, SystemName: "J142247", WormholeClass: 6},
+	31002406: {RegionID: 11000030, SystemID: 31002406, SystemName: "J112028", WormholeClass: 6},
+	31002407: {RegionID: 11000030, SystemID: 31002407, SystemName: "J111520", WormholeClass: 6},
+	31002408: {RegionID: 11000030, SystemID: 31002408, SystemName: "J161335", WormholeClass: 6},
+	31002409: {RegionID: 11000030, SystemID: 31002409, SystemName: "J170118", WormholeClass: 6},
+	31002410: {RegionID: 11000030, SystemID: 31002410, SystemName: "J160722", WormholeClass: 6},
+	31002411: {RegionID: 11000030, SystemID: 31002411, SystemName: "J124504", WormholeClass: 6},
+	31002412: {RegionID: 11000030, SystemID: 31002412, SystemName: "J212612", WormholeClass: 6},
+	31002413: {RegionID: 11000030, SystemID: 31002413, SystemName: "J154829", WormholeClass: 6},
+	31002414: {RegionID: 11000030, SystemID: 31002414, SystemName: "J144436", WormholeClass: 6},
+	31002415: {RegionID: 11000030, SystemID: 31002415, SystemName: "J165641", WormholeClass: 6},
+	31002416: {RegionID: 11000030, SystemID: 31002416, SystemName: "J145510", WormholeClass: 6},
+	31002417: {RegionID: 11000030, SystemID: 31002417, SystemName: "J110810", WormholeClass: 6},
+	31002418: {RegionID: 11000030, SystemID: 31002418, SystemName: "J131916", WormholeClass: 6},
+	31002419: {RegionID: 11000030, SystemID: 31002419, SystemName: "J120103", WormholeClass: 6},
+	31002420: {RegionID: 11000030, SystemID: 31002420, SystemName: "J171404", WormholeClass: 6},
+	31002421: {RegionID: 11000030, SystemID: 31002421, SystemName: "J104632", WormholeClass: 6},
+	31002422: {RegionID: 11000030, SystemID: 31002422, SystemName: "J103400", WormholeClass: 6},
+	31002423: {RegionID: 11000030, SystemID: 31002423, SystemName: "J154021", WormholeClass: 6},
+	31002424: {RegionID: 11000030, SystemID: 31002424, SystemName: "J122249", WormholeClass: 6},
+	31002425: {RegionID: 11000030, SystemID: 31002425, SystemName: "J213753", WormholeClass: 6},
+	31002426: {RegionID: 11000030, SystemID: 31002426, SystemName: "J155650", WormholeClass: 6},
+	31002427: {RegionID: 11000030, SystemID: 31002427, SystemName: "J152722", WormholeClass: 6},
+	31002428: {RegionID: 11000030, SystemID: 31002428, SystemName: "J100501", WormholeClass: 6},
+	31002429: {RegionID: 11000030, SystemID: 31002429, SystemName: "J140918", WormholeClass: 6},
+	31002430: {RegionID: 11000030, SystemID: 31002430, SystemName: "J103414", WormholeClass: 6},
+	31002431: {RegionID: 11000030, SystemID: 31002431, SystemName: "J223432", WormholeClass: 6},
+	31002432: {RegionID: 11000030, SystemID: 31002432, SystemName: "J100409", WormholeClass: 6},
+	31002433: {RegionID: 11000030, SystemID: 31002433, SystemName: "J141502", WormholeClass: 6},
+	31002434: {RegionID: 11000030, SystemID: 31002434, SystemName: "J115008", WormholeClass: 6},
+	31002435: {RegionID: 11000030, SystemID: 31002435, SystemName: "J112829", WormholeClass: 6},
+	31002436: {RegionID: 11000030, SystemID: 31002436, SystemName: "J105801", WormholeClass: 6},
+	31002437: {RegionID: 11000030, SystemID: 31002437, SystemName: "J105023", WormholeClass: 6},
+	31002438: {RegionID: 11000030, SystemID: 31002438, SystemName: "J101652", WormholeClass: 6},
+	31002439: {RegionID: 11000030, SystemID: 31002439, SystemName: "J163225", WormholeClass: 6},
+	31002440: {RegionID: 11000030, SystemID: 31002440, SystemName: "J135220", WormholeClass: 6},
+	31002441: {RegionID: 11000030, SystemID: 31002441, SystemName: "J114430", WormholeClass: 6},
+	31002442: {RegionID: 11000030, SystemID: 31002442, SystemName: "J103504", WormholeClass: 6},
+	31002443: {RegionID: 11000030, SystemID: 31002443, SystemName: "J123940", WormholeClass: 6},
+	31002444: {RegionID: 11000030, SystemID: 31002444, SystemName: "J104857", WormholeClass: 6},
+	31002445: {RegionID: 11000030, SystemID: 31002445, SystemName: "J112417", WormholeClass: 6},
+	31002446: {RegionID: 11000030, SystemID: 31002446, SystemName: "J205141", WormholeClass: 6},
+	31002447: {RegionID: 11000030, SystemID: 31002447, SystemName: "J104037", WormholeClass: 6},
+	31002448: {RegionID: 11000030, SystemID: 31002448, SystemName: "J144902", WormholeClass: 6},
+	31002449: {RegionID: 11000030, SystemID: 31002449, SystemName: "J122818", WormholeClass: 6},
+	31002450: {RegionID: 11000030, SystemID: 31002450, SystemName: "J211353", WormholeClass: 6},
+	31002451: {RegionID: 11000030, SystemID: 31002451, SystemName: "J104136", WormholeClass: 6},
+	31002452: {RegionID: 11000030, SystemID: 31002452, SystemName: "J172842", WormholeClass: 6},
+	31002453: {RegionID: 11000030, SystemID: 31002453, SystemName: "J122452", WormholeClass: 6},
+	31002454: {RegionID: 11000030, SystemID: 31002454, SystemName: "J140200", WormholeClass: 6},
+	31002455: {RegionID: 11000030, SystemID: 31002455, SystemName: "J232246", WormholeClass: 6},
+	31002456: {RegionID: 11000030, SystemID: 31002456, SystemName: "J101748", WormholeClass: 6},
+	31002457: {RegionID: 11000030, SystemID: 31002457, SystemName: "J133241", WormholeClass: 6},
+	31002458: {RegionID: 11000030, SystemID: 31002458, SystemName: "J151817", WormholeClass: 6},
+	31002459: {RegionID: 11000030, SystemID: 31002459, SystemName: "J124201", WormholeClass: 6},
+	31002460: {RegionID: 11000030, SystemID: 31002460, SystemName: "J125111", WormholeClass: 6},
+	31002461: {RegionID: 11000030, SystemID: 31002461, SystemName: "J101755", WormholeClass: 6},
+	31002462: {RegionID: 11000030, SystemID: 31002462, SystemName: "J101708", WormholeClass: 6},
+	31002463: {RegionID: 11000030, SystemID: 31002463, SystemName: "J135031", WormholeClass: 6},
+	31002464: {RegionID: 11000030, SystemID: 31002464, SystemName: "J152117", WormholeClass: 6},
+	31002465: {RegionID: 11000030, SystemID: 31002465, SystemName: "J164701", WormholeClass: 6},
+	31002466: {RegionID: 11000030, SystemID: 31002466, SystemName: "J132106", WormholeClass: 6},
+	31002467: {RegionID: 11000030, SystemID: 31002467, SystemName: "J141319", WormholeClass: 6},
+	31002468: {RegionID: 11000030, SystemID: 31002468, SystemName: "J170845", WormholeClass: 6},
+	31002469: {RegionID: 11000030, SystemID: 31002469, SystemName: "J161215", WormholeClass: 6},
+	31002470: {RegionID: 11000030, SystemID: 31002470, SystemName: "J111447", WormholeClass: 6},
+	31002471: {RegionID: 11000029, SystemID: 31002471, SystemName: "J222408", WormholeClass: 5},
+	31002472: {RegionID: 11000028, SystemID: 31002472, SystemName: "J161747", WormholeClass: 5},
+	31002473: {RegionID: 11000029, SystemID: 31002473, SystemName: "J132328", WormholeClass: 5},
+	31002474: {RegionID: 11000030, SystemID: 31002474, SystemName: "J153217", WormholeClass: 6},
+	31002475: {RegionID: 11000029, SystemID: 31002475, SystemName: "J142814", WormholeClass: 5},
+	31002476: {RegionID: 11000026, SystemID: 31002476, SystemName: "J101248", WormholeClass: 5},
+	31002477: {RegionID: 11000029, SystemID: 31002477, SystemName: "J135825", WormholeClass: 5},
+	31002478: {RegionID: 11000030, SystemID: 31002478, SystemName: "J151838", WormholeClass: 6},
+	31002479: {RegionID: 11000028, SystemID: 31002479, SystemName: "J100820", WormholeClass: 5},
+	31002480: {RegionID: 11000029, SystemID: 31002480, SystemName: "J213344", WormholeClass: 5},
+	31002481: {RegionID: 11000025, SystemID: 31002481, SystemName: "J103701", WormholeClass: 5},
+	31002482: {RegionID: 11000025, SystemID: 31002482, SystemName: "J171246", WormholeClass: 5},
+	31002483: {RegionID: 11000024, SystemID: 31002483, SystemName: "J170158", WormholeClass: 5},
+	31002484: {RegionID: 11000030, SystemID: 31002484, SystemName: "J230559", WormholeClass: 6},
+	31002485: {RegionID: 11000025, SystemID: 31002485, SystemName: "J145208", WormholeClass: 5},
+	31002486: {RegionID: 11000024, SystemID: 31002486, SystemName: "J224721", WormholeClass: 5},
+	31002487: {RegionID: 11000030, SystemID: 31002487, SystemName: "J105934", WormholeClass: 6},
+	31002488: {RegionID: 11000025, SystemID: 31002488, SystemName: "J130818", WormholeClass: 5},
+	31002489: {RegionID: 11000030, SystemID: 31002489, SystemName: "J140555", WormholeClass: 6},
+	31002490: {RegionID: 11000027, SystemID: 31002490, SystemName: "J221356", WormholeClass: 5},
+	31002491: {RegionID: 11000028, SystemID: 31002491, SystemName: "J111245", WormholeClass: 5},
+	31002492: {RegionID: 11000030, SystemID: 31002492, SystemName: "J102834", WormholeClass: 6},
+	31002493: {RegionID: 11000026, SystemID: 31002493, SystemName: "J154509", WormholeClass: 5},
+	31002494: {RegionID: 11000025, SystemID: 31002494, SystemName: "J234928", WormholeClass: 5},
+	31002495: {RegionID: 11000030, SystemID: 31002495, SystemName: "J222604", WormholeClass: 6},
+	31002496: {RegionID: 11000025, SystemID: 31002496, SystemName: "J133011", WormholeClass: 5},
+	31002497: {RegionID: 11000029, SystemID: 31002497, SystemName: "J125245", WormholeClass: 5},
+	31002498: {RegionID: 11000030, SystemID: 31002498, SystemName: "J145523", WormholeClass: 6},
+	31002499: {RegionID: 11000027, SystemID: 31002499, SystemName: "J143605", WormholeClass: 5},
+	31002500: {RegionID: 11000024, SystemID: 31002500, SystemName: "J120750", WormholeClass: 5},
+	31002501: {RegionID: 11000026, SystemID: 31002501, SystemName: "J104617", WormholeClass: 5},
+	31002502: {RegionID: 11000030, SystemID: 31002502, SystemName: "J125122", WormholeClass: 6},
+	31002503: {RegionID: 11000025, SystemID: 31002503, SystemName: "J114809", WormholeClass: 5},
+	31002504: {RegionID: 11000027, SystemID: 31002504, SystemName: "J125657", WormholeClass: 5},
+	31002505: {RegionID: 11000001, SystemID: 31002505, SystemName: "J015092", WormholeClass: 1},
+	31002506: {RegionID: 11000001, SystemID: 31002506, SystemName: "J005834", WormholeClass: 1},
+	31002507: {RegionID: 11000001, SystemID: 31002507, SystemName: "J012402", WormholeClass: 1},
+	31002508: {RegionID: 11000001, SystemID: 31002508, SystemName: "J005926", WormholeClass: 1},
+	31002509: {RegionID: 11000001, SystemID: 31002509, SystemName: "J010569", WormholeClass: 1},
+	31002510: {RegionID: 11000001, SystemID: 31002510, SystemName: "J012157", WormholeClass: 1},
+	31002511: {RegionID: 11000001, SystemID: 31002511, SystemName: "J002757", WormholeClass: 1},
+	31002512: {RegionID: 11000001, SystemID: 31002512, SystemName: "J004791", WormholeClass: 1},
+	31002513: {RegionID: 11000001, SystemID: 31002513, SystemName: "J004317", WormholeClass: 1},
+	31002514: {RegionID: 11000001, SystemID: 31002514, SystemName: "J002216", WormholeClass: 1},
+	31002515: {RegionID: 11000004, SystemID: 31002515, SystemName: "J005482", WormholeClass: 2},
+	31002516: {RegionID: 11000004, SystemID: 31002516, SystemName: "J005900", WormholeClass: 2},
+	31002517: {RegionID: 11000004, SystemID: 31002517, SystemName: "J013070", WormholeClass: 2},
+	31002518: {RegionID: 11000004, SystemID: 31002518, SystemName: "J001057", WormholeClass: 2},
+	31002519: {RegionID: 11000004, SystemID: 31002519, SystemName: "J011321", WormholeClass: 2},
+	31002520: {RegionID: 11000004, SystemID: 31002520, SystemName: "J013123", WormholeClass: 2},
+	31002521: {RegionID: 11000004, SystemID: 31002521, SystemName: "J012735", WormholeClass: 2},
+	31002522: {RegionID: 11000004, SystemID: 31002522, SystemName: "J012635", WormholeClass: 2},
+	31002523: {RegionID: 11000004, SystemID: 31002523, SystemName: "J010000", WormholeClass: 2},
+	31002524: {RegionID: 11000004, SystemID: 31002524, SystemName: "J001890", WormholeClass: 2},
+	31002525: {RegionID: 11000004, SystemID: 31002525, SystemName: "J002964", WormholeClass: 2},
+	31002526: {RegionID: 11000004, SystemID: 31002526, SystemName: "J004686", WormholeClass: 2},
+	31002527: {RegionID: 11000009, SystemID: 31002527, SystemName: "J011563", WormholeClass: 3},
+	31002528: {RegionID: 11000009, SystemID: 31002528, SystemName: "J001348", WormholeClass: 3},
+	31002529: {RegionID: 11000009, SystemID: 31002529, SystemName: "J002838", WormholeClass: 3},
+	31002530: {RegionID: 11000009, SystemID: 31002530, SystemName: "J001670", WormholeClass: 3},
+	31002531: {RegionID: 11000009, SystemID: 31002531, SystemName: "J014348", WormholeClass: 3},
+	31002532: {RegionID: 11000009, SystemID: 31002532, SystemName: "J003789", WormholeClass: 3},
+	31002533: {RegionID: 11000009, SystemID: 31002533, SystemName: "J012794", WormholeClass: 3},
+	31002534: {RegionID: 11000009, SystemID: 31002534, SystemName: "J005923", WormholeClass: 3},
+	31002535: {RegionID: 11000009, SystemID: 31002535, SystemName: "J001398", WormholeClass: 3},
+	31002536: {RegionID: 11000009, SystemID: 31002536, SystemName: "J011339", WormholeClass: 3},
+	31002537: {RegionID: 11000009, SystemID: 31002537, SystemName: "J004283", WormholeClass: 3},
+	31002538: {RegionID: 11000016, SystemID: 31002538, SystemName: "J002625", WormholeClass: 4},
+	31002539: {RegionID: 11000016, SystemID: 31002539, SystemName: "J004470", WormholeClass: 4},
+	31002540: {RegionID: 11000016, SystemID: 31002540, SystemName: "J011824", WormholeClass: 4},
+	31002541: {RegionID: 11000016, SystemID: 31002541, SystemName: "J010247", WormholeClass: 4},
+	31002542: {RegionID: 11000016, SystemID: 31002542, SystemName: "J002423", WormholeClass: 4},
+	31002543: {RegionID: 11000016, SystemID: 31002543, SystemName: "J001302", WormholeClass: 4},
+	31002544: {RegionID: 11000016, SystemID: 31002544, SystemName: "J010556", WormholeClass: 4},
+	31002545: {RegionID: 11000016, SystemID: 31002545, SystemName: "J011778", WormholeClass: 4},
+	31002546: {RegionID: 11000016, SystemID: 31002546, SystemName: "J005259", WormholeClass: 4},
+	31002547: {RegionID: 11000016, SystemID: 31002547, SystemName: "J005969", WormholeClass: 4},
+	31002548: {RegionID: 11000016, SystemID: 31002548, SystemName: "J001769", WormholeClass: 4},
+	31002549: {RegionID: 11000016, SystemID: 31002549, SystemName: "J004150", WormholeClass: 4},
+	31002550: {RegionID: 11000016, SystemID: 31002550, SystemName: "J005872", WormholeClass: 4},
+	31002551: {RegionID: 11000016, SystemID: 31002551, SystemName: "J011376", WormholeClass: 4},
+	31002552: {RegionID: 11000016, SystemID: 31002552, SystemName: "J005299", WormholeClass: 4},
+	31002553: {RegionID: 11000016, SystemID: 31002553, SystemName: "J011355", WormholeClass: 4},
+	31002554: {RegionID: 11000016, SystemID: 31002554, SystemName: "J011195", WormholeClass: 4},
+	31002555: {RegionID: 11000016, SystemID: 31002555, SystemName: "J005280", WormholeClass: 4},
+	31002556: {RegionID: 11000024, SystemID: 31002556, SystemName: "J010811", WormholeClass: 5},
+	31002557: {RegionID: 11000024, SystemID: 31002557, SystemName: "J001694", WormholeClass: 5},
+	31002558: {RegionID: 11000024, SystemID: 31002558, SystemName: "J003941", WormholeClass: 5},
+	31002559: {RegionID: 11000024, SystemID: 31002559, SystemName: "J011790", WormholeClass: 5},
+	31002560: {RegionID: 11000024, SystemID: 31002560, SystemName: "J013146", WormholeClass: 5},
+	31002561: {RegionID: 11000024, SystemID: 31002561, SystemName: "J004998", WormholeClass: 5},
+	31002562: {RegionID: 11000024, SystemID: 31002562, SystemName: "J003382", WormholeClass: 5},
+	31002563: {RegionID: 11000024, SystemID: 31002563, SystemName: "J003546", WormholeClass: 5},
+	31002564: {RegionID: 11000024, SystemID: 31002564, SystemName: "J001820", WormholeClass: 5},
+	31002565: {RegionID: 11000024, SystemID: 31002565, SystemName: "J012578", WormholeClass: 5},
+	31002566: {RegionID: 11000024, SystemID: 31002566, SystemName: "J012773", WormholeClass: 5},
+	31002567: {RegionID: 11000024, SystemID: 31002567, SystemName: "J005223", WormholeClass: 5},
+	31002568: {RegionID: 11000024, SystemID: 31002568, SystemName: "J005663", WormholeClass: 5},
+	31002569: {RegionID: 11000024, SystemID: 31002569, SystemName: "J003793", WormholeClass: 5},
+	31002570: {RegionID: 11000024, SystemID: 31002570, SystemName: "J004128", WormholeClass: 5},
+	31002571: {RegionID: 11000024, SystemID: 31002571, SystemName: "J005070", WormholeClass: 5},
+	31002572: {RegionID: 11000024, SystemID: 31002572, SystemName: "J001025", WormholeClass: 5},
+	31002573: {RegionID: 11000024, SystemID: 31002573, SystemName: "J005724", WormholeClass: 5},
+	31002574: {RegionID: 11000024, SystemID: 31002574, SystemName: "J004921", WormholeClass: 5},
+	31002575: {RegionID: 11000030, SystemID: 31002575, SystemName: "J012686", WormholeClass: 6},
+	31002576: {RegionID: 11000030, SystemID: 31002576, SystemName: "J010366", WormholeClass: 6},
+	31002577: {RegionID: 11000030, SystemID: 31002577, SystemName: "J012475", WormholeClass: 6},
+	31002578: {RegionID: 11000030, SystemID: 31002578, SystemName: "J015227", WormholeClass: 6},
+	31002579: {RegionID: 11000030, SystemID: 31002579, SystemName: "J010951", WormholeClass: 6},
+	31002580: {RegionID: 11000032, SystemID: 31002580, SystemName: "J000895", WormholeClass: 13},
+	31002581: {RegionID: 11000032, SystemID: 31002581, SystemName: "J000487", WormholeClass: 13},
+	31002582: {RegionID: 11000032, SystemID: 31002582, SystemName: "J000621", WormholeClass: 13},
+	31002583: {RegionID: 11000032, SystemID: 31002583, SystemName: "J000551", WormholeClass: 13},
+	31002584: {RegionID: 11000032, SystemID: 31002584, SystemName: "J000630", WormholeClass: 13},
+	31002585: {RegionID: 11000032, SystemID: 31002585, SystemName: "J000452", WormholeClass: 13},
+	31002586: {RegionID: 11000032, SystemID: 31002586, SystemName: "J000327", WormholeClass: 13},
+	31002587: {RegionID: 11000032, SystemID: 31002587, SystemName: "J000186", WormholeClass: 13},
+	31002588: {RegionID: 11000032, SystemID: 31002588, SystemName: "J000528", WormholeClass: 13},
+	31002589: {RegionID: 11000032, SystemID: 31002589, SystemName: "J000353", WormholeClass: 13},
+	31002590: {RegionID: 11000032, SystemID: 31002590, SystemName: "J000461", WormholeClass: 13},
+	31002591: {RegionID: 11000032, SystemID: 31002591, SystemName: "J000522", WormholeClass: 13},
+	31002592: {RegionID: 11000032, SystemID: 31002592, SystemName: "J000965", WormholeClass: 13},
+	31002593: {RegionID: 11000032, SystemID: 31002593, SystemName: "J000304", WormholeClass: 13},
+	31002594: {RegionID: 11000032, SystemID: 31002594, SystemName: "J000595", WormholeClass: 13},
+	31002595: {RegionID: 11000032, SystemID: 31002595, SystemName: "J000685", WormholeClass: 13},
+	31002596: {RegionID: 11000032, SystemID: 31002596, SystemName: "J000844", WormholeClass: 13},
+	31002597: {RegionID: 11000032, SystemID: 31002597, SystemName: "J000719", WormholeClass: 13},
+	31002598: {RegionID: 11000032, SystemID: 31002598, SystemName: "J000726", WormholeClass: 13},
+	31002599: {RegionID: 11000032, SystemID: 31002599, SystemName: "J000214", WormholeClass: 13},
+	31002600: {RegionID: 11000032, SystemID: 31002600, SystemName: "J000652", WormholeClass: 13},
+	31002601: {RegionID: 11000032, SystemID: 31002601, SystemName: "J000313", WormholeClass: 13},
+	31002602: {RegionID: 11000032, SystemID: 31002602, SystemName: "J000687", WormholeClass: 13},
+	31002603: {RegionID: 11000032, SystemID: 31002603, SystemName: "J000427", WormholeClass: 13},
+	31002604: {RegionID: 11000032, SystemID: 31002604, SystemName: "J000102", WormholeClass: 13},
 	32000001: {RegionID: 12000001, SystemID: 32000001, SystemName: "AD001"},
 	32000002: {RegionID: 12000001, SystemID: 32000002, SystemName: "AD002"},
 	32000003: {RegionID: 12000001, SystemID: 32000003, SystemName: "AD003"},
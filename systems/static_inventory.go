@@ -0,0 +1,316 @@
+// Static inventory data for the ship filters, curated by hand. Types it
+// lacks are looked up through the UniverseResolver. Regenerate this file
+// from a full export with make static SDE=path/to/dump.
+
+package systems
+
+var cachedCategories = map[int]CachedCategory{
+	6:  {CategoryID: 6, CategoryName: "Ship"},
+	18: {CategoryID: 18, CategoryName: "Drone"},
+	22: {CategoryID: 22, CategoryName: "Deployable"},
+	23: {CategoryID: 23, CategoryName: "Starbase"},
+	40: {CategoryID: 40, CategoryName: "Sovereignty Structures"},
+	46: {CategoryID: 46, CategoryName: "Orbitals"},
+	65: {CategoryID: 65, CategoryName: "Structure"},
+	87: {CategoryID: 87, CategoryName: "Fighter"},
+}
+
+var cachedGroups = map[int]CachedGroup{
+	25:   {GroupID: 25, GroupName: "Frigate", CategoryID: 6},
+	26:   {GroupID: 26, GroupName: "Cruiser", CategoryID: 6},
+	27:   {GroupID: 27, GroupName: "Battleship", CategoryID: 6},
+	28:   {GroupID: 28, GroupName: "Hauler", CategoryID: 6},
+	29:   {GroupID: 29, GroupName: "Capsule", CategoryID: 6},
+	30:   {GroupID: 30, GroupName: "Titan", CategoryID: 6},
+	31:   {GroupID: 31, GroupName: "Shuttle", CategoryID: 6},
+	237:  {GroupID: 237, GroupName: "Corvette", CategoryID: 6},
+	324:  {GroupID: 324, GroupName: "Assault Frigate", CategoryID: 6},
+	358:  {GroupID: 358, GroupName: "Heavy Assault Cruiser", CategoryID: 6},
+	361:  {GroupID: 361, GroupName: "Mobile Warp Disruptor", CategoryID: 22},
+	365:  {GroupID: 365, GroupName: "Control Tower", CategoryID: 23},
+	380:  {GroupID: 380, GroupName: "Deep Space Transport", CategoryID: 6},
+	419:  {GroupID: 419, GroupName: "Combat Battlecruiser", CategoryID: 6},
+	420:  {GroupID: 420, GroupName: "Destroyer", CategoryID: 6},
+	463:  {GroupID: 463, GroupName: "Mining Barge", CategoryID: 6},
+	485:  {GroupID: 485, GroupName: "Dreadnought", CategoryID: 6},
+	513:  {GroupID: 513, GroupName: "Freighter", CategoryID: 6},
+	540:  {GroupID: 540, GroupName: "Command Ship", CategoryID: 6},
+	541:  {GroupID: 541, GroupName: "Interdictor", CategoryID: 6},
+	543:  {GroupID: 543, GroupName: "Exhumer", CategoryID: 6},
+	547:  {GroupID: 547, GroupName: "Carrier", CategoryID: 6},
+	659:  {GroupID: 659, GroupName: "Supercarrier", CategoryID: 6},
+	830:  {GroupID: 830, GroupName: "Covert Ops", CategoryID: 6},
+	831:  {GroupID: 831, GroupName: "Interceptor", CategoryID: 6},
+	832:  {GroupID: 832, GroupName: "Logistics", CategoryID: 6},
+	833:  {GroupID: 833, GroupName: "Force Recon Ship", CategoryID: 6},
+	834:  {GroupID: 834, GroupName: "Stealth Bomber", CategoryID: 6},
+	883:  {GroupID: 883, GroupName: "Capital Industrial Ship", CategoryID: 6},
+	893:  {GroupID: 893, GroupName: "Electronic Attack Ship", CategoryID: 6},
+	894:  {GroupID: 894, GroupName: "Heavy Interdiction Cruiser", CategoryID: 6},
+	898:  {GroupID: 898, GroupName: "Black Ops", CategoryID: 6},
+	900:  {GroupID: 900, GroupName: "Marauder", CategoryID: 6},
+	902:  {GroupID: 902, GroupName: "Jump Freighter", CategoryID: 6},
+	906:  {GroupID: 906, GroupName: "Combat Recon Ship", CategoryID: 6},
+	941:  {GroupID: 941, GroupName: "Industrial Command Ship", CategoryID: 6},
+	963:  {GroupID: 963, GroupName: "Strategic Cruiser", CategoryID: 6},
+	1022: {GroupID: 1022, GroupName: "Prototype Exploration Ship", CategoryID: 6},
+	1025: {GroupID: 1025, GroupName: "Orbital Infrastructure", CategoryID: 46},
+	1149: {GroupID: 1149, GroupName: "Mobile Cyno Inhibitor", CategoryID: 22},
+	1201: {GroupID: 1201, GroupName: "Attack Battlecruiser", CategoryID: 6},
+	1202: {GroupID: 1202, GroupName: "Blockade Runner", CategoryID: 6},
+	1246: {GroupID: 1246, GroupName: "Mobile Depot", CategoryID: 22},
+	1247: {GroupID: 1247, GroupName: "Mobile Siphon Unit", CategoryID: 22},
+	1249: {GroupID: 1249, GroupName: "Mobile Scan Inhibitor", CategoryID: 22},
+	1250: {GroupID: 1250, GroupName: "Mobile Tractor Unit", CategoryID: 22},
+	1283: {GroupID: 1283, GroupName: "Expedition Frigate", CategoryID: 6},
+	1305: {GroupID: 1305, GroupName: "Tactical Destroyer", CategoryID: 6},
+	1404: {GroupID: 1404, GroupName: "Engineering Complex", CategoryID: 65},
+	1406: {GroupID: 1406, GroupName: "Refinery", CategoryID: 65},
+	1527: {GroupID: 1527, GroupName: "Logistics Frigate", CategoryID: 6},
+	1534: {GroupID: 1534, GroupName: "Command Destroyer", CategoryID: 6},
+	1538: {GroupID: 1538, GroupName: "Force Auxiliary", CategoryID: 6},
+	1657: {GroupID: 1657, GroupName: "Citadel", CategoryID: 65},
+	1972: {GroupID: 1972, GroupName: "Flag Cruiser", CategoryID: 6},
+}
+
+var cachedTypes = map[int]CachedType{
+	582:   {TypeID: 582, TypeName: "Bantam", GroupID: 25},
+	583:   {TypeID: 583, TypeName: "Condor", GroupID: 25},
+	584:   {TypeID: 584, TypeName: "Griffin", GroupID: 25},
+	585:   {TypeID: 585, TypeName: "Slasher", GroupID: 25},
+	586:   {TypeID: 586, TypeName: "Probe", GroupID: 25},
+	587:   {TypeID: 587, TypeName: "Rifter", GroupID: 25},
+	588:   {TypeID: 588, TypeName: "Reaper", GroupID: 237},
+	589:   {TypeID: 589, TypeName: "Executioner", GroupID: 25},
+	590:   {TypeID: 590, TypeName: "Inquisitor", GroupID: 25},
+	591:   {TypeID: 591, TypeName: "Tormentor", GroupID: 25},
+	592:   {TypeID: 592, TypeName: "Navitas", GroupID: 25},
+	593:   {TypeID: 593, TypeName: "Tristan", GroupID: 25},
+	594:   {TypeID: 594, TypeName: "Incursus", GroupID: 25},
+	596:   {TypeID: 596, TypeName: "Impairor", GroupID: 237},
+	597:   {TypeID: 597, TypeName: "Punisher", GroupID: 25},
+	598:   {TypeID: 598, TypeName: "Breacher", GroupID: 25},
+	599:   {TypeID: 599, TypeName: "Burst", GroupID: 25},
+	601:   {TypeID: 601, TypeName: "Ibis", GroupID: 237},
+	602:   {TypeID: 602, TypeName: "Kestrel", GroupID: 25},
+	603:   {TypeID: 603, TypeName: "Merlin", GroupID: 25},
+	605:   {TypeID: 605, TypeName: "Heron", GroupID: 25},
+	606:   {TypeID: 606, TypeName: "Velator", GroupID: 237},
+	607:   {TypeID: 607, TypeName: "Imicus", GroupID: 25},
+	608:   {TypeID: 608, TypeName: "Atron", GroupID: 25},
+	609:   {TypeID: 609, TypeName: "Maulus", GroupID: 25},
+	620:   {TypeID: 620, TypeName: "Osprey", GroupID: 26},
+	621:   {TypeID: 621, TypeName: "Caracal", GroupID: 26},
+	622:   {TypeID: 622, TypeName: "Stabber", GroupID: 26},
+	623:   {TypeID: 623, TypeName: "Moa", GroupID: 26},
+	624:   {TypeID: 624, TypeName: "Maller", GroupID: 26},
+	625:   {TypeID: 625, TypeName: "Augoror", GroupID: 26},
+	626:   {TypeID: 626, TypeName: "Vexor", GroupID: 26},
+	627:   {TypeID: 627, TypeName: "Thorax", GroupID: 26},
+	628:   {TypeID: 628, TypeName: "Arbitrator", GroupID: 26},
+	629:   {TypeID: 629, TypeName: "Rupture", GroupID: 26},
+	630:   {TypeID: 630, TypeName: "Bellicose", GroupID: 26},
+	631:   {TypeID: 631, TypeName: "Scythe", GroupID: 26},
+	632:   {TypeID: 632, TypeName: "Blackbird", GroupID: 26},
+	633:   {TypeID: 633, TypeName: "Celestis", GroupID: 26},
+	634:   {TypeID: 634, TypeName: "Exequror", GroupID: 26},
+	638:   {TypeID: 638, TypeName: "Raven", GroupID: 27},
+	639:   {TypeID: 639, TypeName: "Tempest", GroupID: 27},
+	640:   {TypeID: 640, TypeName: "Scorpion", GroupID: 27},
+	641:   {TypeID: 641, TypeName: "Megathron", GroupID: 27},
+	642:   {TypeID: 642, TypeName: "Apocalypse", GroupID: 27},
+	643:   {TypeID: 643, TypeName: "Armageddon", GroupID: 27},
+	644:   {TypeID: 644, TypeName: "Typhoon", GroupID: 27},
+	645:   {TypeID: 645, TypeName: "Dominix", GroupID: 27},
+	670:   {TypeID: 670, TypeName: "Capsule", GroupID: 29},
+	671:   {TypeID: 671, TypeName: "Erebus", GroupID: 30},
+	672:   {TypeID: 672, TypeName: "Caldari Shuttle", GroupID: 31},
+	2006:  {TypeID: 2006, TypeName: "Omen", GroupID: 26},
+	2161:  {TypeID: 2161, TypeName: "Crucifier", GroupID: 25},
+	2233:  {TypeID: 2233, TypeName: "Customs Office", GroupID: 1025},
+	3756:  {TypeID: 3756, TypeName: "Gnosis", GroupID: 419},
+	3764:  {TypeID: 3764, TypeName: "Leviathan", GroupID: 30},
+	3766:  {TypeID: 3766, TypeName: "Vigil", GroupID: 25},
+	4302:  {TypeID: 4302, TypeName: "Oracle", GroupID: 1201},
+	4306:  {TypeID: 4306, TypeName: "Naga", GroupID: 1201},
+	4308:  {TypeID: 4308, TypeName: "Talos", GroupID: 1201},
+	4310:  {TypeID: 4310, TypeName: "Tornado", GroupID: 1201},
+	11129: {TypeID: 11129, TypeName: "Gallente Shuttle", GroupID: 31},
+	11132: {TypeID: 11132, TypeName: "Minmatar Shuttle", GroupID: 31},
+	11134: {TypeID: 11134, TypeName: "Amarr Shuttle", GroupID: 31},
+	11172: {TypeID: 11172, TypeName: "Helios", GroupID: 830},
+	11176: {TypeID: 11176, TypeName: "Crow", GroupID: 831},
+	11178: {TypeID: 11178, TypeName: "Raptor", GroupID: 831},
+	11182: {TypeID: 11182, TypeName: "Cheetah", GroupID: 830},
+	11184: {TypeID: 11184, TypeName: "Crusader", GroupID: 831},
+	11186: {TypeID: 11186, TypeName: "Malediction", GroupID: 831},
+	11188: {TypeID: 11188, TypeName: "Anathema", GroupID: 830},
+	11192: {TypeID: 11192, TypeName: "Buzzard", GroupID: 830},
+	11196: {TypeID: 11196, TypeName: "Claw", GroupID: 831},
+	11198: {TypeID: 11198, TypeName: "Stiletto", GroupID: 831},
+	11200: {TypeID: 11200, TypeName: "Taranis", GroupID: 831},
+	11202: {TypeID: 11202, TypeName: "Ares", GroupID: 831},
+	11365: {TypeID: 11365, TypeName: "Vengeance", GroupID: 324},
+	11371: {TypeID: 11371, TypeName: "Wolf", GroupID: 324},
+	11377: {TypeID: 11377, TypeName: "Nemesis", GroupID: 834},
+	11379: {TypeID: 11379, TypeName: "Hawk", GroupID: 324},
+	11381: {TypeID: 11381, TypeName: "Harpy", GroupID: 324},
+	11393: {TypeID: 11393, TypeName: "Retribution", GroupID: 324},
+	11400: {TypeID: 11400, TypeName: "Jaguar", GroupID: 324},
+	11567: {TypeID: 11567, TypeName: "Avatar", GroupID: 30},
+	11957: {TypeID: 11957, TypeName: "Falcon", GroupID: 833},
+	11959: {TypeID: 11959, TypeName: "Rook", GroupID: 906},
+	11961: {TypeID: 11961, TypeName: "Huginn", GroupID: 906},
+	11963: {TypeID: 11963, TypeName: "Rapier", GroupID: 833},
+	11965: {TypeID: 11965, TypeName: "Pilgrim", GroupID: 833},
+	11969: {TypeID: 11969, TypeName: "Arazu", GroupID: 833},
+	11971: {TypeID: 11971, TypeName: "Lachesis", GroupID: 906},
+	11978: {TypeID: 11978, TypeName: "Scimitar", GroupID: 832},
+	11985: {TypeID: 11985, TypeName: "Basilisk", GroupID: 832},
+	11987: {TypeID: 11987, TypeName: "Guardian", GroupID: 832},
+	11989: {TypeID: 11989, TypeName: "Oneiros", GroupID: 832},
+	11993: {TypeID: 11993, TypeName: "Cerberus", GroupID: 358},
+	11995: {TypeID: 11995, TypeName: "Onyx", GroupID: 894},
+	11999: {TypeID: 11999, TypeName: "Vagabond", GroupID: 358},
+	12003: {TypeID: 12003, TypeName: "Zealot", GroupID: 358},
+	12005: {TypeID: 12005, TypeName: "Ishtar", GroupID: 358},
+	12011: {TypeID: 12011, TypeName: "Eagle", GroupID: 358},
+	12013: {TypeID: 12013, TypeName: "Broadsword", GroupID: 894},
+	12015: {TypeID: 12015, TypeName: "Muninn", GroupID: 358},
+	12017: {TypeID: 12017, TypeName: "Devoter", GroupID: 894},
+	12019: {TypeID: 12019, TypeName: "Sacrilege", GroupID: 358},
+	12021: {TypeID: 12021, TypeName: "Phobos", GroupID: 894},
+	12023: {TypeID: 12023, TypeName: "Deimos", GroupID: 358},
+	12032: {TypeID: 12032, TypeName: "Manticore", GroupID: 834},
+	12034: {TypeID: 12034, TypeName: "Hound", GroupID: 834},
+	12038: {TypeID: 12038, TypeName: "Purifier", GroupID: 834},
+	12042: {TypeID: 12042, TypeName: "Ishkur", GroupID: 324},
+	12044: {TypeID: 12044, TypeName: "Enyo", GroupID: 324},
+	12729: {TypeID: 12729, TypeName: "Crane", GroupID: 1202},
+	12731: {TypeID: 12731, TypeName: "Bustard", GroupID: 380},
+	12733: {TypeID: 12733, TypeName: "Prorator", GroupID: 1202},
+	12735: {TypeID: 12735, TypeName: "Prowler", GroupID: 1202},
+	12743: {TypeID: 12743, TypeName: "Viator", GroupID: 1202},
+	12745: {TypeID: 12745, TypeName: "Occator", GroupID: 380},
+	12747: {TypeID: 12747, TypeName: "Mastodon", GroupID: 380},
+	12753: {TypeID: 12753, TypeName: "Impel", GroupID: 380},
+	16227: {TypeID: 16227, TypeName: "Ferox", GroupID: 419},
+	16229: {TypeID: 16229, TypeName: "Brutix", GroupID: 419},
+	16231: {TypeID: 16231, TypeName: "Cyclone", GroupID: 419},
+	16233: {TypeID: 16233, TypeName: "Prophecy", GroupID: 419},
+	16236: {TypeID: 16236, TypeName: "Coercer", GroupID: 420},
+	16238: {TypeID: 16238, TypeName: "Cormorant", GroupID: 420},
+	16240: {TypeID: 16240, TypeName: "Catalyst", GroupID: 420},
+	16242: {TypeID: 16242, TypeName: "Thrasher", GroupID: 420},
+	17476: {TypeID: 17476, TypeName: "Covetor", GroupID: 463},
+	17478: {TypeID: 17478, TypeName: "Retriever", GroupID: 463},
+	17480: {TypeID: 17480, TypeName: "Procurer", GroupID: 463},
+	17715: {TypeID: 17715, TypeName: "Gila", GroupID: 26},
+	17718: {TypeID: 17718, TypeName: "Phantasm", GroupID: 26},
+	17720: {TypeID: 17720, TypeName: "Cynabal", GroupID: 26},
+	17722: {TypeID: 17722, TypeName: "Vigilant", GroupID: 26},
+	17736: {TypeID: 17736, TypeName: "Nightmare", GroupID: 27},
+	17738: {TypeID: 17738, TypeName: "Machariel", GroupID: 27},
+	17740: {TypeID: 17740, TypeName: "Vindicator", GroupID: 27},
+	17918: {TypeID: 17918, TypeName: "Rattlesnake", GroupID: 27},
+	17920: {TypeID: 17920, TypeName: "Bhaalgorn", GroupID: 27},
+	17922: {TypeID: 17922, TypeName: "Ashimmu", GroupID: 26},
+	19720: {TypeID: 19720, TypeName: "Revelation", GroupID: 485},
+	19722: {TypeID: 19722, TypeName: "Naglfar", GroupID: 485},
+	19724: {TypeID: 19724, TypeName: "Moros", GroupID: 485},
+	19726: {TypeID: 19726, TypeName: "Phoenix", GroupID: 485},
+	20125: {TypeID: 20125, TypeName: "Curse", GroupID: 906},
+	20183: {TypeID: 20183, TypeName: "Providence", GroupID: 513},
+	20185: {TypeID: 20185, TypeName: "Charon", GroupID: 513},
+	20187: {TypeID: 20187, TypeName: "Obelisk", GroupID: 513},
+	20189: {TypeID: 20189, TypeName: "Fenrir", GroupID: 513},
+	22428: {TypeID: 22428, TypeName: "Redeemer", GroupID: 898},
+	22430: {TypeID: 22430, TypeName: "Sin", GroupID: 898},
+	22436: {TypeID: 22436, TypeName: "Widow", GroupID: 898},
+	22440: {TypeID: 22440, TypeName: "Panther", GroupID: 898},
+	22442: {TypeID: 22442, TypeName: "Eos", GroupID: 540},
+	22444: {TypeID: 22444, TypeName: "Sleipnir", GroupID: 540},
+	22446: {TypeID: 22446, TypeName: "Vulture", GroupID: 540},
+	22448: {TypeID: 22448, TypeName: "Absolution", GroupID: 540},
+	22452: {TypeID: 22452, TypeName: "Heretic", GroupID: 541},
+	22456: {TypeID: 22456, TypeName: "Sabre", GroupID: 541},
+	22460: {TypeID: 22460, TypeName: "Eris", GroupID: 541},
+	22464: {TypeID: 22464, TypeName: "Flycatcher", GroupID: 541},
+	22466: {TypeID: 22466, TypeName: "Astarte", GroupID: 540},
+	22468: {TypeID: 22468, TypeName: "Claymore", GroupID: 540},
+	22470: {TypeID: 22470, TypeName: "Nighthawk", GroupID: 540},
+	22474: {TypeID: 22474, TypeName: "Damnation", GroupID: 540},
+	22544: {TypeID: 22544, TypeName: "Hulk", GroupID: 543},
+	22546: {TypeID: 22546, TypeName: "Skiff", GroupID: 543},
+	22548: {TypeID: 22548, TypeName: "Mackinaw", GroupID: 543},
+	22852: {TypeID: 22852, TypeName: "Hel", GroupID: 659},
+	23757: {TypeID: 23757, TypeName: "Archon", GroupID: 547},
+	23773: {TypeID: 23773, TypeName: "Ragnarok", GroupID: 30},
+	23911: {TypeID: 23911, TypeName: "Thanatos", GroupID: 547},
+	23913: {TypeID: 23913, TypeName: "Nyx", GroupID: 659},
+	23915: {TypeID: 23915, TypeName: "Chimera", GroupID: 547},
+	23917: {TypeID: 23917, TypeName: "Wyvern", GroupID: 659},
+	23919: {TypeID: 23919, TypeName: "Aeon", GroupID: 659},
+	24483: {TypeID: 24483, TypeName: "Nidhoggur", GroupID: 547},
+	24688: {TypeID: 24688, TypeName: "Rokh", GroupID: 27},
+	24690: {TypeID: 24690, TypeName: "Hyperion", GroupID: 27},
+	24692: {TypeID: 24692, TypeName: "Abaddon", GroupID: 27},
+	24694: {TypeID: 24694, TypeName: "Maelstrom", GroupID: 27},
+	24696: {TypeID: 24696, TypeName: "Harbinger", GroupID: 419},
+	24698: {TypeID: 24698, TypeName: "Drake", GroupID: 419},
+	24700: {TypeID: 24700, TypeName: "Myrmidon", GroupID: 419},
+	24702: {TypeID: 24702, TypeName: "Hurricane", GroupID: 419},
+	28352: {TypeID: 28352, TypeName: "Rorqual", GroupID: 883},
+	28606: {TypeID: 28606, TypeName: "Orca", GroupID: 941},
+	28659: {TypeID: 28659, TypeName: "Paladin", GroupID: 900},
+	28661: {TypeID: 28661, TypeName: "Kronos", GroupID: 900},
+	28665: {TypeID: 28665, TypeName: "Vargur", GroupID: 900},
+	28710: {TypeID: 28710, TypeName: "Golem", GroupID: 900},
+	28844: {TypeID: 28844, TypeName: "Rhea", GroupID: 902},
+	28846: {TypeID: 28846, TypeName: "Nomad", GroupID: 902},
+	28848: {TypeID: 28848, TypeName: "Anshar", GroupID: 902},
+	28850: {TypeID: 28850, TypeName: "Ark", GroupID: 902},
+	29248: {TypeID: 29248, TypeName: "Magnate", GroupID: 25},
+	29984: {TypeID: 29984, TypeName: "Tengu", GroupID: 963},
+	29986: {TypeID: 29986, TypeName: "Legion", GroupID: 963},
+	29988: {TypeID: 29988, TypeName: "Proteus", GroupID: 963},
+	29990: {TypeID: 29990, TypeName: "Loki", GroupID: 963},
+	32872: {TypeID: 32872, TypeName: "Algos", GroupID: 420},
+	32874: {TypeID: 32874, TypeName: "Dragoon", GroupID: 420},
+	32876: {TypeID: 32876, TypeName: "Corax", GroupID: 420},
+	32878: {TypeID: 32878, TypeName: "Talwar", GroupID: 420},
+	32880: {TypeID: 32880, TypeName: "Venture", GroupID: 25},
+	33328: {TypeID: 33328, TypeName: "Capsule - Genolution 'Auroral' 197-variant", GroupID: 29},
+	33468: {TypeID: 33468, TypeName: "Astero", GroupID: 25},
+	33470: {TypeID: 33470, TypeName: "Stratios", GroupID: 26},
+	33472: {TypeID: 33472, TypeName: "Nestor", GroupID: 27},
+	33474: {TypeID: 33474, TypeName: "Mobile Depot", GroupID: 1246},
+	33475: {TypeID: 33475, TypeName: "Mobile Tractor Unit", GroupID: 1250},
+	33697: {TypeID: 33697, TypeName: "Prospect", GroupID: 1283},
+	33818: {TypeID: 33818, TypeName: "Orthrus", GroupID: 26},
+	34317: {TypeID: 34317, TypeName: "Confessor", GroupID: 1305},
+	34328: {TypeID: 34328, TypeName: "Bowhead", GroupID: 513},
+	34562: {TypeID: 34562, TypeName: "Svipul", GroupID: 1305},
+	34828: {TypeID: 34828, TypeName: "Jackdaw", GroupID: 1305},
+	35683: {TypeID: 35683, TypeName: "Hecate", GroupID: 1305},
+	35825: {TypeID: 35825, TypeName: "Raitaru", GroupID: 1404},
+	35826: {TypeID: 35826, TypeName: "Azbel", GroupID: 1404},
+	35827: {TypeID: 35827, TypeName: "Sotiyo", GroupID: 1404},
+	35832: {TypeID: 35832, TypeName: "Astrahus", GroupID: 1657},
+	35833: {TypeID: 35833, TypeName: "Fortizar", GroupID: 1657},
+	35834: {TypeID: 35834, TypeName: "Keepstar", GroupID: 1657},
+	35835: {TypeID: 35835, TypeName: "Athanor", GroupID: 1406},
+	35836: {TypeID: 35836, TypeName: "Tatara", GroupID: 1406},
+	37135: {TypeID: 37135, TypeName: "Endurance", GroupID: 1283},
+	37604: {TypeID: 37604, TypeName: "Apostle", GroupID: 1538},
+	37605: {TypeID: 37605, TypeName: "Minokawa", GroupID: 1538},
+	37606: {TypeID: 37606, TypeName: "Lif", GroupID: 1538},
+	37607: {TypeID: 37607, TypeName: "Ninazu", GroupID: 1538},
+	42244: {TypeID: 42244, TypeName: "Porpoise", GroupID: 941},
+	47269: {TypeID: 47269, TypeName: "Damavik", GroupID: 25},
+	47270: {TypeID: 47270, TypeName: "Vedmak", GroupID: 26},
+	47271: {TypeID: 47271, TypeName: "Leshak", GroupID: 27},
+	49710: {TypeID: 49710, TypeName: "Kikimora", GroupID: 420},
+	49711: {TypeID: 49711, TypeName: "Drekavac", GroupID: 419},
+	52907: {TypeID: 52907, TypeName: "Zirnitra", GroupID: 485},
+}
@@ -0,0 +1,176 @@
+package systems
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"git.sr.ht/~barveyhirdman/chainkills/backend"
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+)
+
+// Kinds of system filters.
+const (
+	KindClass         = "class"
+	KindBand          = "band"
+	KindConstellation = "constellation"
+	KindPattern       = "pattern"
+)
+
+// SystemFilterName returns the name of the backend filter list for a mode and
+// kind, e.g. system_exclude_class.
+func SystemFilterName(mode, kind string) string {
+	return fmt.Sprintf("system_%s_%s", mode, kind)
+}
+
+// systemFilters merges the configured system filters with the ones added
+// through slash commands.
+func systemFilters() config.SystemFilters {
+	filters := config.Get().SystemFilters
+
+	b, err := backend.Backend()
+	if err != nil {
+		slog.Warn("failed to get backend", "error", err)
+		return filters
+	}
+
+	mergeFilters(b, map[string]*[]string{
+		SystemFilterName(FilterInclude, KindClass):         &filters.IncludeClasses,
+		SystemFilterName(FilterExclude, KindClass):         &filters.ExcludeClasses,
+		SystemFilterName(FilterInclude, KindBand):          &filters.IncludeBands,
+		SystemFilterName(FilterExclude, KindBand):          &filters.ExcludeBands,
+		SystemFilterName(FilterInclude, KindConstellation): &filters.IncludeConstellations,
+		SystemFilterName(FilterExclude, KindConstellation): &filters.ExcludeConstellations,
+		SystemFilterName(FilterInclude, KindPattern):       &filters.IncludePatterns,
+		SystemFilterName(FilterExclude, KindPattern):       &filters.ExcludePatterns,
+	})
+
+	return filters
+}
+
+// SystemAllowed reports whether a system from the map passes the filters.
+// Patterns are matched against both the map's name for the system and its
+// static name, the other filters need the system to be in the static data or
+// to be looked up.
+func SystemAllowed(ctx context.Context, sys System, filters config.SystemFilters) bool {
+	data, _ := LookupSystem(ctx, sys.SolarSystemID)
+	constellation := CachedConstellation{ConstellationID: data.ConstellationID}
+	if data.ConstellationID != 0 && len(filters.IncludeConstellations)+len(filters.ExcludeConstellations) > 0 {
+		if found, ok := LookupConstellation(ctx, data.ConstellationID); ok {
+			constellation = found
+		}
+	}
+
+	names := []string{sys.Name}
+	if data.SystemName != "" && data.SystemName != sys.Name {
+		names = append(names, data.SystemName)
+	}
+
+	matchClass := func(list []string) bool {
+		return matchesClass(list, data.Class())
+	}
+	matchBand := func(list []string) bool {
+		return matchesBand(list, data.Band())
+	}
+	matchConstellation := func(list []string) bool {
+		return matchesIDOrName(list, constellation.ConstellationID, constellation.ConstellationName)
+	}
+	matchPattern := func(list []string) bool {
+		return matchesPattern(list, names...)
+	}
+
+	if matchClass(filters.ExcludeClasses) || matchBand(filters.ExcludeBands) ||
+		matchConstellation(filters.ExcludeConstellations) || matchPattern(filters.ExcludePatterns) {
+		return false
+	}
+
+	if len(filters.IncludeClasses) == 0 && len(filters.IncludeBands) == 0 &&
+		len(filters.IncludeConstellations) == 0 && len(filters.IncludePatterns) == 0 {
+		return true
+	}
+
+	return matchClass(filters.IncludeClasses) || matchBand(filters.IncludeBands) ||
+		matchConstellation(filters.IncludeConstellations) || matchPattern(filters.IncludePatterns)
+}
+
+func matchesClass(list []string, class WormholeClass) bool {
+	if class == ClassUnknown {
+		return false
+	}
+
+	for _, entry := range list {
+		if classes, ok := ParseWormholeClass(strings.TrimSpace(entry)); ok && slices.Contains(classes, class) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesBand(list []string, band SecurityBand) bool {
+	if band == BandUnknown {
+		return false
+	}
+
+	for _, entry := range list {
+		if b, ok := ParseSecurityBand(entry); ok && b == band {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesPattern reports whether any of the names matches one of the glob
+// patterns, ignoring case. Invalid patterns never match.
+func matchesPattern(list []string, names ...string) bool {
+	for _, pattern := range list {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		for _, name := range names {
+			if ok, err := path.Match(pattern, strings.ToLower(name)); err == nil && ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ValidateSystemFilter checks a filter entry and returns it in the form it's
+// stored in, e.g. a constellation name is turned into its ID.
+func ValidateSystemFilter(ctx context.Context, kind, value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	switch kind {
+	case KindClass:
+		if _, ok := ParseWormholeClass(value); !ok {
+			return "", fmt.Errorf("unknown wormhole class %s", value)
+		}
+		return value, nil
+	case KindBand:
+		band, ok := ParseSecurityBand(value)
+		if !ok {
+			return "", fmt.Errorf("unknown security band %s", value)
+		}
+		return string(band), nil
+	case KindConstellation:
+		if constellation, ok := LookupConstellationByName(ctx, value); ok {
+			return fmt.Sprintf("%d", constellation.ConstellationID), nil
+		}
+		if _, err := strconv.Atoi(value); err == nil {
+			return value, nil
+		}
+		return "", fmt.Errorf("unknown constellation %s", value)
+	case KindPattern:
+		if _, err := path.Match(strings.ToLower(value), ""); err != nil {
+			return "", fmt.Errorf("invalid pattern %s: %w", value, err)
+		}
+		return value, nil
+	}
+
+	return "", fmt.Errorf("unknown filter kind %s", kind)
+}
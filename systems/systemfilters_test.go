@@ -0,0 +1,196 @@
+package systems
+
+import (
+	"context"
+	"testing"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemAllowed(t *testing.T) {
+	c2 := System{Name: "J164417", SolarSystemID: 31000355}
+	c5 := System{Name: "J152820", SolarSystemID: 31001880}
+	renamed := System{Name: "Home", SolarSystemID: 31001880}
+	pochven := System{Name: "Otela", SolarSystemID: 30000157}
+	unknown := System{Name: "New System", SolarSystemID: 1}
+	highsec := System{Name: "Jita", SolarSystemID: 30000142}
+	lowsec := System{Name: "Amamake", SolarSystemID: 30002537}
+	nullsec := System{Name: "HED-GP", SolarSystemID: 30001161}
+
+	SetUniverseResolver(fakeUniverse{})
+	defer SetUniverseResolver(nil)
+
+	tests := []struct {
+		label    string
+		system   System
+		filters  config.SystemFilters
+		expected bool
+	}{
+		{
+			label:    "no filters",
+			system:   c2,
+			filters:  config.SystemFilters{},
+			expected: true,
+		},
+		{
+			label:    "excluded class",
+			system:   c2,
+			filters:  config.SystemFilters{ExcludeClasses: []string{"C1", "C2", "C3"}},
+			expected: false,
+		},
+		{
+			label:    "other class excluded",
+			system:   c5,
+			filters:  config.SystemFilters{ExcludeClasses: []string{"C1", "C2", "C3"}},
+			expected: true,
+		},
+		{
+			label:    "included class",
+			system:   c5,
+			filters:  config.SystemFilters{IncludeClasses: []string{"c5", "c6"}},
+			expected: true,
+		},
+		{
+			label:    "not included",
+			system:   c2,
+			filters:  config.SystemFilters{IncludeClasses: []string{"C5", "C6"}},
+			expected: false,
+		},
+		{
+			label:    "excluded band",
+			system:   pochven,
+			filters:  config.SystemFilters{ExcludeBands: []string{"pochven"}},
+			expected: false,
+		},
+		{
+			label:    "included band alias",
+			system:   c2,
+			filters:  config.SystemFilters{IncludeBands: []string{"J-Space"}},
+			expected: true,
+		},
+		{
+			label:    "excluded pattern",
+			system:   c5,
+			filters:  config.SystemFilters{ExcludePatterns: []string{"j15*"}},
+			expected: false,
+		},
+		{
+			label:    "pattern on static name of renamed system",
+			system:   renamed,
+			filters:  config.SystemFilters{ExcludePatterns: []string{"J15*"}},
+			expected: false,
+		},
+		{
+			label:    "pattern on map name",
+			system:   renamed,
+			filters:  config.SystemFilters{IncludePatterns: []string{"Home"}},
+			expected: true,
+		},
+		{
+			label:  "exclude wins over include",
+			system: c5,
+			filters: config.SystemFilters{
+				IncludeBands:   []string{"wormhole"},
+				ExcludeClasses: []string{"C5"},
+			},
+			expected: false,
+		},
+		{
+			label:    "highsec excluded",
+			system:   highsec,
+			filters:  config.SystemFilters{ExcludeBands: []string{"high"}},
+			expected: false,
+		},
+		{
+			label:    "lowsec included",
+			system:   lowsec,
+			filters:  config.SystemFilters{IncludeBands: []string{"lowsec"}},
+			expected: true,
+		},
+		{
+			label:    "nullsec not included",
+			system:   nullsec,
+			filters:  config.SystemFilters{IncludeBands: []string{"high", "low"}},
+			expected: false,
+		},
+		{
+			label:    "nullsec class",
+			system:   nullsec,
+			filters:  config.SystemFilters{IncludeClasses: []string{"Nullsec"}},
+			expected: true,
+		},
+		{
+			label:    "excluded constellation by id",
+			system:   highsec,
+			filters:  config.SystemFilters{ExcludeConstellations: []string{"20000020"}},
+			expected: false,
+		},
+		{
+			label:    "included constellation by name",
+			system:   lowsec,
+			filters:  config.SystemFilters{IncludeConstellations: []string{"Hed"}},
+			expected: true,
+		},
+		{
+			label:    "unknown system with class include",
+			system:   unknown,
+			filters:  config.SystemFilters{IncludeClasses: []string{"C5"}},
+			expected: false,
+		},
+		{
+			label:    "unknown system with class exclude",
+			system:   unknown,
+			filters:  config.SystemFilters{ExcludeClasses: []string{"C5"}},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			require.Equal(t, tt.expected, SystemAllowed(context.Background(), tt.system, tt.filters))
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestValidateSystemFilter(t *testing.T) {
+	SetUniverseResolver(fakeUniverse{})
+	defer SetUniverseResolver(nil)
+
+	tests := []struct {
+		label    string
+		kind     string
+		value    string
+		expected string
+		err      bool
+	}{
+		{label: "class", kind: KindClass, value: "C5", expected: "C5"},
+		{label: "drifter", kind: KindClass, value: "drifter", expected: "drifter"},
+		{label: "unknown class", kind: KindClass, value: "C9", err: true},
+		{label: "band alias", kind: KindBand, value: "Lowsec", expected: "low"},
+		{label: "unknown band", kind: KindBand, value: "mid", err: true},
+		{label: "constellation id", kind: KindConstellation, value: "20000020", expected: "20000020"},
+		{label: "unknown constellation", kind: KindConstellation, value: "Nowhere", err: true},
+		{label: "constellation name", kind: KindConstellation, value: "Kimotoro", expected: "20000020"},
+		{label: "pattern", kind: KindPattern, value: "J1*", expected: "J1*"},
+		{label: "invalid pattern", kind: KindPattern, value: "J[1", err: true},
+		{label: "unknown kind", kind: "region", value: "1", err: true},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			value, err := ValidateSystemFilter(context.Background(), tt.kind, tt.value)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, value)
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
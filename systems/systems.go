@@ -30,27 +30,32 @@ const (
 	packageName string = "git.sr.ht/~barveyhirdman/chainkills/systems"
 )
 
-var (
-	whPattern = regexp.MustCompile("J[0-9]{6}")
-	register  *SystemRegister
-)
+var whPattern = regexp.MustCompile("^J[0-9]{6}$")
 
 type System struct {
 	Name          string `json:"name"`
 	SolarSystemID int    `json:"solar_system_id"`
+	Tag           string `json:"tag,omitempty"`
 }
 
 func (s System) String() string {
 	return fmt.Sprintf("%d - %s", s.SolarSystemID, s.Name)
 }
 
+// SystemRegister keeps the systems and topology of a single map.
 type SystemRegister struct {
 	mx     *sync.Mutex
 	stop   chan struct{}
 	errors chan error
 
-	ws      *websocket.Conn
-	systems []System
+	m config.Map
+
+	ws       *websocket.Conn
+	systems  []System
+	chain    chain
+	watchers map[chan []System]struct{}
+	// builds the filter systems from the map go through
+	newFilter func(mapFilters config.SystemFilters) systemFilter
 }
 
 type Option func(*SystemRegister)
@@ -61,24 +66,42 @@ func WithWebsocket(ws *websocket.Conn) Option {
 	}
 }
 
-func Register(opts ...Option) *SystemRegister {
-	if register == nil {
-		register = &SystemRegister{
-			mx:   &sync.Mutex{},
-			stop: make(chan struct{}),
+// withSystemFilter replaces the filter that reads the ignore lists and
+// filters from the backend.
+func withSystemFilter(newFilter func(mapFilters config.SystemFilters) systemFilter) Option {
+	return func(s *SystemRegister) {
+		s.newFilter = newFilter
+	}
+}
 
-			systems: []System{},
-		}
+func NewRegister(m config.Map, opts ...Option) *SystemRegister {
+	register := &SystemRegister{
+		mx:   &sync.Mutex{},
+		stop: make(chan struct{}),
+		m:    m,
 
-		for _, opt := range opts {
-			opt(register)
-		}
+		systems:   []System{},
+		watchers:  make(map[chan []System]struct{}),
+		newFilter: newSystemFilter,
+	}
+
+	for _, opt := range opts {
+		opt(register)
 	}
 
 	return register
 }
 
+// Map returns the configuration of the register's map.
+func (s *SystemRegister) Map() config.Map {
+	return s.m
+}
+
 func listHash(list []System) [32]byte {
+	// no systems hash the same whether the list is nil or empty
+	if len(list) == 0 {
+		list = []System{}
+	}
 	listJSON, _ := json.Marshal(list)
 	return sha256.Sum256(listJSON)
 }
@@ -87,20 +110,122 @@ func (s *SystemRegister) Errors() <-chan error {
 	return s.errors
 }
 
+// Systems returns the systems currently in the register.
+func (s *SystemRegister) Systems() []System {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return s.systems
+}
+
+// Contains reports whether the system is in the register.
+func (s *SystemRegister) Contains(systemID int) bool {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	for _, sys := range s.systems {
+		if sys.SolarSystemID == systemID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Watch returns a channel that receives the system list every time it
+// changes. Only the latest list is kept if the receiver falls behind. The
+// returned function stops the watch.
+func (s *SystemRegister) Watch() (<-chan []System, func()) {
+	ch := make(chan []System, 1)
+
+	s.mx.Lock()
+	s.watchers[ch] = struct{}{}
+	s.mx.Unlock()
+
+	return ch, func() {
+		s.mx.Lock()
+		delete(s.watchers, ch)
+		s.mx.Unlock()
+	}
+}
+
+// notify must be called with the lock held.
+func (s *SystemRegister) notify() {
+	for ch := range s.watchers {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- s.systems
+	}
+}
+
+// AddSystem adds a single system to the register if it passes the filters.
+// It reports whether the register changed.
+func (s *SystemRegister) AddSystem(ctx context.Context, sys System) bool {
+	if reason := s.newFilter(s.m.SystemFilters).discard(ctx, sys); reason != "" {
+		slog.Debug("discarding system",
+			"map", s.m.Name,
+			"reason", reason,
+			"system_name", sys.Name,
+			"system_id", sys.SolarSystemID,
+		)
+		return false
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	for _, existing := range s.systems {
+		if existing.SolarSystemID == sys.SolarSystemID {
+			return false
+		}
+	}
+
+	systems := make([]System, 0, len(s.systems)+1)
+	systems = append(systems, s.systems...)
+	s.systems = append(systems, sys)
+	s.notify()
+
+	return true
+}
+
+// RemoveSystem removes a system from the register. It reports whether the
+// register changed.
+func (s *SystemRegister) RemoveSystem(systemID int) bool {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	systems := make([]System, 0, len(s.systems))
+	for _, existing := range s.systems {
+		if existing.SolarSystemID != systemID {
+			systems = append(systems, existing)
+		}
+	}
+
+	if len(systems) == len(s.systems) {
+		return false
+	}
+
+	s.systems = systems
+	s.notify()
+
+	return true
+}
+
 func (s *SystemRegister) Update(ctx context.Context) (bool, error) {
-	_, span := otel.Tracer(packageName).Start(ctx, "Update")
+	sctx, span := otel.Tracer(packageName).Start(ctx, "Update")
 	defer span.End()
 
 	logger := slog.Default().With(
 		"trace_id", span.SpanContext().TraceID().String(),
 		"span_id", span.SpanContext().SpanID().String(),
+		"map", s.m.Name,
 	)
 
 	client := http.Client{}
 
-	origHash := listHash(s.systems)
-
-	url := fmt.Sprintf("%s/api/map/systems?slug=%s", config.Get().Wanderer.Host, config.Get().Wanderer.Slug)
+	url := fmt.Sprintf("%s/api/map/systems?slug=%s", s.m.Host, s.m.Slug)
 	logger.Info("fetching systems on map", "url", url)
 	span.AddEvent("fetch systems", trace.WithAttributes(
 		attribute.String("url", url),
@@ -112,7 +237,7 @@ func (s *SystemRegister) Update(ctx context.Context) (bool, error) {
 		span.SetStatus(codes.Error, err.Error())
 		return false, err
 	}
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", config.Get().Wanderer.Token))
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", s.m.Token))
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("User-Agent", fmt.Sprintf("%s/%s:%s", config.Get().AdminName, config.Get().AppName, config.Get().Version))
 
@@ -124,6 +249,18 @@ func (s *SystemRegister) Update(ctx context.Context) (bool, error) {
 		return false, err
 	}
 
+	// error responses decode to an empty list, which would clear the chain
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected status code from wanderer: %d", resp.StatusCode)
+		logger.Error("failed to fetch systems", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		if err := resp.Body.Close(); err != nil {
+			logger.Error("failed to close response body", "error", err)
+		}
+		return false, err
+	}
+
 	list := struct{ Data []System }{}
 
 	decoder := json.NewDecoder(resp.Body)
@@ -145,70 +282,58 @@ func (s *SystemRegister) Update(ctx context.Context) (bool, error) {
 	}
 
 	tmpRegistry := make([]System, 0)
+	f := s.newFilter(s.m.SystemFilters)
 
 	logger.Info("filtering systems",
 		"wormholes_only", config.Get().OnlyWHKills,
-		"ignored_system_names", ignoredSystemNames(),
-		"ignored_system_ids", ignoredSystemIDs(),
-		"ignored_region_ids", ignoredRegionIDs(),
+		"ignored_system_names", f.ignoredNames,
+		"ignored_system_ids", f.ignoredIDs,
+		"ignored_region_ids", f.ignoredRegions,
+		"system_filters", f.filters,
+		"map_system_filters", f.mapFilters,
 	)
 
 	for _, sys := range list.Data {
-
-		if config.Get().OnlyWHKills && !isWH(sys) {
+		if reason := f.discard(sctx, sys); reason != "" {
 			logger.Debug("discarding system",
-				"reason", "wormhole kills only is turned on",
+				"reason", reason,
 				"system_name", sys.Name,
 				"system_id", sys.SolarSystemID,
 			)
 			continue
 		}
 
-		if common.ContainsKey(ignoredSystemNames(), sys.Name) {
-			logger.Debug("discarding system",
-				"reason", "system is on ignore list",
-				"system_name", sys.Name,
-				"system_id", sys.SolarSystemID,
-			)
-			continue
-		}
-
-		if common.ContainsKey(ignoredSystemIDs(), sys.SolarSystemID) {
-			logger.Debug("discarding system",
-				"reason", "system is on ignore list",
-				"system_name", sys.Name,
-				"system_id", sys.SolarSystemID,
-			)
-			continue
-		}
-
-		systemData, ok := GetSystem(sys.SolarSystemID)
-		if ok {
-			if common.ContainsKey(ignoredRegionIDs(), systemData.RegionID) {
-				logger.Debug("discarding system",
-					"reason", "region is on ignore list",
-					"system_name", sys.Name,
-					"system_id", sys.SolarSystemID,
-					"region_id", systemData.RegionID,
-				)
-				continue
-			}
-		} else {
-			slog.Warn("failed to get system data", "system_id", sys.SolarSystemID)
-		}
-
 		tmpRegistry = append(tmpRegistry, sys)
+	}
 
+	connections, err := fetchConnections(sctx, s.m.Wanderer)
+	if err != nil {
+		logger.Warn("failed to fetch connections, keeping the previous ones", "error", err)
+		connections = s.Connections()
 	}
 
-	newHash := listHash(tmpRegistry)
-	changed := !bytes.Equal(origHash[:], newHash[:])
+	home := findHome(s.m.Wanderer, list.Data)
+	topology := newChain(home, connections)
+	s.mx.Lock()
+	s.chain = topology
+	s.mx.Unlock()
 
-	if len(tmpRegistry) > 0 && changed {
-		s.mx.Lock()
+	logger.Debug("updated chain", "home", home, "connections", len(connections), "reachable", len(topology.jumps))
+
+	// events can change the systems while the map is fetched, so they are
+	// compared and replaced in one go. An empty list is applied as well, the
+	// chain collapsed or every system is filtered out.
+	s.mx.Lock()
+	origHash, newHash := listHash(s.systems), listHash(tmpRegistry)
+	changed := !bytes.Equal(origHash[:], newHash[:])
+	if changed {
+		if len(tmpRegistry) == 0 {
+			logger.Warn("no systems left on the map")
+		}
 		s.systems = tmpRegistry
-		s.mx.Unlock()
+		s.notify()
 	}
+	s.mx.Unlock()
 
 	logger.Debug("fetch complete", "change", changed, "system_count", len(tmpRegistry))
 	span.AddEvent("fetch complete", trace.WithAttributes(
@@ -219,13 +344,17 @@ func (s *SystemRegister) Update(ctx context.Context) (bool, error) {
 }
 
 func (s *SystemRegister) Fetch(ctx context.Context, out chan Killmail) error {
-	sctx, span := otel.Tracer(packageName).Start(ctx, "Fetch")
-	defer span.End()
-
 	s.mx.Lock()
 	systems := s.systems
 	s.mx.Unlock()
 
+	return fetchSystems(ctx, systems, out)
+}
+
+func fetchSystems(ctx context.Context, systems []System, out chan Killmail) error {
+	sctx, span := otel.Tracer(packageName).Start(ctx, "Fetch")
+	defer span.End()
+
 	systemList := make([]string, len(systems))
 	for i := range systems {
 		systemList[i] = systems[i].String()
@@ -239,7 +368,7 @@ func (s *SystemRegister) Fetch(ctx context.Context, out chan Killmail) error {
 		"fetching killmails",
 		"trace_id", span.SpanContext().TraceID().String(),
 		"span_id", span.SpanContext().SpanID().String(),
-		"systems", s.systems,
+		"systems", systems,
 	)
 
 	kms, err := FetchKillmails(sctx, systems)
@@ -257,10 +386,101 @@ func (s *SystemRegister) Fetch(ctx context.Context, out chan Killmail) error {
 	return nil
 }
 
-func isWH(sys System) bool {
+// systemFilter holds everything that decides whether a system from the map is
+// kept, so the lists are only read once per update.
+type systemFilter struct {
+	whClasses      map[WormholeClass]struct{}
+	filters        config.SystemFilters
+	mapFilters     config.SystemFilters
+	ignoredNames   map[string]struct{}
+	ignoredIDs     map[int]struct{}
+	ignoredRegions map[int]struct{}
+}
+
+// newSystemFilter combines the global filters with the ones of a map.
+func newSystemFilter(mapFilters config.SystemFilters) systemFilter {
+	return systemFilter{
+		whClasses:      wormholeClasses(),
+		filters:        systemFilters(),
+		mapFilters:     mapFilters,
+		ignoredNames:   ignoredSystemNames(),
+		ignoredIDs:     ignoredSystemIDs(),
+		ignoredRegions: ignoredRegionIDs(),
+	}
+}
+
+// discard returns why the system should not be in the register, or an empty
+// string if it should.
+func (f systemFilter) discard(ctx context.Context, sys System) string {
+	if config.Get().OnlyWHKills && !isWH(sys, f.whClasses) {
+		return "wormhole kills only is turned on"
+	}
+
+	if common.ContainsKey(f.ignoredNames, sys.Name) || common.ContainsKey(f.ignoredIDs, sys.SolarSystemID) {
+		return "system is on ignore list"
+	}
+
+	if !SystemAllowed(ctx, sys, f.filters) {
+		return "system is filtered"
+	}
+
+	if !SystemAllowed(ctx, sys, f.mapFilters) {
+		return "system is filtered on the map"
+	}
+
+	systemData, ok := GetSystem(sys.SolarSystemID)
+	if !ok {
+		slog.Warn("failed to get system data", "system_id", sys.SolarSystemID)
+		return ""
+	}
+
+	if common.ContainsKey(f.ignoredRegions, systemData.RegionID) {
+		return "region is on ignore list"
+	}
+
+	return ""
+}
+
+// isWH reports whether the system's class is one of the given classes.
+// Systems missing from the static data fall back to matching J-names.
+func isWH(sys System, classes map[WormholeClass]struct{}) bool {
+	if data, ok := GetSystem(sys.SolarSystemID); ok && data.WormholeClass != ClassUnknown {
+		_, ok := classes[data.WormholeClass]
+		return ok
+	}
+
 	return whPattern.MatchString(sys.Name)
 }
 
+// wormholeClasses returns the classes that count as wormhole space for
+// only_wh_kills. Without configured classes that's all of J-space.
+func wormholeClasses() map[WormholeClass]struct{} {
+	classes := make(map[WormholeClass]struct{})
+
+	if len(config.Get().WHClasses) == 0 {
+		for class := range classNames {
+			if class.IsWormhole() {
+				classes[class] = struct{}{}
+			}
+		}
+		return classes
+	}
+
+	for _, name := range config.Get().WHClasses {
+		parsed, ok := ParseWormholeClass(name)
+		if !ok {
+			slog.Warn("unknown wormhole class", "class", name)
+			continue
+		}
+
+		for _, class := range parsed {
+			classes[class] = struct{}{}
+		}
+	}
+
+	return classes
+}
+
 // ignoredSystemIDs returns a map of system IDs that should be ignored
 // from the config and the backend both by name and ID
 func ignoredSystemIDs() map[int]struct{} {
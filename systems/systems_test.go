@@ -0,0 +1,136 @@
+package systems
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsWH(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	c5 := System{Name: "J152820", SolarSystemID: 31001880}
+	thera := System{Name: "Thera", SolarSystemID: 31000005}
+	drifter := System{Name: "J055520", SolarSystemID: 31000001}
+	pochven := System{Name: "Otela", SolarSystemID: 30000157}
+	kspace := System{Name: "Jita", SolarSystemID: 30000142}
+	unknown := System{Name: "J999999", SolarSystemID: 1}
+	renamed := System{Name: "Home", SolarSystemID: 31001880}
+
+	tests := []struct {
+		label    string
+		classes  []string
+		system   System
+		expected bool
+	}{
+		{label: "c5 by default", system: c5, expected: true},
+		{label: "thera by default", system: thera, expected: true},
+		{label: "drifter by default", system: drifter, expected: true},
+		{label: "pochven by default", system: pochven, expected: false},
+		{label: "k-space by default", system: kspace, expected: false},
+		{label: "renamed by default", system: renamed, expected: true},
+		{label: "unknown system by name", system: unknown, expected: true},
+		{label: "c5 in list", classes: []string{"c5", "C6"}, system: c5, expected: true},
+		{label: "thera not in list", classes: []string{"C5", "C6"}, system: thera, expected: false},
+		{label: "drifter alias", classes: []string{"drifter"}, system: drifter, expected: true},
+		{label: "pochven in list", classes: []string{"C5", "Pochven"}, system: pochven, expected: true},
+		{label: "invalid class ignored", classes: []string{"C7", "C5"}, system: c5, expected: true},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			config.Get().WHClasses = tt.classes
+			defer func() { config.Get().WHClasses = nil }()
+
+			require.Equal(t, tt.expected, isWH(tt.system, wormholeClasses()))
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestUpdateCollapsedChain(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	var collapsed atomic.Bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/map/systems", func(w http.ResponseWriter, r *http.Request) {
+		if collapsed.Load() {
+			fmt.Fprint(w, `{"data":[]}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"name":"J152820","solar_system_id":31001880}]}`)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	reg := newTestRegister(config.Wanderer{Host: srv.URL, Slug: "test"})
+
+	changed, err := reg.Update(context.Background())
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Len(t, reg.Systems(), 1)
+
+	collapsed.Store(true)
+	changed, err = reg.Update(context.Background())
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Empty(t, reg.Systems())
+
+	changed, err = reg.Update(context.Background())
+	require.NoError(t, err)
+	require.False(t, changed)
+}
+
+func TestUpdateFailed(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	tests := []struct {
+		label  string
+		status int
+	}{
+		{label: "unauthorized", status: http.StatusUnauthorized},
+		{label: "server error", status: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			var failing atomic.Bool
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/map/systems", func(w http.ResponseWriter, r *http.Request) {
+				if failing.Load() {
+					w.WriteHeader(tt.status)
+					fmt.Fprint(w, `{"error":"nope"}`)
+					return
+				}
+				fmt.Fprint(w, `{"data":[{"name":"J152820","solar_system_id":31001880}]}`)
+			})
+
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+
+			reg := newTestRegister(config.Wanderer{Host: srv.URL, Slug: "test"}, withSystemFilter(keepWormholes))
+			_, err := reg.Update(context.Background())
+			require.NoError(t, err)
+
+			changes, unwatch := reg.Watch()
+			defer unwatch()
+
+			failing.Store(true)
+			changed, err := reg.Update(context.Background())
+			require.ErrorContains(t, err, fmt.Sprintf("%d", tt.status))
+			require.False(t, changed)
+			require.Equal(t, []int{31001880}, systemIDs(reg))
+			require.Empty(t, changes)
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
@@ -0,0 +1,274 @@
+package systems
+
+import (
+	"math"
+	"strings"
+)
+
+// WormholeClass is the class ID the SDE assigns to a location. K-space uses
+// the highsec, lowsec and nullsec classes.
+type WormholeClass int
+
+const (
+	ClassUnknown  WormholeClass = 0
+	ClassC1       WormholeClass = 1
+	ClassC2       WormholeClass = 2
+	ClassC3       WormholeClass = 3
+	ClassC4       WormholeClass = 4
+	ClassC5       WormholeClass = 5
+	ClassC6       WormholeClass = 6
+	ClassHighsec  WormholeClass = 7
+	ClassLowsec   WormholeClass = 8
+	ClassNullsec  WormholeClass = 9
+	ClassThera    WormholeClass = 12
+	ClassC13      WormholeClass = 13
+	ClassSentinel WormholeClass = 14
+	ClassBarbican WormholeClass = 15
+	ClassVidette  WormholeClass = 16
+	ClassConflux  WormholeClass = 17
+	ClassRedoubt  WormholeClass = 18
+	ClassPochven  WormholeClass = 25
+)
+
+var classNames = map[WormholeClass]string{
+	ClassC1:       "C1",
+	ClassC2:       "C2",
+	ClassC3:       "C3",
+	ClassC4:       "C4",
+	ClassC5:       "C5",
+	ClassC6:       "C6",
+	ClassHighsec:  "Highsec",
+	ClassLowsec:   "Lowsec",
+	ClassNullsec:  "Nullsec",
+	ClassThera:    "Thera",
+	ClassC13:      "C13",
+	ClassSentinel: "Sentinel",
+	ClassBarbican: "Barbican",
+	ClassVidette:  "Vidette",
+	ClassConflux:  "Conflux",
+	ClassRedoubt:  "Redoubt",
+	ClassPochven:  "Pochven",
+}
+
+func (c WormholeClass) String() string {
+	return classNames[c]
+}
+
+// IsWormhole reports whether the class is J-space, including Thera, shattered
+// and drifter systems.
+func (c WormholeClass) IsWormhole() bool {
+	return (c >= ClassC1 && c <= ClassC6) || (c >= ClassThera && c <= ClassRedoubt)
+}
+
+// IsDrifter reports whether the class is one of the drifter wormholes.
+func (c WormholeClass) IsDrifter() bool {
+	return c >= ClassSentinel && c <= ClassRedoubt
+}
+
+// ParseWormholeClass accepts the class names returned by String, ignoring
+// case, and drifter as an alias for every drifter class.
+func ParseWormholeClass(name string) ([]WormholeClass, bool) {
+	if strings.EqualFold(name, "drifter") {
+		return []WormholeClass{ClassSentinel, ClassBarbican, ClassVidette, ClassConflux, ClassRedoubt}, true
+	}
+
+	for class, n := range classNames {
+		if strings.EqualFold(n, name) {
+			return []WormholeClass{class}, true
+		}
+	}
+
+	return nil, false
+}
+
+type SecurityBand string
+
+const (
+	BandUnknown  SecurityBand = ""
+	BandHighsec  SecurityBand = "high"
+	BandLowsec   SecurityBand = "low"
+	BandNullsec  SecurityBand = "null"
+	BandWormhole SecurityBand = "wormhole"
+	BandPochven  SecurityBand = "pochven"
+)
+
+// ParseSecurityBand accepts the band names, ignoring case, and J-space as an
+// alias for wormhole.
+func ParseSecurityBand(name string) (SecurityBand, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	switch SecurityBand(name) {
+	case BandHighsec, BandLowsec, BandNullsec, BandWormhole, BandPochven:
+		return SecurityBand(name), true
+	}
+
+	switch name {
+	case "highsec":
+		return BandHighsec, true
+	case "lowsec":
+		return BandLowsec, true
+	case "nullsec":
+		return BandNullsec, true
+	case "jspace", "j-space":
+		return BandWormhole, true
+	}
+
+	return BandUnknown, false
+}
+
+type CachedRegion struct {
+	RegionID   int
+	RegionName string
+}
+
+type CachedConstellation struct {
+	ConstellationID   int
+	ConstellationName string
+	RegionID          int
+}
+
+type CachedSystem struct {
+	RegionID        int
+	SystemID        int
+	SystemName      string
+	ConstellationID int
+	Security        float64       // True security status
+	WormholeClass   WormholeClass // Most specific class of the system, region or constellation
+}
+
+// Band returns the security band of the system. J-space and Pochven go by
+// their class, k-space by the security status as shown in game. Security is
+// only known along with the constellation, without it the band falls back to
+// the k-space class and is unknown if there is none.
+func (s CachedSystem) Band() SecurityBand {
+	switch {
+	case s.WormholeClass.IsWormhole():
+		return BandWormhole
+	case s.WormholeClass == ClassPochven:
+		return BandPochven
+	case s.ConstellationID != 0:
+		switch security := s.RoundedSecurity(); {
+		case security >= 0.5:
+			return BandHighsec
+		case security > 0:
+			return BandLowsec
+		}
+		return BandNullsec
+	case s.WormholeClass == ClassHighsec:
+		return BandHighsec
+	case s.WormholeClass == ClassLowsec:
+		return BandLowsec
+	case s.WormholeClass == ClassNullsec:
+		return BandNullsec
+	}
+
+	return BandUnknown
+}
+
+// Class returns the wormhole class of the system, or for k-space systems
+// without one the class of their security band.
+func (s CachedSystem) Class() WormholeClass {
+	if s.WormholeClass != ClassUnknown {
+		return s.WormholeClass
+	}
+
+	switch s.Band() {
+	case BandHighsec:
+		return ClassHighsec
+	case BandLowsec:
+		return ClassLowsec
+	case BandNullsec:
+		return ClassNullsec
+	}
+
+	return ClassUnknown
+}
+
+// RoundedSecurity returns the security status as shown in game. Anything
+// above 0.0 is at least 0.1.
+func (s CachedSystem) RoundedSecurity() float64 {
+	if s.Security > 0 && s.Security < 0.05 {
+		return 0.1
+	}
+
+	return math.Round(s.Security*10) / 10
+}
+
+func (s CachedSystem) Region() (CachedRegion, bool) {
+	return GetRegion(s.RegionID)
+}
+
+func (s CachedSystem) Constellation() (CachedConstellation, bool) {
+	return GetConstellation(s.ConstellationID)
+}
+
+// RegionName returns the name of the region, or an empty string if it isn't
+// in the static data.
+func (s CachedSystem) RegionName() string {
+	region, _ := s.Region()
+	return region.RegionName
+}
+
+// ConstellationName returns the name of the constellation, or an empty string
+// if it isn't in the static data.
+func (s CachedSystem) ConstellationName() string {
+	constellation, _ := s.Constellation()
+	return constellation.ConstellationName
+}
+
+var (
+	systemsByName        = indexSystemNames()
+	constellationsByName = indexConstellationNames()
+)
+
+func indexSystemNames() map[string]int {
+	index := make(map[string]int, len(cachedSystems))
+	for id, system := range cachedSystems {
+		index[strings.ToLower(system.SystemName)] = id
+	}
+
+	return index
+}
+
+func indexConstellationNames() map[string]int {
+	index := make(map[string]int, len(cachedConstellations))
+	for id, constellation := range cachedConstellations {
+		index[strings.ToLower(constellation.ConstellationName)] = id
+	}
+
+	return index
+}
+
+func GetSystem(systemID int) (CachedSystem, bool) {
+	system, ok := cachedSystems[systemID]
+	return system, ok
+}
+
+// GetSystemByName looks up a system by its name, ignoring case.
+func GetSystemByName(systemName string) (CachedSystem, bool) {
+	id, ok := systemsByName[strings.ToLower(systemName)]
+	if !ok {
+		return CachedSystem{}, false
+	}
+
+	return GetSystem(id)
+}
+
+func GetRegion(regionID int) (CachedRegion, bool) {
+	region, ok := cachedRegions[regionID]
+	return region, ok
+}
+
+func GetConstellation(constellationID int) (CachedConstellation, bool) {
+	constellation, ok := cachedConstellations[constellationID]
+	return constellation, ok
+}
+
+// GetConstellationByName looks up a constellation by its name, ignoring case.
+func GetConstellationByName(name string) (CachedConstellation, bool) {
+	id, ok := constellationsByName[strings.ToLower(name)]
+	if !ok {
+		return CachedConstellation{}, false
+	}
+
+	return GetConstellation(id)
+}
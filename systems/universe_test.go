@@ -0,0 +1,78 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSystemByName(t *testing.T) {
+	system, ok := GetSystemByName("jita")
+	require.True(t, ok)
+	require.Equal(t, 30000142, system.SystemID)
+	require.Equal(t, "The Forge", system.RegionName())
+
+	_, ok = GetSystemByName("Not a system")
+	require.False(t, ok)
+}
+
+func TestSystemClass(t *testing.T) {
+	tests := []struct {
+		label  string
+		system string
+		class  WormholeClass
+		band   SecurityBand
+	}{
+		{label: "c5", system: "J152820", class: ClassC5, band: BandWormhole},
+		{label: "thera", system: "Thera", class: ClassThera, band: BandWormhole},
+		{label: "drifter", system: "J055520", class: ClassSentinel, band: BandWormhole},
+		{label: "pochven", system: "Otela", class: ClassPochven, band: BandPochven},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			system, ok := GetSystemByName(tt.system)
+			require.True(t, ok)
+			require.Equal(t, tt.class, system.WormholeClass)
+			require.Equal(t, tt.band, system.Band())
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestBand(t *testing.T) {
+	require.Equal(t, BandHighsec, CachedSystem{WormholeClass: ClassHighsec}.Band())
+	require.Equal(t, BandLowsec, CachedSystem{WormholeClass: ClassLowsec}.Band())
+	require.Equal(t, BandNullsec, CachedSystem{WormholeClass: ClassNullsec}.Band())
+	require.Equal(t, BandWormhole, CachedSystem{WormholeClass: ClassC13}.Band())
+	require.Equal(t, BandUnknown, CachedSystem{}.Band())
+}
+
+func TestRoundedSecurity(t *testing.T) {
+	require.Equal(t, 0.5, CachedSystem{Security: 0.4512}.RoundedSecurity())
+	require.Equal(t, 0.4, CachedSystem{Security: 0.4423}.RoundedSecurity())
+	require.Equal(t, 0.1, CachedSystem{Security: 0.0123}.RoundedSecurity())
+	require.Equal(t, -0.3, CachedSystem{Security: -0.2812}.RoundedSecurity())
+}
+
+func TestParseWormholeClass(t *testing.T) {
+	classes, ok := ParseWormholeClass("c5")
+	require.True(t, ok)
+	require.Equal(t, []WormholeClass{ClassC5}, classes)
+
+	classes, ok = ParseWormholeClass("Drifter")
+	require.True(t, ok)
+	require.Len(t, classes, 5)
+
+	_, ok = ParseWormholeClass("C7")
+	require.False(t, ok)
+}
+
+func TestSystemLabel(t *testing.T) {
+	require.Equal(t, "J152820 C5", systemLabel(CachedSystem{SystemName: "J152820", WormholeClass: ClassC5}))
+	require.Equal(t, "Thera", systemLabel(CachedSystem{SystemName: "Thera", WormholeClass: ClassThera}))
+	require.Equal(t, "Amamake 0.4", systemLabel(CachedSystem{SystemName: "Amamake", Security: 0.4, WormholeClass: ClassLowsec}))
+	require.Equal(t, "Otela", systemLabel(CachedSystem{SystemName: "Otela", WormholeClass: ClassPochven}))
+	require.Equal(t, "Jita", systemLabel(CachedSystem{SystemName: "Jita"}))
+}
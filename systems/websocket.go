@@ -1,131 +1,262 @@
 package systems
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
-	"log"
 	"log/slog"
-	"net/url"
+	"sort"
+	"sync"
 	"time"
 
-	"github.com/gorilla/websocket"
+	"git.sr.ht/~barveyhirdman/chainkills/backend"
+	"git.sr.ht/~barveyhirdman/chainkills/config"
 )
 
 func StartListener(outbox chan Killmail, stop chan struct{}, errchan chan error) error {
-	// connect to websocket
-	u := url.URL{Scheme: "wss", Host: "zkillboard.com", Path: "/websocket/"}
-	c, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
-	if err != nil {
-		log.Fatal("dial:", err)
-	}
-	defer func() {
-		if err := c.Close(); err != nil {
-			slog.Error("failed to close websocket connection", "error", err)
+	cfg := config.Get().Zkillboard.Websocket
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-stop:
+			slog.Info("stopping websocket listener")
+			cancel()
+		case <-ctx.Done():
 		}
 	}()
 
-	done := make(chan struct{})
-
-	if err := c.WriteJSON(map[string]string{
-		"action":  "sub",
-		"channel": "killstream",
-	}); err != nil {
-		slog.Warn("failed to subscribe to killstream", "error", err)
-		return err
-	}
+	subs := newSubscriptions()
 
-	// start heartbeat ticker
-	heartbeat := time.NewTicker(30 * time.Second)
-	var killmail Killmail
+	conn := NewConnection(cfg.URL,
+		WithPing(time.Duration(cfg.PingInterval)*time.Second, time.Duration(cfg.PongTimeout)*time.Second),
+		WithStaleTimeout(staleTimeout(cfg)),
+		WithBackoff(time.Duration(cfg.BackoffMin)*time.Second, time.Duration(cfg.BackoffMax)*time.Second),
+		WithMaxFailures(cfg.MaxFailures),
+		WithOnConnect(func(c *Connection, reconnect bool) error {
+			subs.reset()
+			if cfg.Killstream {
+				if err := subs.sync(c, []string{"killstream"}); err != nil {
+					slog.Warn("failed to subscribe to killstream", "error", err)
+					return err
+				}
+			} else if err := subs.sync(c, systemChannels(Registers().Systems())); err != nil {
+				slog.Warn("failed to subscribe to systems", "error", err)
+				return err
+			}
 
-	// listener loop in goroutine
-	// 	send killmails to outbox
-	errorCount := 0
-	go func() {
-		defer close(done)
-		for {
-			err := c.ReadJSON(&killmail)
-			if err != nil {
-				if _, ok := err.(*websocket.CloseError); ok {
-					slog.Error("websocket connection closed", "error", err)
-					return
+			if reconnect && config.Get().Zkillboard.Backfill.Enabled {
+				if since := watermark.get(ctx); !since.IsZero() {
+					go func() {
+						if _, err := Backfill(ctx, since, outbox); err != nil {
+							slog.Error("failed to backfill killmails", "error", err)
+						}
+					}()
 				}
-				errchan <- err
-				slog.Warn("error reading message", "error", err)
-				errorCount++
-				if errorCount > 5 {
-					slog.Error("too many errors, exiting")
+			}
+
+			return nil
+		}),
+	)
+
+	if !cfg.Killstream {
+		changes, unwatch := Registers().Watch()
+		defer unwatch()
+
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
 					return
+				case systems := <-changes:
+					if conn.State() != StateSubscribed {
+						// the next connection subscribes to the new list anyway
+						continue
+					}
+					if err := subs.sync(conn, systemChannels(systems)); err != nil {
+						slog.Warn("failed to update system subscriptions", "error", err)
+					}
 				}
-				continue
 			}
+		}()
+	}
 
-			errorCount = 0
+	return conn.Run(ctx, func(msg []byte) {
+		var killmail Killmail
+		if err := json.Unmarshal(msg, &killmail); err != nil {
+			slog.Warn("error reading message", "error", err)
+			errchan <- err
+			return
+		}
 
-			if killmail.Zkill.NPC {
-				slog.Debug("filtered out killmail",
-					"reason", "NPC kill",
-					"id", killmail.KillmailID,
-				)
-				continue
-			}
+		watermark.touch(ctx)
 
-			if !filter(killmail) {
-				slog.Debug("filtered out killmail",
-					"reason", "system is on ignore list",
-					"id", killmail.KillmailID,
-					"system", killmail.SolarSystemID,
-				)
-				continue
-			}
+		publish(ctx, outbox, killmail)
+	})
+}
 
-			deviation := time.Since(killmail.OriginalTimestamp)
+// staleTimeout returns how long the connection may go without a killmail.
+// Kills in single systems are rarer than on the killstream, so per-system
+// subscriptions get a longer timeout. A reconnect is cheap either way, the
+// backfill fetches what a silent connection missed.
+func staleTimeout(cfg config.Websocket) time.Duration {
+	if cfg.Killstream {
+		return time.Duration(cfg.StaleAfter) * time.Minute
+	}
 
-			slog.Info("received new killmail",
-				"original_timestamp", killmail.OriginalTimestamp,
-				"id", killmail.KillmailID,
-				"hash", killmail.Zkill.Hash,
-				"url", killmail.Zkill.URL,
-				"deviation", fmt.Sprintf("%d", deviation/time.Minute),
-			)
+	return time.Duration(cfg.SystemStaleAfter) * time.Minute
+}
+
+// subscriptions tracks the zKillboard websocket channels the current
+// connection is subscribed to.
+type subscriptions struct {
+	mx *sync.Mutex
+
+	channels map[string]struct{}
+}
+
+func newSubscriptions() *subscriptions {
+	return &subscriptions{
+		mx:       &sync.Mutex{},
+		channels: make(map[string]struct{}),
+	}
+}
+
+// reset forgets all channels, a fresh connection has no subscriptions.
+func (s *subscriptions) reset() {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.channels = make(map[string]struct{})
+}
+
+// sync subscribes to the channels missing from the current set and
+// unsubscribes from the ones no longer wanted.
+func (s *subscriptions) sync(c *Connection, wanted []string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
 
-			outbox <- killmail
+	sub, unsub := diffChannels(s.channels, wanted)
+
+	for _, channel := range sub {
+		if err := c.Send(map[string]string{"action": "sub", "channel": channel}); err != nil {
+			return err
 		}
-	}()
+		s.channels[channel] = struct{}{}
+	}
 
-	for {
-		select {
-		case <-stop:
-			slog.Info("stopping websocket listener")
-			heartbeat.Stop()
-			if err := c.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")); err != nil {
-				slog.Warn("failed to send close message", "error", err)
-			}
-			<-done
-			return nil
-		case <-heartbeat.C:
-			if err := c.WriteMessage(websocket.PingMessage, nil); err != nil {
-				return err
-			}
+	for _, channel := range unsub {
+		if err := c.Send(map[string]string{"action": "unsub", "channel": channel}); err != nil {
+			return err
 		}
+		delete(s.channels, channel)
 	}
+
+	if len(sub)+len(unsub) > 0 {
+		slog.Info("updated websocket subscriptions",
+			"subscribed", sub,
+			"unsubscribed", unsub,
+			"total", len(s.channels),
+		)
+	}
+
+	return nil
 }
 
-func filter(km Killmail) bool {
-	valid := true
+func diffChannels(current map[string]struct{}, wanted []string) ([]string, []string) {
+	wantedSet := make(map[string]struct{}, len(wanted))
+	sub := make([]string, 0)
+	for _, channel := range wanted {
+		wantedSet[channel] = struct{}{}
+		if _, ok := current[channel]; !ok {
+			sub = append(sub, channel)
+		}
+	}
 
-	Register().mx.Lock()
-	systems := Register().systems
-	Register().mx.Unlock()
+	unsub := make([]string, 0)
+	for channel := range current {
+		if _, ok := wantedSet[channel]; !ok {
+			unsub = append(unsub, channel)
+		}
+	}
+	sort.Strings(unsub)
+
+	return sub, unsub
+}
 
-	found := false
+func systemChannels(systems []System) []string {
+	channels := make([]string, 0, len(systems))
 	for _, sys := range systems {
-		if sys.SolarSystemID == km.SolarSystemID {
-			found = true
+		channels = append(channels, fmt.Sprintf("system:%d", sys.SolarSystemID))
+	}
+
+	return channels
+}
+
+// publish runs a killmail received from any zKillboard source or backfilled
+// through the common filters and sends it to the outbox. It returns false if
+// the killmail was discarded or ctx was done before the outbox took it.
+func publish(ctx context.Context, outbox chan Killmail, killmail Killmail) bool {
+	if killmail.Zkill.NPC {
+		slog.Debug("filtered out killmail",
+			"reason", "NPC kill",
+			"id", killmail.KillmailID,
+		)
+		return false
+	}
+
+	if !filter(ctx, killmail) {
+		return false
+	}
+
+	id := fmt.Sprintf("%d", killmail.KillmailID)
+	cache, err := backend.Backend()
+	if err != nil {
+		slog.Error("failed to get cache instance", "error", err)
+	} else if config.Get().Redict.Cache {
+		if exists, err := cache.KillmailExists(ctx, id); err != nil {
+			slog.Error("failed to check id in cache", "error", err)
+		} else if exists {
+			slog.Debug("filtered out killmail",
+				"reason", "already seen",
+				"id", killmail.KillmailID,
+			)
+			return false
 		}
 	}
-	if !found {
+
+	deviation := time.Since(killmail.OriginalTimestamp)
+
+	// names are resolved when the embed is rendered, looking them up here
+	// would hold up the reader on ESI
+	slog.Info("received new killmail",
+		"original_timestamp", killmail.OriginalTimestamp,
+		"id", killmail.KillmailID,
+		"victim_id", killmail.Victim.CharacterID,
+		"ship_type_id", killmail.Victim.ShipTypeID,
+		"hash", killmail.Zkill.Hash,
+		"url", killmail.Zkill.URL,
+		"value", killmail.Zkill.TotalValue,
+		"points", killmail.Zkill.Points,
+		"solo", killmail.Zkill.Solo,
+		"awox", killmail.Zkill.Awox,
+		"labels", killmail.Zkill.Labels,
+		"deviation", fmt.Sprintf("%d", deviation/time.Minute),
+	)
+
+	select {
+	case outbox <- killmail:
+	case <-ctx.Done():
 		return false
 	}
 
-	return valid
+	if cache != nil && config.Get().Redict.Cache {
+		if err := cache.AddKillmail(ctx, id); err != nil {
+			slog.Error("failed to add item to cache", "id", id, "error", err)
+		}
+	}
+
+	return true
 }
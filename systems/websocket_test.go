@@ -0,0 +1,144 @@
+package systems
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.sr.ht/~barveyhirdman/chainkills/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffChannels(t *testing.T) {
+	tests := []struct {
+		label   string
+		current []string
+		wanted  []string
+		sub     []string
+		unsub   []string
+	}{
+		{
+			label:  "fresh connection",
+			wanted: []string{"system:1", "system:2"},
+			sub:    []string{"system:1", "system:2"},
+			unsub:  []string{},
+		},
+		{
+			label:   "no change",
+			current: []string{"system:1", "system:2"},
+			wanted:  []string{"system:2", "system:1"},
+			sub:     []string{},
+			unsub:   []string{},
+		},
+		{
+			label:   "chain changed",
+			current: []string{"system:1", "system:2", "system:3"},
+			wanted:  []string{"system:2", "system:4"},
+			sub:     []string{"system:4"},
+			unsub:   []string{"system:1", "system:3"},
+		},
+		{
+			label:   "chain collapsed",
+			current: []string{"system:1"},
+			wanted:  []string{},
+			sub:     []string{},
+			unsub:   []string{"system:1"},
+		},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			current := make(map[string]struct{})
+			for _, c := range tt.current {
+				current[c] = struct{}{}
+			}
+
+			sub, unsub := diffChannels(current, tt.wanted)
+			require.Equal(t, tt.sub, sub)
+			require.Equal(t, tt.unsub, unsub)
+		}
+
+		t.Run(tt.label, tf)
+	}
+}
+
+func TestSystemChannels(t *testing.T) {
+	channels := systemChannels([]System{
+		{Name: "J123456", SolarSystemID: 31000001},
+		{Name: "Thera", SolarSystemID: 31000005},
+	})
+
+	require.Equal(t, []string{"system:31000001", "system:31000005"}, channels)
+}
+
+func TestStaleTimeout(t *testing.T) {
+	cfg := config.Websocket{StaleAfter: 10, SystemStaleAfter: 60}
+	require.Equal(t, time.Hour, staleTimeout(cfg))
+
+	cfg.Killstream = true
+	require.Equal(t, 10*time.Minute, staleTimeout(cfg))
+
+	cfg.StaleAfter = 0
+	require.Zero(t, staleTimeout(cfg))
+}
+
+func TestPublish(t *testing.T) {
+	require.NoError(t, config.Read("testdata/config.test.yaml"))
+
+	reg := newTestRegister(config.Wanderer{})
+	reg.systems = []System{{Name: "J123456", SolarSystemID: 31000001}}
+	registers = NewRegisterSet(reg)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		label    string
+		ctx      context.Context
+		killmail Killmail
+		expected bool
+	}{
+		{
+			label:    "in the chain",
+			ctx:      context.Background(),
+			killmail: Killmail{KillmailID: 1, SolarSystemID: 31000001},
+			expected: true,
+		},
+		{
+			label:    "npc kill",
+			ctx:      context.Background(),
+			killmail: Killmail{KillmailID: 2, SolarSystemID: 31000001, Zkill: Zkb{NPC: true}},
+			expected: false,
+		},
+		{
+			label:    "outside the chain",
+			ctx:      context.Background(),
+			killmail: Killmail{KillmailID: 3, SolarSystemID: 30000142},
+			expected: false,
+		},
+		{
+			label:    "cancelled while the outbox is full",
+			ctx:      cancelled,
+			killmail: Killmail{KillmailID: 4, SolarSystemID: 31000001},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tf := func(t *testing.T) {
+			// unbuffered, publish only returns once the killmail was taken
+			outbox := make(chan Killmail)
+			received := make(chan Killmail, 1)
+			if tt.expected {
+				go func() { received <- <-outbox }()
+			}
+
+			require.Equal(t, tt.expected, publish(tt.ctx, outbox, tt.killmail))
+			if tt.expected {
+				require.Equal(t, tt.killmail.KillmailID, (<-received).KillmailID)
+			}
+		}
+
+		t.Run(tt.label, tf)
+	}
+}